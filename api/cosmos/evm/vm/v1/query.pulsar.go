@@ -6219,27 +6219,27 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
-	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
-	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryStorageRangeRequest            protoreflect.MessageDescriptor
+	fd_QueryStorageRangeRequest_address    protoreflect.FieldDescriptor
+	fd_QueryStorageRangeRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
-	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
-	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
+	md_QueryStorageRangeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryStorageRangeRequest")
+	fd_QueryStorageRangeRequest_address = md_QueryStorageRangeRequest.Fields().ByName("address")
+	fd_QueryStorageRangeRequest_pagination = md_QueryStorageRangeRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageRangeRequest)(nil)
 
-type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
+type fastReflection_QueryStorageRangeRequest QueryStorageRangeRequest
 
-func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(x)
+func (x *QueryStorageRangeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageRangeRequest)(x)
 }
 
-func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageRangeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6251,43 +6251,43 @@ func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
+var _fastReflection_QueryStorageRangeRequest_messageType fastReflection_QueryStorageRangeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageRangeRequest_messageType{}
 
-type fastReflection_QueryTxLogsRequest_messageType struct{}
+type fastReflection_QueryStorageRangeRequest_messageType struct{}
 
-func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(nil)
+func (x fastReflection_QueryStorageRangeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageRangeRequest)(nil)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x fastReflection_QueryStorageRangeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageRangeRequest)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x fastReflection_QueryStorageRangeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageRangeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x *fastReflection_QueryStorageRangeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageRangeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsRequest_messageType
+func (x *fastReflection_QueryStorageRangeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageRangeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x *fastReflection_QueryStorageRangeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageRangeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsRequest)(x)
+func (x *fastReflection_QueryStorageRangeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageRangeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6295,16 +6295,16 @@ func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_QueryTxLogsRequest_hash, value) {
+func (x *fastReflection_QueryStorageRangeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryStorageRangeRequest_address, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsRequest_pagination, value) {
+		if !f(fd_QueryStorageRangeRequest_pagination, value) {
 			return
 		}
 	}
@@ -6321,17 +6321,17 @@ func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageRangeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		return x.Hash != ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6341,17 +6341,17 @@ func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageRangeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6361,19 +6361,19 @@ func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		value := x.Hash
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6387,17 +6387,17 @@ func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageRangeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6411,48 +6411,48 @@ func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryStorageRangeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageRangeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryStorageRangeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6460,7 +6460,7 @@ func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageRangeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6471,7 +6471,7 @@ func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageRangeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6483,7 +6483,7 @@ func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
+func (x *fastReflection_QueryStorageRangeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6493,9 +6493,9 @@ func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageRangeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageRangeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6507,7 +6507,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6525,7 +6525,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageRangeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6558,10 +6558,10 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6576,7 +6576,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryStorageRangeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6608,15 +6608,15 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageRangeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,7 +6644,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -6717,79 +6717,79 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryStorageRangeResponse_1_list)(nil)
 
-type _QueryTxLogsResponse_1_list struct {
-	list *[]*Log
+type _QueryStorageRangeResponse_1_list struct {
+	list *[]*State
 }
 
-func (x *_QueryTxLogsResponse_1_list) Len() int {
+func (x *_QueryStorageRangeResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryStorageRangeResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryStorageRangeResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+	concreteValue := valueUnwrapped.Interface().(*State)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryStorageRangeResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+	concreteValue := valueUnwrapped.Interface().(*State)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
+func (x *_QueryStorageRangeResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(State)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
+func (x *_QueryStorageRangeResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Log)
+func (x *_QueryStorageRangeResponse_1_list) NewElement() protoreflect.Value {
+	v := new(State)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
+func (x *_QueryStorageRangeResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
-	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
-	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryStorageRangeResponse            protoreflect.MessageDescriptor
+	fd_QueryStorageRangeResponse_storage    protoreflect.FieldDescriptor
+	fd_QueryStorageRangeResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
-	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
-	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
+	md_QueryStorageRangeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryStorageRangeResponse")
+	fd_QueryStorageRangeResponse_storage = md_QueryStorageRangeResponse.Fields().ByName("storage")
+	fd_QueryStorageRangeResponse_pagination = md_QueryStorageRangeResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryStorageRangeResponse)(nil)
 
-type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
+type fastReflection_QueryStorageRangeResponse QueryStorageRangeResponse
 
-func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(x)
+func (x *QueryStorageRangeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStorageRangeResponse)(x)
 }
 
-func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryStorageRangeResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6801,43 +6801,43 @@ func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
+var _fastReflection_QueryStorageRangeResponse_messageType fastReflection_QueryStorageRangeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStorageRangeResponse_messageType{}
 
-type fastReflection_QueryTxLogsResponse_messageType struct{}
+type fastReflection_QueryStorageRangeResponse_messageType struct{}
 
-func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(nil)
+func (x fastReflection_QueryStorageRangeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStorageRangeResponse)(nil)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x fastReflection_QueryStorageRangeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageRangeResponse)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x fastReflection_QueryStorageRangeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageRangeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x *fastReflection_QueryStorageRangeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStorageRangeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsResponse_messageType
+func (x *fastReflection_QueryStorageRangeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStorageRangeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x *fastReflection_QueryStorageRangeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStorageRangeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsResponse)(x)
+func (x *fastReflection_QueryStorageRangeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStorageRangeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6845,16 +6845,16 @@ func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
-		if !f(fd_QueryTxLogsResponse_logs, value) {
+func (x *fastReflection_QueryStorageRangeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Storage) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStorageRangeResponse_1_list{list: &x.Storage})
+		if !f(fd_QueryStorageRangeResponse_storage, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsResponse_pagination, value) {
+		if !f(fd_QueryStorageRangeResponse_pagination, value) {
 			return
 		}
 	}
@@ -6871,17 +6871,17 @@ func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryStorageRangeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		return len(x.Logs) != 0
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
+		return len(x.Storage) != 0
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6891,17 +6891,17 @@ func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryStorageRangeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		x.Logs = nil
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
+		x.Storage = nil
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6911,22 +6911,22 @@ func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
+		if len(x.Storage) == 0 {
+			return protoreflect.ValueOfList(&_QueryStorageRangeResponse_1_list{})
 		}
-		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		listValue := &_QueryStorageRangeResponse_1_list{list: &x.Storage}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6940,19 +6940,19 @@ func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryStorageRangeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
 		lv := value.List()
-		clv := lv.(*_QueryTxLogsResponse_1_list)
-		x.Logs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		clv := lv.(*_QueryStorageRangeResponse_1_list)
+		x.Storage = *clv.list
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6966,53 +6966,53 @@ func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
+		if x.Storage == nil {
+			x.Storage = []*State{}
 		}
-		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		value := &_QueryStorageRangeResponse_1_list{list: &x.Storage}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageResponse)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryStorageRangeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.storage":
+		list := []*State{}
+		return protoreflect.ValueOfList(&_QueryStorageRangeResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination":
 		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryStorageRangeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryStorageRangeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryStorageRangeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryStorageRangeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7020,7 +7020,7 @@ func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryStorageRangeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7031,7 +7031,7 @@ func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryStorageRangeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7043,7 +7043,7 @@ func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
+func (x *fastReflection_QueryStorageRangeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7053,9 +7053,9 @@ func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryStorageRangeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryStorageRangeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7067,8 +7067,8 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
+		if len(x.Storage) > 0 {
+			for _, e := range x.Storage {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -7087,7 +7087,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryStorageRangeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7120,9 +7120,9 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
+		if len(x.Storage) > 0 {
+			for iNdEx := len(x.Storage) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Storage[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7147,7 +7147,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryStorageRangeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7179,15 +7179,15 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageRangeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStorageRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7214,8 +7214,8 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+				x.Storage = append(x.Storage, &State{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Storage[len(x.Storage)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7291,23 +7291,25 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryContractsRequest            protoreflect.MessageDescriptor
+	fd_QueryContractsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryContractsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryContractsRequest")
+	fd_QueryContractsRequest_pagination = md_QueryContractsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractsRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryContractsRequest QueryContractsRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryContractsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7319,43 +7321,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryContractsRequest_messageType fastReflection_QueryContractsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryContractsRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryContractsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryContractsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryContractsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryContractsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryContractsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryContractsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryContractsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7363,7 +7365,13 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryContractsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7377,13 +7385,15 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7393,13 +7403,15 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7409,13 +7421,16 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7429,13 +7444,15 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7449,36 +7466,44 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryContractsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryContractsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7486,7 +7511,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7497,7 +7522,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7509,7 +7534,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryContractsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7519,9 +7544,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryContractsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7533,6 +7558,10 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7543,7 +7572,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryContractsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7562,6 +7591,20 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7573,7 +7616,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryContractsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7605,12 +7648,48 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7647,25 +7726,31 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_ContractAccount           protoreflect.MessageDescriptor
+	fd_ContractAccount_address   protoreflect.FieldDescriptor
+	fd_ContractAccount_balance   protoreflect.FieldDescriptor
+	fd_ContractAccount_nonce     protoreflect.FieldDescriptor
+	fd_ContractAccount_code_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_ContractAccount = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("ContractAccount")
+	fd_ContractAccount_address = md_ContractAccount.Fields().ByName("address")
+	fd_ContractAccount_balance = md_ContractAccount.Fields().ByName("balance")
+	fd_ContractAccount_nonce = md_ContractAccount.Fields().ByName("nonce")
+	fd_ContractAccount_code_hash = md_ContractAccount.Fields().ByName("code_hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_ContractAccount)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_ContractAccount ContractAccount
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *ContractAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractAccount)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *ContractAccount) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7677,43 +7762,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_ContractAccount_messageType fastReflection_ContractAccount_messageType
+var _ protoreflect.MessageType = fastReflection_ContractAccount_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_ContractAccount_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_ContractAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractAccount)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_ContractAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractAccount)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_ContractAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractAccount
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_ContractAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractAccount
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_ContractAccount) Type() protoreflect.MessageType {
+	return _fastReflection_ContractAccount_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_ContractAccount) New() protoreflect.Message {
+	return new(fastReflection_ContractAccount)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_ContractAccount) Interface() protoreflect.ProtoMessage {
+	return (*ContractAccount)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7721,10 +7806,28 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_ContractAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ContractAccount_address, value) {
+			return
+		}
+	}
+	if x.Balance != "" {
+		value := protoreflect.ValueOfString(x.Balance)
+		if !f(fd_ContractAccount_balance, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_ContractAccount_nonce, value) {
+			return
+		}
+	}
+	if x.CodeHash != "" {
+		value := protoreflect.ValueOfString(x.CodeHash)
+		if !f(fd_ContractAccount_code_hash, value) {
 			return
 		}
 	}
@@ -7741,15 +7844,21 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ContractAccount) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		return x.Balance != ""
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		return x.Nonce != uint64(0)
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		return x.CodeHash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7759,15 +7868,21 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ContractAccount) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		x.Balance = ""
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		x.Nonce = uint64(0)
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		x.CodeHash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7777,16 +7892,25 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		value := x.Balance
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		value := x.CodeHash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7800,15 +7924,21 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ContractAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		x.Balance = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		x.Nonce = value.Uint()
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		x.CodeHash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7822,44 +7952,52 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.ContractAccount is not mutable"))
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		panic(fmt.Errorf("field balance of message cosmos.evm.vm.v1.ContractAccount is not mutable"))
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		panic(fmt.Errorf("field nonce of message cosmos.evm.vm.v1.ContractAccount is not mutable"))
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		panic(fmt.Errorf("field code_hash of message cosmos.evm.vm.v1.ContractAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ContractAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.ContractAccount.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ContractAccount.balance":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ContractAccount.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ContractAccount.code_hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ContractAccount"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ContractAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ContractAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ContractAccount", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7867,7 +8005,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ContractAccount) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7878,7 +8016,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ContractAccount) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7890,7 +8028,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_ContractAccount) IsValid() bool {
 	return x != nil
 }
 
@@ -7900,9 +8038,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ContractAccount) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*ContractAccount)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7914,8 +8052,19 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Balance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.CodeHash)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7928,7 +8077,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*ContractAccount)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7947,17 +8096,29 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.CodeHash) > 0 {
+			i -= len(x.CodeHash)
+			copy(dAtA[i:], x.CodeHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CodeHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Balance) > 0 {
+			i -= len(x.Balance)
+			copy(dAtA[i:], x.Balance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Balance)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7972,7 +8133,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*ContractAccount)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8004,17 +8165,17 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractAccount: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8024,27 +8185,106 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.CodeHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8081,34 +8321,79 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_QueryContractsResponse_1_list)(nil)
+
+type _QueryContractsResponse_1_list struct {
+	list *[]*ContractAccount
+}
+
+func (x *_QueryContractsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryContractsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryContractsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractAccount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryContractsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractAccount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryContractsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(ContractAccount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryContractsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(ContractAccount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_EthCallRequest                  protoreflect.MessageDescriptor
-	fd_EthCallRequest_args             protoreflect.FieldDescriptor
-	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
-	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
-	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
-	fd_EthCallRequest_overrides        protoreflect.FieldDescriptor
+	md_QueryContractsResponse            protoreflect.MessageDescriptor
+	fd_QueryContractsResponse_contracts  protoreflect.FieldDescriptor
+	fd_QueryContractsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
-	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
-	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
-	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
-	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
-	fd_EthCallRequest_overrides = md_EthCallRequest.Fields().ByName("overrides")
+	md_QueryContractsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryContractsResponse")
+	fd_QueryContractsResponse_contracts = md_QueryContractsResponse.Fields().ByName("contracts")
+	fd_QueryContractsResponse_pagination = md_QueryContractsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractsResponse)(nil)
 
-type fastReflection_EthCallRequest EthCallRequest
+type fastReflection_QueryContractsResponse QueryContractsResponse
 
-func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(x)
+func (x *QueryContractsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractsResponse)(x)
 }
 
-func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8120,43 +8405,43 @@ func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
-var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
+var _fastReflection_QueryContractsResponse_messageType fastReflection_QueryContractsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractsResponse_messageType{}
 
-type fastReflection_EthCallRequest_messageType struct{}
+type fastReflection_QueryContractsResponse_messageType struct{}
 
-func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(nil)
+func (x fastReflection_QueryContractsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractsResponse)(nil)
 }
-func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x fastReflection_QueryContractsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsResponse)
 }
-func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x fastReflection_QueryContractsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x *fastReflection_QueryContractsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
-	return _fastReflection_EthCallRequest_messageType
+func (x *fastReflection_QueryContractsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x *fastReflection_QueryContractsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
-	return (*EthCallRequest)(x)
+func (x *fastReflection_QueryContractsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8164,34 +8449,16 @@ func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Args) != 0 {
-		value := protoreflect.ValueOfBytes(x.Args)
-		if !f(fd_EthCallRequest_args, value) {
-			return
-		}
-	}
-	if x.GasCap != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasCap)
-		if !f(fd_EthCallRequest_gas_cap, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_EthCallRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_EthCallRequest_chain_id, value) {
+func (x *fastReflection_QueryContractsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Contracts) != 0 {
+		value := protoreflect.ValueOfList(&_QueryContractsResponse_1_list{list: &x.Contracts})
+		if !f(fd_QueryContractsResponse_contracts, value) {
 			return
 		}
 	}
-	if len(x.Overrides) != 0 {
-		value := protoreflect.ValueOfBytes(x.Overrides)
-		if !f(fd_EthCallRequest_overrides, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractsResponse_pagination, value) {
 			return
 		}
 	}
@@ -8208,23 +8475,17 @@ func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return len(x.Args) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return x.GasCap != uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		return len(x.Overrides) != 0
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		return len(x.Contracts) != 0
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8234,23 +8495,17 @@ func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		x.Overrides = nil
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		x.Contracts = nil
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8260,28 +8515,22 @@ func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		value := x.Args
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		value := x.GasCap
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		value := x.Overrides
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		if len(x.Contracts) == 0 {
+			return protoreflect.ValueOfList(&_QueryContractsResponse_1_list{})
+		}
+		listValue := &_QueryContractsResponse_1_list{list: &x.Contracts}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8295,23 +8544,19 @@ func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = value.Uint()
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		x.Overrides = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		lv := value.List()
+		clv := lv.(*_QueryContractsResponse_1_list)
+		x.Contracts = *clv.list
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8325,56 +8570,53 @@ func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		panic(fmt.Errorf("field overrides of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		if x.Contracts == nil {
+			x.Contracts = []*ContractAccount{}
+		}
+		value := &_QueryContractsResponse_1_list{list: &x.Contracts}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryContractsResponse.contracts":
+		list := []*ContractAccount{}
+		return protoreflect.ValueOfList(&_QueryContractsResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryContractsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryContractsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8382,7 +8624,7 @@ func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8393,7 +8635,7 @@ func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8405,7 +8647,7 @@ func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EthCallRequest) IsValid() bool {
+func (x *fastReflection_QueryContractsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8415,9 +8657,9 @@ func (x *fastReflection_EthCallRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryContractsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8429,22 +8671,14 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Args)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasCap != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasCap))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
+		if len(x.Contracts) > 0 {
+			for _, e := range x.Contracts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		l = len(x.Overrides)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8457,7 +8691,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryContractsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8476,36 +8710,35 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Overrides) > 0 {
-			i -= len(x.Overrides)
-			copy(dAtA[i:], x.Overrides)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.GasCap != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Args) > 0 {
-			i -= len(x.Args)
-			copy(dAtA[i:], x.Args)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Contracts) > 0 {
+			for iNdEx := len(x.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8518,7 +8751,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryContractsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8550,17 +8783,17 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8570,50 +8803,31 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
-				if x.Args == nil {
-					x.Args = []byte{}
+				x.Contracts = append(x.Contracts, &ContractAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contracts[len(x.Contracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-				}
-				x.GasCap = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasCap |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8623,77 +8837,26 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
-				if x.Overrides == nil {
-					x.Overrides = []byte{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -8732,29 +8895,27 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EstimateGasResponse          protoreflect.MessageDescriptor
-	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
+	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
+	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
+	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
-	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
-	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
-	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
+	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
+	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
+	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
 
-type fastReflection_EstimateGasResponse EstimateGasResponse
+type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
 
-func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(x)
+func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(x)
 }
 
-func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8766,43 +8927,43 @@ func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
-var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
 
-type fastReflection_EstimateGasResponse_messageType struct{}
+type fastReflection_QueryTxLogsRequest_messageType struct{}
 
-func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(nil)
+func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(nil)
 }
-func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
-func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
-	return _fastReflection_EstimateGasResponse_messageType
+func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
-	return (*EstimateGasResponse)(x)
+func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8810,22 +8971,16 @@ func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Gas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Gas)
-		if !f(fd_EstimateGasResponse_gas, value) {
-			return
-		}
-	}
-	if len(x.Ret) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ret)
-		if !f(fd_EstimateGasResponse_ret, value) {
+func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_QueryTxLogsRequest_hash, value) {
 			return
 		}
 	}
-	if x.VmError != "" {
-		value := protoreflect.ValueOfString(x.VmError)
-		if !f(fd_EstimateGasResponse_vm_error, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsRequest_pagination, value) {
 			return
 		}
 	}
@@ -8842,19 +8997,17 @@ func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return x.Gas != uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return len(x.Ret) != 0
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		return x.VmError != ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		return x.Hash != ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8864,19 +9017,17 @@ func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = nil
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8886,22 +9037,19 @@ func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		value := x.Gas
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		value := x.Ret
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		value := x.VmError
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		value := x.Hash
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8915,19 +9063,17 @@ func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = value.Uint()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = value.Bytes()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8941,48 +9087,48 @@ func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8990,7 +9136,7 @@ func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9001,7 +9147,7 @@ func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9013,7 +9159,7 @@ func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9023,9 +9169,9 @@ func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9037,15 +9183,12 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Gas != 0 {
-			n += 1 + runtime.Sov(uint64(x.Gas))
-		}
-		l = len(x.Ret)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VmError)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9058,7 +9201,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9077,24 +9220,26 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VmError) > 0 {
-			i -= len(x.VmError)
-			copy(dAtA[i:], x.VmError)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Ret) > 0 {
-			i -= len(x.Ret)
-			copy(dAtA[i:], x.Ret)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Gas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9107,7 +9252,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9139,36 +9284,17 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-				}
-				x.Gas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Gas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9178,31 +9304,29 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ret == nil {
-					x.Ret = []byte{}
-				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9212,23 +9336,27 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VmError = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9265,93 +9393,79 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
 
-type _QueryTraceTxRequest_4_list struct {
-	list *[]*MsgEthereumTx
+type _QueryTxLogsResponse_1_list struct {
+	list *[]*Log
 }
 
-func (x *_QueryTraceTxRequest_4_list) Len() int {
+func (x *_QueryTxLogsResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
+func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	concreteValue := valueUnwrapped.Interface().(*Log)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
+func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	concreteValue := valueUnwrapped.Interface().(*Log)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
+func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
+func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
+func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Log)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
+	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
+	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
-	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
-	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
-	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
-	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
-	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
-	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
-	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
-	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
+	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
+	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
 
-type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
 
-func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(x)
+func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(x)
 }
 
-func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9363,43 +9477,43 @@ func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
 
-type fastReflection_QueryTraceTxRequest_messageType struct{}
+type fastReflection_QueryTxLogsResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(nil)
+func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(nil)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxRequest_messageType
+func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxRequest)(x)
+func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9407,58 +9521,16 @@ func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Msg != nil {
-		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_msg, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_trace_config, value) {
-			return
-		}
-	}
-	if len(x.Predecessors) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
-		if !f(fd_QueryTraceTxRequest_predecessors, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceTxRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceTxRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
+		if !f(fd_QueryTxLogsResponse_logs, value) {
 			return
 		}
 	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsResponse_pagination, value) {
 			return
 		}
 	}
@@ -9475,31 +9547,17 @@ func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		return x.Msg != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		return len(x.Predecessors) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		return len(x.Logs) != 0
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9509,31 +9567,17 @@ func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		x.Predecessors = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		x.Logs = nil
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9543,43 +9587,22 @@ func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		value := x.Msg
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if len(x.Predecessors) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
 		}
-		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		value := x.BlockTime
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9593,33 +9616,19 @@ func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = value.Message().Interface().(*MsgEthereumTx)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
 		lv := value.List()
-		clv := lv.(*_QueryTraceTxRequest_4_list)
-		x.Predecessors = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+		clv := lv.(*_QueryTxLogsResponse_1_list)
+		x.Logs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9633,89 +9642,53 @@ func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		if x.Msg == nil {
-			x.Msg = new(MsgEthereumTx)
-		}
-		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if x.Predecessors == nil {
-			x.Predecessors = []*MsgEthereumTx{}
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
 		}
-		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
 		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		m := new(MsgEthereumTx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		m := new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9723,7 +9696,7 @@ func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9734,7 +9707,7 @@ func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9746,7 +9719,7 @@ func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9756,9 +9729,9 @@ func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9770,41 +9743,16 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Msg != nil {
-			l = options.Size(x.Msg)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Predecessors) > 0 {
-			for _, e := range x.Predecessors {
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -9815,7 +9763,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9834,25 +9782,8 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9863,23 +9794,11 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x12
 		}
-		if len(x.Predecessors) > 0 {
-			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Predecessors[iNdEx])
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9890,36 +9809,8 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Msg != nil {
-			encoded, err := options.Marshal(x.Msg)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9932,7 +9823,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9964,15 +9855,15 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9999,16 +9890,14 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Msg == nil {
-					x.Msg = &MsgEthereumTx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -10035,211 +9924,18 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -10271,25 +9967,23 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
-	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10301,43 +9995,43 @@ func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryTraceTxResponse_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxResponse_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxResponse)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10345,13 +10039,7 @@ func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceTxResponse_data, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -10365,15 +10053,13 @@ func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		return len(x.Data) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10383,15 +10069,13 @@ func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10401,16 +10085,13 @@ func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10424,15 +10105,13 @@ func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10446,40 +10125,36 @@ func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10487,7 +10162,7 @@ func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10498,7 +10173,7 @@ func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10510,7 +10185,7 @@ func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10520,9 +10195,9 @@ func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10534,10 +10209,6 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10548,7 +10219,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10567,13 +10238,6 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10585,7 +10249,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10617,46 +10281,12 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10692,91 +10322,26 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
-
-type _QueryTraceBlockRequest_1_list struct {
-	list *[]*MsgEthereumTx
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
-	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
-	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
-	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
-	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
-	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
-	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
-	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10788,43 +10353,43 @@ func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryTraceBlockRequest_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockRequest_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockRequest)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10832,91 +10397,35 @@ func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
-		if !f(fd_QueryTraceBlockRequest_txs, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceBlockRequest_block_number, value) {
-			return
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
-			return
-		}
-	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		return len(x.Txs) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10926,29 +10435,15 @@ func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		x.Txs = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10958,40 +10453,16 @@ func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
-		}
-		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		value := x.BlockTime
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11005,31 +10476,15 @@ func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		lv := value.List()
-		clv := lv.(*_QueryTraceBlockRequest_1_list)
-		x.Txs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11043,81 +10498,44 @@ func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if x.Txs == nil {
-			x.Txs = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		m := new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11125,7 +10543,7 @@ func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11136,7 +10554,7 @@ func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11148,7 +10566,7 @@ func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11158,9 +10576,9 @@ func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11172,48 +10590,21 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
 		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11232,51 +10623,8 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11287,23 +10635,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11316,7 +10648,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11348,49 +10680,15 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txs = append(x.Txs, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11417,186 +10715,27 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -11619,25 +10758,33 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+	md_EthCallRequest                  protoreflect.MessageDescriptor
+	fd_EthCallRequest_args             protoreflect.FieldDescriptor
+	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
+	fd_EthCallRequest_overrides        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
-	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
+	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
+	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
+	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
+	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+	fd_EthCallRequest_overrides = md_EthCallRequest.Fields().ByName("overrides")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
 
-type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+type fastReflection_EthCallRequest EthCallRequest
 
-func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(x)
+func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(x)
 }
 
-func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
+func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -11649,43 +10796,43 @@ func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
 
-type fastReflection_QueryTraceBlockResponse_messageType struct{}
+type fastReflection_EthCallRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(nil)
+func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(nil)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockResponse_messageType
+func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockResponse)(x)
+func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*EthCallRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11693,10 +10840,34 @@ func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceBlockResponse_data, value) {
+func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_EthCallRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_EthCallRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_EthCallRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_EthCallRequest_chain_id, value) {
+			return
+		}
+	}
+	if len(x.Overrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.Overrides)
+		if !f(fd_EthCallRequest_overrides, value) {
 			return
 		}
 	}
@@ -11713,15 +10884,23 @@ func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		return len(x.Overrides) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11731,15 +10910,23 @@ func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11749,16 +10936,28 @@ func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		value := x.Data
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		value := x.Overrides
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11772,15 +10971,23 @@ func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11794,40 +11001,56 @@ func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		panic(fmt.Errorf("field overrides of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11835,7 +11058,7 @@ func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11846,7 +11069,7 @@ func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11858,7 +11081,7 @@ func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+func (x *fastReflection_EthCallRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -11868,9 +11091,9 @@ func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11882,7 +11105,21 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Overrides)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -11896,7 +11133,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11915,10 +11152,34 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.Overrides) > 0 {
+			i -= len(x.Overrides)
+			copy(dAtA[i:], x.Overrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -11933,7 +11194,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11965,15 +11226,15 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -12000,9 +11261,115 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.Overrides == nil {
+					x.Overrides = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -12041,39 +11408,29 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryTraceCallRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceCallRequest_args             protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_gas_cap          protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceCallRequest_chain_id         protoreflect.FieldDescriptor
+	md_EstimateGasResponse          protoreflect.MessageDescriptor
+	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallRequest")
-	fd_QueryTraceCallRequest_args = md_QueryTraceCallRequest.Fields().ByName("args")
-	fd_QueryTraceCallRequest_gas_cap = md_QueryTraceCallRequest.Fields().ByName("gas_cap")
-	fd_QueryTraceCallRequest_proposer_address = md_QueryTraceCallRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceCallRequest_trace_config = md_QueryTraceCallRequest.Fields().ByName("trace_config")
-	fd_QueryTraceCallRequest_block_number = md_QueryTraceCallRequest.Fields().ByName("block_number")
-	fd_QueryTraceCallRequest_block_hash = md_QueryTraceCallRequest.Fields().ByName("block_hash")
-	fd_QueryTraceCallRequest_block_time = md_QueryTraceCallRequest.Fields().ByName("block_time")
-	fd_QueryTraceCallRequest_chain_id = md_QueryTraceCallRequest.Fields().ByName("chain_id")
+	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
+	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
+	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
+	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceCallRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
 
-type fastReflection_QueryTraceCallRequest QueryTraceCallRequest
+type fastReflection_EstimateGasResponse EstimateGasResponse
 
-func (x *QueryTraceCallRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceCallRequest)(x)
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(x)
 }
 
-func (x *QueryTraceCallRequest) slowProtoReflect() protoreflect.Message {
+func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -12085,43 +11442,43 @@ func (x *QueryTraceCallRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceCallRequest_messageType fastReflection_QueryTraceCallRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceCallRequest_messageType{}
+var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
 
-type fastReflection_QueryTraceCallRequest_messageType struct{}
+type fastReflection_EstimateGasResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceCallRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceCallRequest)(nil)
+func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(nil)
 }
-func (x fastReflection_QueryTraceCallRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceCallRequest)
+func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
-func (x fastReflection_QueryTraceCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceCallRequest
+func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceCallRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceCallRequest
+func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceCallRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceCallRequest_messageType
+func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceCallRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceCallRequest)
+func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceCallRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceCallRequest)(x)
+func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12129,52 +11486,22 @@ func (x *fastReflection_QueryTraceCallRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Args) != 0 {
-		value := protoreflect.ValueOfBytes(x.Args)
-		if !f(fd_QueryTraceCallRequest_args, value) {
+func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_EstimateGasResponse_gas, value) {
 			return
 		}
 	}
-	if x.GasCap != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasCap)
-		if !f(fd_QueryTraceCallRequest_gas_cap, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceCallRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceCallRequest_trace_config, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceCallRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceCallRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceCallRequest_block_time, value) {
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_EstimateGasResponse_ret, value) {
 			return
 		}
 	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceCallRequest_chain_id, value) {
+	if x.VmError != "" {
+		value := protoreflect.ValueOfString(x.VmError)
+		if !f(fd_EstimateGasResponse_vm_error, value) {
 			return
 		}
 	}
@@ -12191,29 +11518,19 @@ func (x *fastReflection_QueryTraceCallRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		return len(x.Args) != 0
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
-		return x.GasCap != uint64(0)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		return x.Gas != uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		return len(x.Ret) != 0
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		return x.VmError != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12223,29 +11540,19 @@ func (x *fastReflection_QueryTraceCallRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		x.Args = nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
-		x.GasCap = uint64(0)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = nil
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12255,37 +11562,22 @@ func (x *fastReflection_QueryTraceCallRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		value := x.Args
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
-		value := x.GasCap
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		value := x.Gas
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
-		value := x.ProposerAddress
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		value := x.Ret
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
-		value := x.BlockHash
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		value := x.VmError
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12299,29 +11591,19 @@ func (x *fastReflection_QueryTraceCallRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		x.Args = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
-		x.GasCap = value.Uint()
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = value.Uint()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = value.Bytes()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12335,76 +11617,48 @@ func (x *fastReflection_QueryTraceCallRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
-		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12412,7 +11666,7 @@ func (x *fastReflection_QueryTraceCallRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceCallRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12423,7 +11677,7 @@ func (x *fastReflection_QueryTraceCallRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12435,7 +11689,7 @@ func (x *fastReflection_QueryTraceCallRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceCallRequest) IsValid() bool {
+func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -12445,9 +11699,9 @@ func (x *fastReflection_QueryTraceCallRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceCallRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12459,35 +11713,17 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Args)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasCap != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasCap))
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
 		}
-		l = len(x.ProposerAddress)
+		l = len(x.Ret)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
+		l = len(x.VmError)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -12498,7 +11734,7 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceCallRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12517,69 +11753,24 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+		if len(x.VmError) > 0 {
+			i -= len(x.VmError)
+			copy(dAtA[i:], x.VmError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.GasCap != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Args) > 0 {
-			i -= len(x.Args)
-			copy(dAtA[i:], x.Args)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12592,7 +11783,7 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceCallRequest)
+		x := input.Message.Interface().(*EstimateGasResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12624,51 +11815,17 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
-				if x.Args == nil {
-					x.Args = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 				}
-				x.GasCap = 0
+				x.Gas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12678,14 +11835,14 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GasCap |= uint64(b&0x7F) << shift
+					x.Gas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -12712,69 +11869,14 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12802,63 +11904,5597 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				x.VmError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SimulateV1Request                  protoreflect.MessageDescriptor
+	fd_SimulateV1Request_opts             protoreflect.FieldDescriptor
+	fd_SimulateV1Request_gas_cap          protoreflect.FieldDescriptor
+	fd_SimulateV1Request_proposer_address protoreflect.FieldDescriptor
+	fd_SimulateV1Request_chain_id         protoreflect.FieldDescriptor
+	fd_SimulateV1Request_block_hash       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_SimulateV1Request = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("SimulateV1Request")
+	fd_SimulateV1Request_opts = md_SimulateV1Request.Fields().ByName("opts")
+	fd_SimulateV1Request_gas_cap = md_SimulateV1Request.Fields().ByName("gas_cap")
+	fd_SimulateV1Request_proposer_address = md_SimulateV1Request.Fields().ByName("proposer_address")
+	fd_SimulateV1Request_chain_id = md_SimulateV1Request.Fields().ByName("chain_id")
+	fd_SimulateV1Request_block_hash = md_SimulateV1Request.Fields().ByName("block_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_SimulateV1Request)(nil)
+
+type fastReflection_SimulateV1Request SimulateV1Request
+
+func (x *SimulateV1Request) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateV1Request)(x)
+}
+
+func (x *SimulateV1Request) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SimulateV1Request_messageType fastReflection_SimulateV1Request_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateV1Request_messageType{}
+
+type fastReflection_SimulateV1Request_messageType struct{}
+
+func (x fastReflection_SimulateV1Request_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateV1Request)(nil)
+}
+func (x fastReflection_SimulateV1Request_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateV1Request)
+}
+func (x fastReflection_SimulateV1Request_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateV1Request
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SimulateV1Request) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateV1Request
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SimulateV1Request) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateV1Request_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SimulateV1Request) New() protoreflect.Message {
+	return new(fastReflection_SimulateV1Request)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SimulateV1Request) Interface() protoreflect.ProtoMessage {
+	return (*SimulateV1Request)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SimulateV1Request) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Opts) != 0 {
+		value := protoreflect.ValueOfBytes(x.Opts)
+		if !f(fd_SimulateV1Request_opts, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_SimulateV1Request_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_SimulateV1Request_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_SimulateV1Request_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_SimulateV1Request_block_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SimulateV1Request) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		return len(x.Opts) != 0
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		return x.BlockHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Request) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		x.Opts = nil
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		x.BlockHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SimulateV1Request) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		value := x.Opts
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Request) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		x.Opts = value.Bytes()
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		x.BlockHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Request) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		panic(fmt.Errorf("field opts of message cosmos.evm.vm.v1.SimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.SimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.SimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.SimulateV1Request is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.SimulateV1Request is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SimulateV1Request) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Request.opts":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.SimulateV1Request.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.SimulateV1Request.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.SimulateV1Request.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.SimulateV1Request.block_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Request"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Request does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SimulateV1Request) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.SimulateV1Request", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SimulateV1Request) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Request) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SimulateV1Request) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SimulateV1Request) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SimulateV1Request)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Opts)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SimulateV1Request)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Opts) > 0 {
+			i -= len(x.Opts)
+			copy(dAtA[i:], x.Opts)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Opts)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SimulateV1Request)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Opts = append(x.Opts[:0], dAtA[iNdEx:postIndex]...)
+				if x.Opts == nil {
+					x.Opts = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SimulateV1Response            protoreflect.MessageDescriptor
+	fd_SimulateV1Response_data       protoreflect.FieldDescriptor
+	fd_SimulateV1Response_error_code protoreflect.FieldDescriptor
+	fd_SimulateV1Response_error      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_SimulateV1Response = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("SimulateV1Response")
+	fd_SimulateV1Response_data = md_SimulateV1Response.Fields().ByName("data")
+	fd_SimulateV1Response_error_code = md_SimulateV1Response.Fields().ByName("error_code")
+	fd_SimulateV1Response_error = md_SimulateV1Response.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_SimulateV1Response)(nil)
+
+type fastReflection_SimulateV1Response SimulateV1Response
+
+func (x *SimulateV1Response) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SimulateV1Response)(x)
+}
+
+func (x *SimulateV1Response) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SimulateV1Response_messageType fastReflection_SimulateV1Response_messageType
+var _ protoreflect.MessageType = fastReflection_SimulateV1Response_messageType{}
+
+type fastReflection_SimulateV1Response_messageType struct{}
+
+func (x fastReflection_SimulateV1Response_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SimulateV1Response)(nil)
+}
+func (x fastReflection_SimulateV1Response_messageType) New() protoreflect.Message {
+	return new(fastReflection_SimulateV1Response)
+}
+func (x fastReflection_SimulateV1Response_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateV1Response
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SimulateV1Response) Descriptor() protoreflect.MessageDescriptor {
+	return md_SimulateV1Response
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SimulateV1Response) Type() protoreflect.MessageType {
+	return _fastReflection_SimulateV1Response_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SimulateV1Response) New() protoreflect.Message {
+	return new(fastReflection_SimulateV1Response)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SimulateV1Response) Interface() protoreflect.ProtoMessage {
+	return (*SimulateV1Response)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SimulateV1Response) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_SimulateV1Response_data, value) {
+			return
+		}
+	}
+	if x.ErrorCode != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ErrorCode)
+		if !f(fd_SimulateV1Response_error_code, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_SimulateV1Response_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SimulateV1Response) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		return x.ErrorCode != int64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Response) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		x.Data = nil
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		x.ErrorCode = int64(0)
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SimulateV1Response) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		value := x.ErrorCode
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Response) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		x.ErrorCode = value.Int()
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Response) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.SimulateV1Response is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		panic(fmt.Errorf("field error_code of message cosmos.evm.vm.v1.SimulateV1Response is not mutable"))
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		panic(fmt.Errorf("field error of message cosmos.evm.vm.v1.SimulateV1Response is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SimulateV1Response) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.SimulateV1Response.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.SimulateV1Response.error_code":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.SimulateV1Response.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.SimulateV1Response"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.SimulateV1Response does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SimulateV1Response) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.SimulateV1Response", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SimulateV1Response) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SimulateV1Response) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SimulateV1Response) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SimulateV1Response) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SimulateV1Response)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ErrorCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SimulateV1Response)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ErrorCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SimulateV1Response)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				}
+				x.ErrorCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ErrorCode |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+
+type _QueryTraceTxRequest_4_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceTxRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
+	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
+	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
+	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
+	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
+	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
+	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
+	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
+	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+
+type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+
+func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(x)
+}
+
+func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+
+type fastReflection_QueryTraceTxRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(nil)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_msg, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_trace_config, value) {
+			return
+		}
+	}
+	if len(x.Predecessors) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
+		if !f(fd_QueryTraceTxRequest_predecessors, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceTxRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceTxRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		return x.Msg != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		return len(x.Predecessors) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		x.Predecessors = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if len(x.Predecessors) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
+		}
+		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = value.Message().Interface().(*MsgEthereumTx)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		lv := value.List()
+		clv := lv.(*_QueryTraceTxRequest_4_list)
+		x.Predecessors = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		if x.Msg == nil {
+			x.Msg = new(MsgEthereumTx)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if x.Predecessors == nil {
+			x.Predecessors = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		m := new(MsgEthereumTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Predecessors) > 0 {
+			for _, e := range x.Predecessors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Predecessors) > 0 {
+			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Predecessors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &MsgEthereumTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
+	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+
+type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+
+func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(x)
+}
+
+func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+
+type fastReflection_QueryTraceTxResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(nil)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceTxResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
+
+type _QueryTraceBlockRequest_1_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
+	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
+	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
+	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
+	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
+	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
+	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
+	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+
+type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+
+func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(x)
+}
+
+func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+
+type fastReflection_QueryTraceBlockRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(nil)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
+		if !f(fd_QueryTraceBlockRequest_txs, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceBlockRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		return len(x.Txs) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		x.Txs = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
+		}
+		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		lv := value.List()
+		clv := lv.(*_QueryTraceBlockRequest_1_list)
+		x.Txs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if x.Txs == nil {
+			x.Txs = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
+	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+
+type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+
+func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(x)
+}
+
+func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+
+type fastReflection_QueryTraceBlockResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(nil)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceBlockResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceCallRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceCallRequest_args             protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceCallRequest_chain_id         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallRequest")
+	fd_QueryTraceCallRequest_args = md_QueryTraceCallRequest.Fields().ByName("args")
+	fd_QueryTraceCallRequest_gas_cap = md_QueryTraceCallRequest.Fields().ByName("gas_cap")
+	fd_QueryTraceCallRequest_proposer_address = md_QueryTraceCallRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceCallRequest_trace_config = md_QueryTraceCallRequest.Fields().ByName("trace_config")
+	fd_QueryTraceCallRequest_block_number = md_QueryTraceCallRequest.Fields().ByName("block_number")
+	fd_QueryTraceCallRequest_block_hash = md_QueryTraceCallRequest.Fields().ByName("block_hash")
+	fd_QueryTraceCallRequest_block_time = md_QueryTraceCallRequest.Fields().ByName("block_time")
+	fd_QueryTraceCallRequest_chain_id = md_QueryTraceCallRequest.Fields().ByName("chain_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceCallRequest)(nil)
+
+type fastReflection_QueryTraceCallRequest QueryTraceCallRequest
+
+func (x *QueryTraceCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallRequest)(x)
+}
+
+func (x *QueryTraceCallRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceCallRequest_messageType fastReflection_QueryTraceCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceCallRequest_messageType{}
+
+type fastReflection_QueryTraceCallRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallRequest)(nil)
+}
+func (x fastReflection_QueryTraceCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallRequest)
+}
+func (x fastReflection_QueryTraceCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceCallRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceCallRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceCallRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_QueryTraceCallRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_QueryTraceCallRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceCallRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceCallRequest_trace_config, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceCallRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceCallRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceCallRequest_block_time, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceCallRequest_chain_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		return x.ChainId != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		x.ChainId = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		x.Args = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		x.ChainId = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceCallRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.args":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceCallRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceCallRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceCallRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceCallResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceCallResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceCallResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallResponse")
+	fd_QueryTraceCallResponse_data = md_QueryTraceCallResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceCallResponse)(nil)
+
+type fastReflection_QueryTraceCallResponse QueryTraceCallResponse
+
+func (x *QueryTraceCallResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallResponse)(x)
+}
+
+func (x *QueryTraceCallResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceCallResponse_messageType fastReflection_QueryTraceCallResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceCallResponse_messageType{}
+
+type fastReflection_QueryTraceCallResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceCallResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceCallResponse)(nil)
+}
+func (x fastReflection_QueryTraceCallResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallResponse)
+}
+func (x fastReflection_QueryTraceCallResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceCallResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceCallResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceCallResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceCallResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceCallResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceCallResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceCallResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceCallResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceCallResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceCallResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceCallResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceCallResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceCallResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceCallResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceCallResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceCallResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceCallResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceCallResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceCallResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryBaseFeeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+
+type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+
+func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(x)
+}
+
+func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+
+type fastReflection_QueryBaseFeeRequest_messageType struct{}
+
+func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(nil)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12895,26 +17531,26 @@ func (x *fastReflection_QueryTraceCallRequest) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryTraceCallResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceCallResponse_data protoreflect.FieldDescriptor
+	md_QueryBaseFeeResponse          protoreflect.MessageDescriptor
+	fd_QueryBaseFeeResponse_base_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceCallResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceCallResponse")
-	fd_QueryTraceCallResponse_data = md_QueryTraceCallResponse.Fields().ByName("data")
+	md_QueryBaseFeeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
+	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceCallResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
 
-type fastReflection_QueryTraceCallResponse QueryTraceCallResponse
+type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
 
-func (x *QueryTraceCallResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceCallResponse)(x)
+func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(x)
 }
 
-func (x *QueryTraceCallResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12925,43 +17561,43 @@ func (x *QueryTraceCallResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceCallResponse_messageType fastReflection_QueryTraceCallResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceCallResponse_messageType{}
+var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
 
-type fastReflection_QueryTraceCallResponse_messageType struct{}
+type fastReflection_QueryBaseFeeResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceCallResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceCallResponse)(nil)
+func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(nil)
 }
-func (x fastReflection_QueryTraceCallResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceCallResponse)
+func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
 }
-func (x fastReflection_QueryTraceCallResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceCallResponse
+func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceCallResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceCallResponse
+func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceCallResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceCallResponse_messageType
+func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceCallResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceCallResponse)
+func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceCallResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceCallResponse)(x)
+func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12969,10 +17605,10 @@ func (x *fastReflection_QueryTraceCallResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceCallResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceCallResponse_data, value) {
+func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
 			return
 		}
 	}
@@ -12989,15 +17625,15 @@ func (x *fastReflection_QueryTraceCallResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceCallResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		return x.BaseFee != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13007,15 +17643,15 @@ func (x *fastReflection_QueryTraceCallResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13025,16 +17661,16 @@ func (x *fastReflection_QueryTraceCallResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceCallResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13048,15 +17684,15 @@ func (x *fastReflection_QueryTraceCallResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13070,40 +17706,40 @@ func (x *fastReflection_QueryTraceCallResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceCallResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		panic(fmt.Errorf("field base_fee of message cosmos.evm.vm.v1.QueryBaseFeeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceCallResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceCallResponse.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceCallResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceCallResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceCallResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceCallResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13111,7 +17747,7 @@ func (x *fastReflection_QueryTraceCallResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceCallResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13122,7 +17758,7 @@ func (x *fastReflection_QueryTraceCallResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceCallResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13134,7 +17770,7 @@ func (x *fastReflection_QueryTraceCallResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceCallResponse) IsValid() bool {
+func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13144,9 +17780,9 @@ func (x *fastReflection_QueryTraceCallResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceCallResponse)
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13158,7 +17794,7 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
+		l = len(x.BaseFee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -13172,7 +17808,7 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceCallResponse)
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13191,10 +17827,10 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -13209,7 +17845,7 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceCallResponse)
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13241,17 +17877,17 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13261,25 +17897,23 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
-				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -13317,24 +17951,24 @@ func (x *fastReflection_QueryTraceCallResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+	md_QueryGlobalMinGasPriceRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryBaseFeeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+	md_QueryGlobalMinGasPriceRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
 
-type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+type fastReflection_QueryGlobalMinGasPriceRequest QueryGlobalMinGasPriceRequest
 
-func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(x)
+func (x *QueryGlobalMinGasPriceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceRequest)(x)
 }
 
-func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13345,43 +17979,43 @@ func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+var _fastReflection_QueryGlobalMinGasPriceRequest_messageType fastReflection_QueryGlobalMinGasPriceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceRequest_messageType{}
 
-type fastReflection_QueryBaseFeeRequest_messageType struct{}
+type fastReflection_QueryGlobalMinGasPriceRequest_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(nil)
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceRequest)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeRequest_messageType
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGlobalMinGasPriceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeRequest)(x)
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGlobalMinGasPriceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -13389,7 +18023,7 @@ func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -13403,13 +18037,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13419,13 +18053,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13435,13 +18069,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13455,13 +18089,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13475,36 +18109,36 @@ func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13512,7 +18146,7 @@ func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13523,7 +18157,7 @@ func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13535,7 +18169,7 @@ func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -13545,9 +18179,9 @@ func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13569,7 +18203,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13599,7 +18233,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13631,10 +18265,10 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -13673,26 +18307,26 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBaseFeeResponse          protoreflect.MessageDescriptor
-	fd_QueryBaseFeeResponse_base_fee protoreflect.FieldDescriptor
+	md_QueryGlobalMinGasPriceResponse               protoreflect.MessageDescriptor
+	fd_QueryGlobalMinGasPriceResponse_min_gas_price protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryBaseFeeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
-	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
+	md_QueryGlobalMinGasPriceResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceResponse")
+	fd_QueryGlobalMinGasPriceResponse_min_gas_price = md_QueryGlobalMinGasPriceResponse.Fields().ByName("min_gas_price")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
 
-type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
+type fastReflection_QueryGlobalMinGasPriceResponse QueryGlobalMinGasPriceResponse
 
-func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(x)
+func (x *QueryGlobalMinGasPriceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceResponse)(x)
 }
 
-func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13703,43 +18337,43 @@ func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
+var _fastReflection_QueryGlobalMinGasPriceResponse_messageType fastReflection_QueryGlobalMinGasPriceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceResponse_messageType{}
 
-type fastReflection_QueryBaseFeeResponse_messageType struct{}
+type fastReflection_QueryGlobalMinGasPriceResponse_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(nil)
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceResponse)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeResponse_messageType
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGlobalMinGasPriceResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceResponse)
 }
 
 // Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeResponse)(x)
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGlobalMinGasPriceResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -13747,10 +18381,10 @@ func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseFee != "" {
-		value := protoreflect.ValueOfString(x.BaseFee)
-		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MinGasPrice)
+		if !f(fd_QueryGlobalMinGasPriceResponse_min_gas_price, value) {
 			return
 		}
 	}
@@ -13767,15 +18401,15 @@ func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		return x.BaseFee != ""
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		return x.MinGasPrice != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13785,15 +18419,15 @@ func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = ""
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		x.MinGasPrice = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13803,16 +18437,16 @@ func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		value := x.BaseFee
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		value := x.MinGasPrice
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13826,15 +18460,15 @@ func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		x.MinGasPrice = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13848,40 +18482,40 @@ func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		panic(fmt.Errorf("field base_fee of message cosmos.evm.vm.v1.QueryBaseFeeResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13889,7 +18523,7 @@ func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13900,7 +18534,7 @@ func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13912,7 +18546,7 @@ func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13922,9 +18556,9 @@ func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13936,7 +18570,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseFee)
+		l = len(x.MinGasPrice)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -13950,7 +18584,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13969,10 +18603,10 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BaseFee) > 0 {
-			i -= len(x.BaseFee)
-			copy(dAtA[i:], x.BaseFee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+		if len(x.MinGasPrice) > 0 {
+			i -= len(x.MinGasPrice)
+			copy(dAtA[i:], x.MinGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -13987,7 +18621,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14019,15 +18653,15 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -14055,7 +18689,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -14093,24 +18727,26 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryGlobalMinGasPriceRequest protoreflect.MessageDescriptor
+	md_QueryTxStatusRequest      protoreflect.MessageDescriptor
+	fd_QueryTxStatusRequest_hash protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryGlobalMinGasPriceRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceRequest")
+	md_QueryTxStatusRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxStatusRequest")
+	fd_QueryTxStatusRequest_hash = md_QueryTxStatusRequest.Fields().ByName("hash")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxStatusRequest)(nil)
 
-type fastReflection_QueryGlobalMinGasPriceRequest QueryGlobalMinGasPriceRequest
+type fastReflection_QueryTxStatusRequest QueryTxStatusRequest
 
-func (x *QueryGlobalMinGasPriceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceRequest)(x)
+func (x *QueryTxStatusRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxStatusRequest)(x)
 }
 
-func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
+func (x *QueryTxStatusRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14121,43 +18757,43 @@ func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGlobalMinGasPriceRequest_messageType fastReflection_QueryGlobalMinGasPriceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceRequest_messageType{}
+var _fastReflection_QueryTxStatusRequest_messageType fastReflection_QueryTxStatusRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxStatusRequest_messageType{}
 
-type fastReflection_QueryGlobalMinGasPriceRequest_messageType struct{}
+type fastReflection_QueryTxStatusRequest_messageType struct{}
 
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+func (x fastReflection_QueryTxStatusRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxStatusRequest)(nil)
 }
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+func (x fastReflection_QueryTxStatusRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxStatusRequest)
 }
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceRequest
+func (x fastReflection_QueryTxStatusRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxStatusRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceRequest
+func (x *fastReflection_QueryTxStatusRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxStatusRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGlobalMinGasPriceRequest_messageType
+func (x *fastReflection_QueryTxStatusRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxStatusRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+func (x *fastReflection_QueryTxStatusRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxStatusRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGlobalMinGasPriceRequest)(x)
+func (x *fastReflection_QueryTxStatusRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxStatusRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -14165,7 +18801,13 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryTxStatusRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_QueryTxStatusRequest_hash, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -14179,13 +18821,15 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxStatusRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		return x.Hash != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14195,13 +18839,15 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxStatusRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		x.Hash = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14211,13 +18857,16 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -14231,13 +18880,15 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxStatusRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		x.Hash = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14251,36 +18902,40 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxStatusRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxStatusRequest.hash":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxStatusRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxStatusRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -14288,7 +18943,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxStatusRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -14299,7 +18954,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxStatusRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -14311,7 +18966,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
+func (x *fastReflection_QueryTxStatusRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -14321,9 +18976,9 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxStatusRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryTxStatusRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14335,6 +18990,10 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -14345,7 +19004,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryTxStatusRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14364,6 +19023,13 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -14375,7 +19041,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryTxStatusRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14407,12 +19073,44 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxStatusRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -14449,26 +19147,40 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 }
 
 var (
-	md_QueryGlobalMinGasPriceResponse               protoreflect.MessageDescriptor
-	fd_QueryGlobalMinGasPriceResponse_min_gas_price protoreflect.FieldDescriptor
+	md_QueryTxStatusResponse                protoreflect.MessageDescriptor
+	fd_QueryTxStatusResponse_status         protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_nonce          protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_expected_nonce protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_base_fee       protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_replaced_by    protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_reason         protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_block_number   protoreflect.FieldDescriptor
+	fd_QueryTxStatusResponse_removed_at     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryGlobalMinGasPriceResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceResponse")
-	fd_QueryGlobalMinGasPriceResponse_min_gas_price = md_QueryGlobalMinGasPriceResponse.Fields().ByName("min_gas_price")
+	md_QueryTxStatusResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxStatusResponse")
+	fd_QueryTxStatusResponse_status = md_QueryTxStatusResponse.Fields().ByName("status")
+	fd_QueryTxStatusResponse_nonce = md_QueryTxStatusResponse.Fields().ByName("nonce")
+	fd_QueryTxStatusResponse_expected_nonce = md_QueryTxStatusResponse.Fields().ByName("expected_nonce")
+	fd_QueryTxStatusResponse_base_fee = md_QueryTxStatusResponse.Fields().ByName("base_fee")
+	fd_QueryTxStatusResponse_replaced_by = md_QueryTxStatusResponse.Fields().ByName("replaced_by")
+	fd_QueryTxStatusResponse_reason = md_QueryTxStatusResponse.Fields().ByName("reason")
+	fd_QueryTxStatusResponse_block_number = md_QueryTxStatusResponse.Fields().ByName("block_number")
+	fd_QueryTxStatusResponse_removed_at = md_QueryTxStatusResponse.Fields().ByName("removed_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxStatusResponse)(nil)
 
-type fastReflection_QueryGlobalMinGasPriceResponse QueryGlobalMinGasPriceResponse
+type fastReflection_QueryTxStatusResponse QueryTxStatusResponse
 
-func (x *QueryGlobalMinGasPriceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceResponse)(x)
+func (x *QueryTxStatusResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxStatusResponse)(x)
 }
 
-func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
+func (x *QueryTxStatusResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14479,43 +19191,43 @@ func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGlobalMinGasPriceResponse_messageType fastReflection_QueryGlobalMinGasPriceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceResponse_messageType{}
+var _fastReflection_QueryTxStatusResponse_messageType fastReflection_QueryTxStatusResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxStatusResponse_messageType{}
 
-type fastReflection_QueryGlobalMinGasPriceResponse_messageType struct{}
+type fastReflection_QueryTxStatusResponse_messageType struct{}
 
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+func (x fastReflection_QueryTxStatusResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxStatusResponse)(nil)
 }
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+func (x fastReflection_QueryTxStatusResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxStatusResponse)
 }
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceResponse
+func (x fastReflection_QueryTxStatusResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxStatusResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceResponse
+func (x *fastReflection_QueryTxStatusResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxStatusResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGlobalMinGasPriceResponse_messageType
+func (x *fastReflection_QueryTxStatusResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxStatusResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+func (x *fastReflection_QueryTxStatusResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxStatusResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGlobalMinGasPriceResponse)(x)
+func (x *fastReflection_QueryTxStatusResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxStatusResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -14523,10 +19235,52 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MinGasPrice != "" {
-		value := protoreflect.ValueOfString(x.MinGasPrice)
-		if !f(fd_QueryGlobalMinGasPriceResponse_min_gas_price, value) {
+func (x *fastReflection_QueryTxStatusResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_QueryTxStatusResponse_status, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_QueryTxStatusResponse_nonce, value) {
+			return
+		}
+	}
+	if x.ExpectedNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpectedNonce)
+		if !f(fd_QueryTxStatusResponse_expected_nonce, value) {
+			return
+		}
+	}
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_QueryTxStatusResponse_base_fee, value) {
+			return
+		}
+	}
+	if x.ReplacedBy != "" {
+		value := protoreflect.ValueOfString(x.ReplacedBy)
+		if !f(fd_QueryTxStatusResponse_replaced_by, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_QueryTxStatusResponse_reason, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_QueryTxStatusResponse_block_number, value) {
+			return
+		}
+	}
+	if x.RemovedAt != nil {
+		value := protoreflect.ValueOfMessage(x.RemovedAt.ProtoReflect())
+		if !f(fd_QueryTxStatusResponse_removed_at, value) {
 			return
 		}
 	}
@@ -14543,15 +19297,29 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxStatusResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		return x.MinGasPrice != ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		return x.Status != ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		return x.Nonce != uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		return x.ExpectedNonce != uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
+		return x.BaseFee != ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		return x.ReplacedBy != ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		return x.Reason != ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		return x.RemovedAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14561,15 +19329,29 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxStatusResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		x.MinGasPrice = ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		x.Status = ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		x.Nonce = uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		x.ExpectedNonce = uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
+		x.BaseFee = ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		x.ReplacedBy = ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		x.Reason = ""
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		x.RemovedAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14579,16 +19361,37 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		value := x.MinGasPrice
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		value := x.ExpectedNonce
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
+		value := x.BaseFee
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		value := x.ReplacedBy
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		value := x.RemovedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -14602,15 +19405,29 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxStatusResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		x.MinGasPrice = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		x.Status = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		x.Nonce = value.Uint()
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		x.ExpectedNonce = value.Uint()
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
+		x.BaseFee = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		x.ReplacedBy = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		x.Reason = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		x.RemovedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -14624,40 +19441,72 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		if x.RemovedAt == nil {
+			x.RemovedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.RemovedAt.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		panic(fmt.Errorf("field status of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		panic(fmt.Errorf("field nonce of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		panic(fmt.Errorf("field expected_nonce of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
+		panic(fmt.Errorf("field base_fee of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		panic(fmt.Errorf("field replaced_by of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		panic(fmt.Errorf("field reason of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTxStatusResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxStatusResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.status":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.expected_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.base_fee":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.replaced_by":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.reason":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxStatusResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxStatusResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxStatusResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxStatusResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -14665,7 +19514,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxStatusResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -14676,7 +19525,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxStatusResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -14688,7 +19537,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
+func (x *fastReflection_QueryTxStatusResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -14698,9 +19547,9 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxStatusResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryTxStatusResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14712,10 +19561,35 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.MinGasPrice)
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.ExpectedNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpectedNonce))
+		}
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReplacedBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		if x.RemovedAt != nil {
+			l = options.Size(x.RemovedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -14726,7 +19600,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryTxStatusResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14745,10 +19619,60 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinGasPrice) > 0 {
-			i -= len(x.MinGasPrice)
-			copy(dAtA[i:], x.MinGasPrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
+		if x.RemovedAt != nil {
+			encoded, err := options.Marshal(x.RemovedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ReplacedBy) > 0 {
+			i -= len(x.ReplacedBy)
+			copy(dAtA[i:], x.ReplacedBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReplacedBy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ExpectedNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpectedNonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -14763,7 +19687,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryTxStatusResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -14795,15 +19719,149 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxStatusResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpectedNonce", wireType)
+				}
+				x.ExpectedNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpectedNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReplacedBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReplacedBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -14831,7 +19889,62 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RemovedAt == nil {
+					x.RemovedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RemovedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -15255,205 +20368,450 @@ func (*QueryBalanceRequest) Descriptor() ([]byte, []int) {
 	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryBalanceRequest) GetAddress() string {
+func (x *QueryBalanceRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryBalanceResponse is the response type for the Query/Balance RPC method.
+type QueryBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// balance is the balance of the EVM denomination.
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *QueryBalanceResponse) Reset() {
+	*x = QueryBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBalanceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBalanceResponse.ProtoReflect.Descriptor instead.
+func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryBalanceResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+// QueryStorageRequest is the request type for the Query/Storage RPC method.
+type QueryStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address to query the storage state for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// key defines the key of the storage state
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *QueryStorageRequest) Reset() {
+	*x = QueryStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageRequest.ProtoReflect.Descriptor instead.
+func (*QueryStorageRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryStorageRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryStorageRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// QueryStorageResponse is the response type for the Query/Storage RPC
+// method.
+type QueryStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value defines the storage state value hash associated with the given key.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *QueryStorageResponse) Reset() {
+	*x = QueryStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStorageResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryStorageResponse.ProtoReflect.Descriptor instead.
+func (*QueryStorageResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryStorageResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// QueryCodeRequest is the request type for the Query/Code RPC method.
+type QueryCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address to query the code for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryCodeRequest) Reset() {
+	*x = QueryCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCodeRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
+func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryCodeRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryCodeResponse is the response type for the Query/Code RPC
+// method.
+type QueryCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code represents the code bytes from an ethereum address.
+	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *QueryCodeResponse) Reset() {
+	*x = QueryCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCodeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCodeResponse.ProtoReflect.Descriptor instead.
+func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryCodeResponse) GetCode() []byte {
 	if x != nil {
-		return x.Address
+		return x.Code
 	}
-	return ""
+	return nil
 }
 
-// QueryBalanceResponse is the response type for the Query/Balance RPC method.
-type QueryBalanceResponse struct {
+// QueryStorageRangeRequest is the request type for the Query/StorageRange RPC
+// method.
+type QueryStorageRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// balance is the balance of the EVM denomination.
-	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// address is the ethereum hex address to query the storage state for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request, the key is the
+	// storage slot key to start from.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryBalanceResponse) Reset() {
-	*x = QueryBalanceResponse{}
+func (x *QueryStorageRangeRequest) Reset() {
+	*x = QueryStorageRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBalanceResponse) String() string {
+func (x *QueryStorageRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBalanceResponse) ProtoMessage() {}
+func (*QueryStorageRangeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryBalanceResponse.ProtoReflect.Descriptor instead.
-func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use QueryStorageRangeRequest.ProtoReflect.Descriptor instead.
+func (*QueryStorageRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryBalanceResponse) GetBalance() string {
+func (x *QueryStorageRangeRequest) GetAddress() string {
 	if x != nil {
-		return x.Balance
+		return x.Address
 	}
 	return ""
 }
 
-// QueryStorageRequest is the request type for the Query/Storage RPC method.
-type QueryStorageRequest struct {
+func (x *QueryStorageRangeRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryStorageRangeResponse is the response type for the Query/StorageRange
+// RPC method.
+type QueryStorageRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the ethereum hex address to query the storage state for.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// key defines the key of the storage state
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// storage defines the storage slots of the contract.
+	Storage []*State `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryStorageRequest) Reset() {
-	*x = QueryStorageRequest{}
+func (x *QueryStorageRangeResponse) Reset() {
+	*x = QueryStorageRangeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[10]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStorageRequest) String() string {
+func (x *QueryStorageRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStorageRequest) ProtoMessage() {}
+func (*QueryStorageRangeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryStorageRequest.ProtoReflect.Descriptor instead.
-func (*QueryStorageRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryStorageRangeResponse.ProtoReflect.Descriptor instead.
+func (*QueryStorageRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryStorageRequest) GetAddress() string {
+func (x *QueryStorageRangeResponse) GetStorage() []*State {
 	if x != nil {
-		return x.Address
+		return x.Storage
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryStorageRequest) GetKey() string {
+func (x *QueryStorageRangeResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.Key
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryStorageResponse is the response type for the Query/Storage RPC
+// QueryContractsRequest is the request type for the Query/Contracts RPC
 // method.
-type QueryStorageResponse struct {
+type QueryContractsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// value defines the storage state value hash associated with the given key.
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// pagination defines an optional pagination for the request, the key is the
+	// contract address to start from.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryStorageResponse) Reset() {
-	*x = QueryStorageResponse{}
+func (x *QueryContractsRequest) Reset() {
+	*x = QueryContractsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[11]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStorageResponse) String() string {
+func (x *QueryContractsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStorageResponse) ProtoMessage() {}
+func (*QueryContractsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryStorageResponse.ProtoReflect.Descriptor instead.
-func (*QueryStorageResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QueryContractsRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractsRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryStorageResponse) GetValue() string {
+func (x *QueryContractsRequest) GetPagination() *v1beta1.PageRequest {
 	if x != nil {
-		return x.Value
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryCodeRequest is the request type for the Query/Code RPC method.
-type QueryCodeRequest struct {
+// ContractAccount defines the state of an account holding code.
+type ContractAccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// address is the ethereum hex address to query the code for.
+	// address is the ethereum hex address of the account.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the EVM denomination.
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// nonce is the account's sequence number.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// code_hash is the hex hash of the account's code.
+	CodeHash string `protobuf:"bytes,4,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
-func (x *QueryCodeRequest) Reset() {
-	*x = QueryCodeRequest{}
+func (x *ContractAccount) Reset() {
+	*x = ContractAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCodeRequest) String() string {
+func (x *ContractAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCodeRequest) ProtoMessage() {}
+func (*ContractAccount) ProtoMessage() {}
 
-// Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
-func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ContractAccount.ProtoReflect.Descriptor instead.
+func (*ContractAccount) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryCodeRequest) GetAddress() string {
+func (x *ContractAccount) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-// QueryCodeResponse is the response type for the Query/Code RPC
+func (x *ContractAccount) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *ContractAccount) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ContractAccount) GetCodeHash() string {
+	if x != nil {
+		return x.CodeHash
+	}
+	return ""
+}
+
+// QueryContractsResponse is the response type for the Query/Contracts RPC
 // method.
-type QueryCodeResponse struct {
+type QueryContractsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// code represents the code bytes from an ethereum address.
-	Code []byte `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// contracts defines the accounts holding code.
+	Contracts []*ContractAccount `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryCodeResponse) Reset() {
-	*x = QueryCodeResponse{}
+func (x *QueryContractsResponse) Reset() {
+	*x = QueryContractsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCodeResponse) String() string {
+func (x *QueryContractsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCodeResponse) ProtoMessage() {}
+func (*QueryContractsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryCodeResponse.ProtoReflect.Descriptor instead.
-func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryContractsResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryCodeResponse) GetCode() []byte {
+func (x *QueryContractsResponse) GetContracts() []*ContractAccount {
 	if x != nil {
-		return x.Code
+		return x.Contracts
+	}
+	return nil
+}
+
+func (x *QueryContractsResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -15473,7 +20831,7 @@ type QueryTxLogsRequest struct {
 func (x *QueryTxLogsRequest) Reset() {
 	*x = QueryTxLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15487,7 +20845,7 @@ func (*QueryTxLogsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryTxLogsRequest) GetHash() string {
@@ -15519,7 +20877,7 @@ type QueryTxLogsResponse struct {
 func (x *QueryTxLogsResponse) Reset() {
 	*x = QueryTxLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15533,7 +20891,7 @@ func (*QueryTxLogsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryTxLogsResponse) GetLogs() []*Log {
@@ -15560,7 +20918,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15574,7 +20932,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 // QueryParamsResponse defines the response type for querying x/vm parameters.
@@ -15590,7 +20948,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15604,7 +20962,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -15635,7 +20993,7 @@ type EthCallRequest struct {
 func (x *EthCallRequest) Reset() {
 	*x = EthCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15649,7 +21007,7 @@ func (*EthCallRequest) ProtoMessage() {}
 
 // Deprecated: Use EthCallRequest.ProtoReflect.Descriptor instead.
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *EthCallRequest) GetArgs() []byte {
@@ -15705,7 +21063,7 @@ type EstimateGasResponse struct {
 func (x *EstimateGasResponse) Reset() {
 	*x = EstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15719,7 +21077,7 @@ func (*EstimateGasResponse) ProtoMessage() {}
 
 // Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EstimateGasResponse) GetGas() uint64 {
@@ -15733,12 +21091,142 @@ func (x *EstimateGasResponse) GetRet() []byte {
 	if x != nil {
 		return x.Ret
 	}
-	return nil
+	return nil
+}
+
+func (x *EstimateGasResponse) GetVmError() string {
+	if x != nil {
+		return x.VmError
+	}
+	return ""
+}
+
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// opts uses the same json format as the `eth_simulateV1` rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used for each call
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the base block in hex format
+	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the base block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_hash (hex) of the base block, used as the parent of the first
+	// simulated block
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+}
+
+func (x *SimulateV1Request) Reset() {
+	*x = SimulateV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateV1Request) ProtoMessage() {}
+
+// Deprecated: Use SimulateV1Request.ProtoReflect.Descriptor instead.
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SimulateV1Request) GetOpts() []byte {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+func (x *SimulateV1Request) GetGasCap() uint64 {
+	if x != nil {
+		return x.GasCap
+	}
+	return 0
+}
+
+func (x *SimulateV1Request) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *SimulateV1Request) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SimulateV1Request) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// error_code is the json-rpc error code of the failure that aborted the
+	// simulation, if any
+	ErrorCode int64 `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// error is the reason the simulation was aborted, if any
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SimulateV1Response) Reset() {
+	*x = SimulateV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateV1Response) ProtoMessage() {}
+
+// Deprecated: Use SimulateV1Response.ProtoReflect.Descriptor instead.
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimulateV1Response) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SimulateV1Response) GetErrorCode() int64 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
 }
 
-func (x *EstimateGasResponse) GetVmError() string {
+func (x *SimulateV1Response) GetError() string {
 	if x != nil {
-		return x.VmError
+		return x.Error
 	}
 	return ""
 }
@@ -15773,7 +21261,7 @@ type QueryTraceTxRequest struct {
 func (x *QueryTraceTxRequest) Reset() {
 	*x = QueryTraceTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15787,7 +21275,7 @@ func (*QueryTraceTxRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
@@ -15866,7 +21354,7 @@ type QueryTraceTxResponse struct {
 func (x *QueryTraceTxResponse) Reset() {
 	*x = QueryTraceTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15880,7 +21368,7 @@ func (*QueryTraceTxResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryTraceTxResponse) GetData() []byte {
@@ -15917,7 +21405,7 @@ type QueryTraceBlockRequest struct {
 func (x *QueryTraceBlockRequest) Reset() {
 	*x = QueryTraceBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15931,7 +21419,7 @@ func (*QueryTraceBlockRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
@@ -16003,7 +21491,7 @@ type QueryTraceBlockResponse struct {
 func (x *QueryTraceBlockResponse) Reset() {
 	*x = QueryTraceBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16017,7 +21505,7 @@ func (*QueryTraceBlockResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *QueryTraceBlockResponse) GetData() []byte {
@@ -16054,7 +21542,7 @@ type QueryTraceCallRequest struct {
 func (x *QueryTraceCallRequest) Reset() {
 	*x = QueryTraceCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16068,7 +21556,7 @@ func (*QueryTraceCallRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceCallRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryTraceCallRequest) GetArgs() []byte {
@@ -16140,7 +21628,7 @@ type QueryTraceCallResponse struct {
 func (x *QueryTraceCallResponse) Reset() {
 	*x = QueryTraceCallResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16154,7 +21642,7 @@ func (*QueryTraceCallResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceCallResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QueryTraceCallResponse) GetData() []byte {
@@ -16175,7 +21663,7 @@ type QueryBaseFeeRequest struct {
 func (x *QueryBaseFeeRequest) Reset() {
 	*x = QueryBaseFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16189,7 +21677,7 @@ func (*QueryBaseFeeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeRequest.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 // QueryBaseFeeResponse returns the EIP1559 base fee.
@@ -16205,7 +21693,7 @@ type QueryBaseFeeResponse struct {
 func (x *QueryBaseFeeResponse) Reset() {
 	*x = QueryBaseFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16219,7 +21707,7 @@ func (*QueryBaseFeeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryBaseFeeResponse) GetBaseFee() string {
@@ -16240,7 +21728,7 @@ type QueryGlobalMinGasPriceRequest struct {
 func (x *QueryGlobalMinGasPriceRequest) Reset() {
 	*x = QueryGlobalMinGasPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16254,7 +21742,7 @@ func (*QueryGlobalMinGasPriceRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryGlobalMinGasPriceRequest.ProtoReflect.Descriptor instead.
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{28}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{35}
 }
 
 // QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
@@ -16270,7 +21758,7 @@ type QueryGlobalMinGasPriceResponse struct {
 func (x *QueryGlobalMinGasPriceResponse) Reset() {
 	*x = QueryGlobalMinGasPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -16284,7 +21772,7 @@ func (*QueryGlobalMinGasPriceResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryGlobalMinGasPriceResponse.ProtoReflect.Descriptor instead.
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{29}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *QueryGlobalMinGasPriceResponse) GetMinGasPrice() string {
@@ -16294,6 +21782,146 @@ func (x *QueryGlobalMinGasPriceResponse) GetMinGasPrice() string {
 	return ""
 }
 
+// QueryTxStatusRequest is the request type for the Query/TxStatus RPC method.
+type QueryTxStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hash is the ethereum hex hash of the transaction.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *QueryTxStatusRequest) Reset() {
+	*x = QueryTxStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxStatusRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTxStatusRequest.ProtoReflect.Descriptor instead.
+func (*QueryTxStatusRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueryTxStatusRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// QueryTxStatusResponse is the response type for the Query/TxStatus RPC
+// method.
+type QueryTxStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status is the stage of its lifecycle the transaction is in: "pending",
+	// "queued", "underpriced", "replaced", "evicted", "included" or "unknown".
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// nonce is the nonce of the transaction, if it's in the mempool.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// expected_nonce is the nonce of the next transaction of the sender the
+	// mempool expects, if the transaction is queued.
+	ExpectedNonce uint64 `protobuf:"varint,3,opt,name=expected_nonce,json=expectedNonce,proto3" json:"expected_nonce,omitempty"`
+	// base_fee is the current base fee, if the transaction is underpriced.
+	BaseFee string `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// replaced_by is the hash of the transaction that replaced it.
+	ReplacedBy string `protobuf:"bytes,5,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	// reason is why the transaction was evicted.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block_number is the number of the block the transaction was included in.
+	BlockNumber uint64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// removed_at is when the transaction exited the mempool.
+	RemovedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+}
+
+func (x *QueryTxStatusResponse) Reset() {
+	*x = QueryTxStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTxStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTxStatusResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTxStatusResponse.ProtoReflect.Descriptor instead.
+func (*QueryTxStatusResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryTxStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryTxStatusResponse) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *QueryTxStatusResponse) GetExpectedNonce() uint64 {
+	if x != nil {
+		return x.ExpectedNonce
+	}
+	return 0
+}
+
+func (x *QueryTxStatusResponse) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+func (x *QueryTxStatusResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *QueryTxStatusResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QueryTxStatusResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *QueryTxStatusResponse) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
 var File_cosmos_evm_vm_v1_query_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
@@ -16375,213 +22003,318 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
 	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x27, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x7a, 0x0a,
-	0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0xd5, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12,
-	0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72,
-	0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x04,
-	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x72,
-	0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54,
-	0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
-	0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d,
-	0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52,
-	0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x86, 0x01,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xad, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x14,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0xad, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x7a, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x89, 0x01,
+	0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x52, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73,
+	0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa,
+	0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x13, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x67, 0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xd9, 0x01, 0x0a, 0x11, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61,
+	0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32,
+	0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x5d, 0x0a,
+	0x12, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x04, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03,
-	0x74, 0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x74,
+	0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x22, 0x2d,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x03,
+	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61,
+	0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32,
+	0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
 	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
 	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
-	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f,
-	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x22,
-	0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87,
-	0x03, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07,
-	0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67,
-	0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
-	0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
-	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
-	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61,
-	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
-	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x32, 0x91, 0x10, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x07,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x34, 0x12, 0x32, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12,
-	0x8b, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xbf, 0x02, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xda,
+	0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x74, 0x32, 0xbe,
+	0x14, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x7a, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12,
+	0x32, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8b, 0x01, 0x0a,
+	0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12,
+	0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x7a, 0x0a, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x77, 0x0a, 0x06, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
 	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
@@ -16604,7 +22337,15 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x7c, 0x0a, 0x07,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x7e, 0x0a, 0x0a,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x31, 0x12, 0x7c, 0x0a, 0x07,
 	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
@@ -16655,18 +22396,27 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
 	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
 	0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56,
-	0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x78, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x42,
+	0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76,
+	0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -16681,7 +22431,7 @@ func file_cosmos_evm_vm_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_vm_v1_query_proto_rawDescData
 }
 
-var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryConfigRequest)(nil),             // 0: cosmos.evm.vm.v1.QueryConfigRequest
 	(*QueryConfigResponse)(nil),            // 1: cosmos.evm.vm.v1.QueryConfigResponse
@@ -16697,82 +22447,107 @@ var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryStorageResponse)(nil),           // 11: cosmos.evm.vm.v1.QueryStorageResponse
 	(*QueryCodeRequest)(nil),               // 12: cosmos.evm.vm.v1.QueryCodeRequest
 	(*QueryCodeResponse)(nil),              // 13: cosmos.evm.vm.v1.QueryCodeResponse
-	(*QueryTxLogsRequest)(nil),             // 14: cosmos.evm.vm.v1.QueryTxLogsRequest
-	(*QueryTxLogsResponse)(nil),            // 15: cosmos.evm.vm.v1.QueryTxLogsResponse
-	(*QueryParamsRequest)(nil),             // 16: cosmos.evm.vm.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),            // 17: cosmos.evm.vm.v1.QueryParamsResponse
-	(*EthCallRequest)(nil),                 // 18: cosmos.evm.vm.v1.EthCallRequest
-	(*EstimateGasResponse)(nil),            // 19: cosmos.evm.vm.v1.EstimateGasResponse
-	(*QueryTraceTxRequest)(nil),            // 20: cosmos.evm.vm.v1.QueryTraceTxRequest
-	(*QueryTraceTxResponse)(nil),           // 21: cosmos.evm.vm.v1.QueryTraceTxResponse
-	(*QueryTraceBlockRequest)(nil),         // 22: cosmos.evm.vm.v1.QueryTraceBlockRequest
-	(*QueryTraceBlockResponse)(nil),        // 23: cosmos.evm.vm.v1.QueryTraceBlockResponse
-	(*QueryTraceCallRequest)(nil),          // 24: cosmos.evm.vm.v1.QueryTraceCallRequest
-	(*QueryTraceCallResponse)(nil),         // 25: cosmos.evm.vm.v1.QueryTraceCallResponse
-	(*QueryBaseFeeRequest)(nil),            // 26: cosmos.evm.vm.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),           // 27: cosmos.evm.vm.v1.QueryBaseFeeResponse
-	(*QueryGlobalMinGasPriceRequest)(nil),  // 28: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	(*QueryGlobalMinGasPriceResponse)(nil), // 29: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	(*ChainConfig)(nil),                    // 30: cosmos.evm.vm.v1.ChainConfig
-	(*v1beta1.PageRequest)(nil),            // 31: cosmos.base.query.v1beta1.PageRequest
-	(*Log)(nil),                            // 32: cosmos.evm.vm.v1.Log
-	(*v1beta1.PageResponse)(nil),           // 33: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                         // 34: cosmos.evm.vm.v1.Params
-	(*MsgEthereumTx)(nil),                  // 35: cosmos.evm.vm.v1.MsgEthereumTx
-	(*TraceConfig)(nil),                    // 36: cosmos.evm.vm.v1.TraceConfig
-	(*timestamppb.Timestamp)(nil),          // 37: google.protobuf.Timestamp
-	(*MsgEthereumTxResponse)(nil),          // 38: cosmos.evm.vm.v1.MsgEthereumTxResponse
+	(*QueryStorageRangeRequest)(nil),       // 14: cosmos.evm.vm.v1.QueryStorageRangeRequest
+	(*QueryStorageRangeResponse)(nil),      // 15: cosmos.evm.vm.v1.QueryStorageRangeResponse
+	(*QueryContractsRequest)(nil),          // 16: cosmos.evm.vm.v1.QueryContractsRequest
+	(*ContractAccount)(nil),                // 17: cosmos.evm.vm.v1.ContractAccount
+	(*QueryContractsResponse)(nil),         // 18: cosmos.evm.vm.v1.QueryContractsResponse
+	(*QueryTxLogsRequest)(nil),             // 19: cosmos.evm.vm.v1.QueryTxLogsRequest
+	(*QueryTxLogsResponse)(nil),            // 20: cosmos.evm.vm.v1.QueryTxLogsResponse
+	(*QueryParamsRequest)(nil),             // 21: cosmos.evm.vm.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 22: cosmos.evm.vm.v1.QueryParamsResponse
+	(*EthCallRequest)(nil),                 // 23: cosmos.evm.vm.v1.EthCallRequest
+	(*EstimateGasResponse)(nil),            // 24: cosmos.evm.vm.v1.EstimateGasResponse
+	(*SimulateV1Request)(nil),              // 25: cosmos.evm.vm.v1.SimulateV1Request
+	(*SimulateV1Response)(nil),             // 26: cosmos.evm.vm.v1.SimulateV1Response
+	(*QueryTraceTxRequest)(nil),            // 27: cosmos.evm.vm.v1.QueryTraceTxRequest
+	(*QueryTraceTxResponse)(nil),           // 28: cosmos.evm.vm.v1.QueryTraceTxResponse
+	(*QueryTraceBlockRequest)(nil),         // 29: cosmos.evm.vm.v1.QueryTraceBlockRequest
+	(*QueryTraceBlockResponse)(nil),        // 30: cosmos.evm.vm.v1.QueryTraceBlockResponse
+	(*QueryTraceCallRequest)(nil),          // 31: cosmos.evm.vm.v1.QueryTraceCallRequest
+	(*QueryTraceCallResponse)(nil),         // 32: cosmos.evm.vm.v1.QueryTraceCallResponse
+	(*QueryBaseFeeRequest)(nil),            // 33: cosmos.evm.vm.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),           // 34: cosmos.evm.vm.v1.QueryBaseFeeResponse
+	(*QueryGlobalMinGasPriceRequest)(nil),  // 35: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	(*QueryGlobalMinGasPriceResponse)(nil), // 36: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	(*QueryTxStatusRequest)(nil),           // 37: cosmos.evm.vm.v1.QueryTxStatusRequest
+	(*QueryTxStatusResponse)(nil),          // 38: cosmos.evm.vm.v1.QueryTxStatusResponse
+	(*ChainConfig)(nil),                    // 39: cosmos.evm.vm.v1.ChainConfig
+	(*v1beta1.PageRequest)(nil),            // 40: cosmos.base.query.v1beta1.PageRequest
+	(*State)(nil),                          // 41: cosmos.evm.vm.v1.State
+	(*v1beta1.PageResponse)(nil),           // 42: cosmos.base.query.v1beta1.PageResponse
+	(*Log)(nil),                            // 43: cosmos.evm.vm.v1.Log
+	(*Params)(nil),                         // 44: cosmos.evm.vm.v1.Params
+	(*MsgEthereumTx)(nil),                  // 45: cosmos.evm.vm.v1.MsgEthereumTx
+	(*TraceConfig)(nil),                    // 46: cosmos.evm.vm.v1.TraceConfig
+	(*timestamppb.Timestamp)(nil),          // 47: google.protobuf.Timestamp
+	(*MsgEthereumTxResponse)(nil),          // 48: cosmos.evm.vm.v1.MsgEthereumTxResponse
 }
 var file_cosmos_evm_vm_v1_query_proto_depIdxs = []int32{
-	30, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
-	31, // 1: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	32, // 2: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
-	33, // 3: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	34, // 4: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
-	35, // 5: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	36, // 6: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	35, // 7: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	37, // 8: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
-	35, // 9: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	36, // 10: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	37, // 11: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
-	36, // 12: cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	37, // 13: cosmos.evm.vm.v1.QueryTraceCallRequest.block_time:type_name -> google.protobuf.Timestamp
-	2,  // 14: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
-	4,  // 15: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
-	6,  // 16: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
-	8,  // 17: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
-	10, // 18: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
-	12, // 19: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
-	16, // 20: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
-	18, // 21: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	18, // 22: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	20, // 23: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
-	22, // 24: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
-	24, // 25: cosmos.evm.vm.v1.Query.TraceCall:input_type -> cosmos.evm.vm.v1.QueryTraceCallRequest
-	26, // 26: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
-	0,  // 27: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
-	28, // 28: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	3,  // 29: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
-	5,  // 30: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
-	7,  // 31: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
-	9,  // 32: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
-	11, // 33: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
-	13, // 34: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
-	17, // 35: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
-	38, // 36: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
-	19, // 37: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
-	21, // 38: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
-	23, // 39: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
-	25, // 40: cosmos.evm.vm.v1.Query.TraceCall:output_type -> cosmos.evm.vm.v1.QueryTraceCallResponse
-	27, // 41: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
-	1,  // 42: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
-	29, // 43: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	29, // [29:44] is the sub-list for method output_type
-	14, // [14:29] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	39, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
+	40, // 1: cosmos.evm.vm.v1.QueryStorageRangeRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 2: cosmos.evm.vm.v1.QueryStorageRangeResponse.storage:type_name -> cosmos.evm.vm.v1.State
+	42, // 3: cosmos.evm.vm.v1.QueryStorageRangeResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 4: cosmos.evm.vm.v1.QueryContractsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	17, // 5: cosmos.evm.vm.v1.QueryContractsResponse.contracts:type_name -> cosmos.evm.vm.v1.ContractAccount
+	42, // 6: cosmos.evm.vm.v1.QueryContractsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	40, // 7: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 8: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
+	42, // 9: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	44, // 10: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
+	45, // 11: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	46, // 12: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	45, // 13: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	47, // 14: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
+	45, // 15: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	46, // 16: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	47, // 17: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
+	46, // 18: cosmos.evm.vm.v1.QueryTraceCallRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	47, // 19: cosmos.evm.vm.v1.QueryTraceCallRequest.block_time:type_name -> google.protobuf.Timestamp
+	47, // 20: cosmos.evm.vm.v1.QueryTxStatusResponse.removed_at:type_name -> google.protobuf.Timestamp
+	2,  // 21: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
+	4,  // 22: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
+	6,  // 23: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
+	8,  // 24: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
+	10, // 25: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
+	12, // 26: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
+	14, // 27: cosmos.evm.vm.v1.Query.StorageRange:input_type -> cosmos.evm.vm.v1.QueryStorageRangeRequest
+	16, // 28: cosmos.evm.vm.v1.Query.Contracts:input_type -> cosmos.evm.vm.v1.QueryContractsRequest
+	21, // 29: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
+	23, // 30: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	23, // 31: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	25, // 32: cosmos.evm.vm.v1.Query.SimulateV1:input_type -> cosmos.evm.vm.v1.SimulateV1Request
+	27, // 33: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
+	29, // 34: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
+	31, // 35: cosmos.evm.vm.v1.Query.TraceCall:input_type -> cosmos.evm.vm.v1.QueryTraceCallRequest
+	33, // 36: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
+	0,  // 37: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
+	35, // 38: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	37, // 39: cosmos.evm.vm.v1.Query.TxStatus:input_type -> cosmos.evm.vm.v1.QueryTxStatusRequest
+	3,  // 40: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
+	5,  // 41: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
+	7,  // 42: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
+	9,  // 43: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
+	11, // 44: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
+	13, // 45: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
+	15, // 46: cosmos.evm.vm.v1.Query.StorageRange:output_type -> cosmos.evm.vm.v1.QueryStorageRangeResponse
+	18, // 47: cosmos.evm.vm.v1.Query.Contracts:output_type -> cosmos.evm.vm.v1.QueryContractsResponse
+	22, // 48: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
+	48, // 49: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
+	24, // 50: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
+	26, // 51: cosmos.evm.vm.v1.Query.SimulateV1:output_type -> cosmos.evm.vm.v1.SimulateV1Response
+	28, // 52: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
+	30, // 53: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
+	32, // 54: cosmos.evm.vm.v1.Query.TraceCall:output_type -> cosmos.evm.vm.v1.QueryTraceCallResponse
+	34, // 55: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
+	1,  // 56: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
+	36, // 57: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	38, // 58: cosmos.evm.vm.v1.Query.TxStatus:output_type -> cosmos.evm.vm.v1.QueryTxStatusResponse
+	40, // [40:59] is the sub-list for method output_type
+	21, // [21:40] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_query_proto_init() }
@@ -16952,7 +22727,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsRequest); i {
+			switch v := v.(*QueryStorageRangeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16964,7 +22739,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsResponse); i {
+			switch v := v.(*QueryStorageRangeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16976,7 +22751,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryContractsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -16988,7 +22763,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*ContractAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17000,7 +22775,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthCallRequest); i {
+			switch v := v.(*QueryContractsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17012,7 +22787,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasResponse); i {
+			switch v := v.(*QueryTxLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17024,7 +22799,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxRequest); i {
+			switch v := v.(*QueryTxLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17036,7 +22811,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxResponse); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17048,7 +22823,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockRequest); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17060,7 +22835,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockResponse); i {
+			switch v := v.(*EthCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17072,7 +22847,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceCallRequest); i {
+			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17084,7 +22859,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceCallResponse); i {
+			switch v := v.(*SimulateV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17096,7 +22871,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeRequest); i {
+			switch v := v.(*SimulateV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17108,7 +22883,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeResponse); i {
+			switch v := v.(*QueryTraceTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17120,7 +22895,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			switch v := v.(*QueryTraceTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -17132,6 +22907,90 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceCallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGlobalMinGasPriceResponse); i {
 			case 0:
 				return &v.state
@@ -17143,6 +23002,30 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTxStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTxStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -17150,7 +23033,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,15 +25,19 @@ const (
 	Query_Balance_FullMethodName           = "/cosmos.evm.vm.v1.Query/Balance"
 	Query_Storage_FullMethodName           = "/cosmos.evm.vm.v1.Query/Storage"
 	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
+	Query_StorageRange_FullMethodName      = "/cosmos.evm.vm.v1.Query/StorageRange"
+	Query_Contracts_FullMethodName         = "/cosmos.evm.vm.v1.Query/Contracts"
 	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
 	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
 	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
+	Query_SimulateV1_FullMethodName        = "/cosmos.evm.vm.v1.Query/SimulateV1"
 	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
 	Query_TraceCall_FullMethodName         = "/cosmos.evm.vm.v1.Query/TraceCall"
 	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
 	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
 	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_TxStatus_FullMethodName          = "/cosmos.evm.vm.v1.Query/TxStatus"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,12 +60,18 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// StorageRange queries the storage slots of a contract, ordered by slot key.
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// Contracts queries the accounts holding code, ordered by address.
+	Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -80,6 +90,9 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// TxStatus queries the stage of its lifecycle an ethereum transaction is in,
+	// as seen by the app-side mempool of the node.
+	TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error)
 }
 
 type queryClient struct {
@@ -150,6 +163,26 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, Query_StorageRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, Query_Contracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -180,6 +213,16 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, Query_SimulateV1_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryTraceTxResponse)
@@ -240,6 +283,16 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTxStatusResponse)
+	err := c.cc.Invoke(ctx, Query_TxStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -260,12 +313,18 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// StorageRange queries the storage slots of a contract, ordered by slot key.
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// Contracts queries the accounts holding code, ordered by address.
+	Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -284,6 +343,9 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// TxStatus queries the stage of its lifecycle an ethereum transaction is in,
+	// as seen by the app-side mempool of the node.
+	TxStatus(context.Context, *QueryTxStatusRequest) (*QueryTxStatusResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -312,6 +374,12 @@ func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (
 func (UnimplementedQueryServer) Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Code not implemented")
 }
+func (UnimplementedQueryServer) StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (UnimplementedQueryServer) Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Contracts not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Params not implemented")
 }
@@ -321,6 +389,9 @@ func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgE
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedQueryServer) SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (UnimplementedQueryServer) TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -339,6 +410,9 @@ func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*Q
 func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (UnimplementedQueryServer) TxStatus(context.Context, *QueryTxStatusRequest) (*QueryTxStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TxStatus not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -468,6 +542,42 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Contracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Contracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contracts(ctx, req.(*QueryContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +632,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateV1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -630,6 +758,24 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxStatus(ctx, req.(*QueryTxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -661,6 +807,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "Contracts",
+			Handler:    _Query_Contracts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -673,6 +827,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -697,6 +855,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Query_TxStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
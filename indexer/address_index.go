@@ -0,0 +1,224 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AddressTxKeyLength is the length of address-tx key
+	AddressTxKeyLength = 1 + common.AddressLength + 8 + 8
+	// SenderNonceKeyLength is the length of sender-nonce key
+	SenderNonceKeyLength = 1 + common.AddressLength + 8
+	// AddressBlockKeyLength is the length of address-block key
+	AddressBlockKeyLength = 1 + 8
+	// contractCreatorValueLength is the length of the contract-creator value
+	contractCreatorValueLength = common.HashLength + common.AddressLength
+)
+
+// AddressIndexEnabled returns true if the eth txs of the indexed blocks are
+// indexed by address and by sender and nonce.
+func (kv *KVIndexer) AddressIndexEnabled() bool {
+	return kv.addressIndex
+}
+
+// LastAddressIndexedBlock returns the latest block whose eth txs are indexed by
+// address and by sender and nonce, returns -1 if no block is.
+func (kv *KVIndexer) LastAddressIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixAddressBlock}, []byte{KeyPrefixAddressBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastAddressIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseAddressBlockKey(it.Key())
+}
+
+// FirstAddressIndexedBlock returns the first block whose eth txs are indexed
+// by address and by sender and nonce, returns -1 if no block is.
+func (kv *KVIndexer) FirstAddressIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixAddressBlock}, []byte{KeyPrefixAddressBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstAddressIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseAddressBlockKey(it.Key())
+}
+
+// GetByAddress returns the eth txs within the inclusive block range in which
+// the address appears. At least limit txs are returned when available, but the
+// txs of a block are never split. The second return value reports if there
+// are more txs beyond the returned ones.
+func (kv *KVIndexer) GetByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	reverse bool,
+	limit int,
+) ([]servertypes.AddressTx, bool, error) {
+	if fromBlock > toBlock {
+		return nil, false, nil
+	}
+
+	start := AddressTxKey(address, fromBlock, 0)
+	// the upper bound is computed as uint64 to not overflow on math.MaxInt64
+	end := append(append([]byte{KeyPrefixAddressTx}, address.Bytes()...), sdk.Uint64ToBigEndian(uint64(toBlock)+1)...) //nolint:gosec // G115 // block number won't exceed uint64
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if reverse {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var txs []servertypes.AddressTx
+	for ; it.Valid(); it.Next() {
+		height, txIndex, err := parseAddressTxKey(it.Key())
+		if err != nil {
+			return nil, false, err
+		}
+		if limit > 0 && len(txs) >= limit && txs[len(txs)-1].Height != height {
+			return txs, true, nil
+		}
+		var roles uint8
+		if value := it.Value(); len(value) > 0 {
+			roles = value[0]
+		}
+		txs = append(txs, servertypes.AddressTx{Height: height, EthTxIndex: txIndex, Roles: roles})
+	}
+	return txs, false, it.Error()
+}
+
+// GetContractCreator returns the eth tx that created the contract, returns nil
+// if the contract creation is not indexed.
+func (kv *KVIndexer) GetContractCreator(address common.Address) (*servertypes.ContractCreator, error) {
+	bz, err := kv.db.Get(ContractCreatorKey(address))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreator %s", address.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != contractCreatorValueLength {
+		return nil, fmt.Errorf("wrong contract creator value length, expect: %d, got: %d", contractCreatorValueLength, len(bz))
+	}
+	return &servertypes.ContractCreator{
+		TxHash:  common.BytesToHash(bz[:common.HashLength]),
+		Creator: common.BytesToAddress(bz[common.HashLength:]),
+	}, nil
+}
+
+// GetBySenderAndNonce returns the hash of the eth tx sent by the sender with
+// the nonce, returns nil if the tx is not indexed.
+func (kv *KVIndexer) GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error) {
+	bz, err := kv.db.Get(SenderNonceKey(sender, nonce))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySenderAndNonce %s %d", sender.Hex(), nonce)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != common.HashLength {
+		return nil, fmt.Errorf("wrong sender nonce value length, expect: %d, got: %d", common.HashLength, len(bz))
+	}
+	hash := common.BytesToHash(bz)
+	return &hash, nil
+}
+
+// AddressTxKey returns the key for db entry: `(address, block number, tx index) -> address roles`
+func AddressTxKey(address common.Address, blockNumber int64, txIndex int32) []byte {
+	key := make([]byte, 0, AddressTxKeyLength)
+	key = append(key, KeyPrefixAddressTx)
+	key = append(key, address.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	key = append(key, sdk.Uint64ToBigEndian(uint64(txIndex))...)     //nolint:gosec // G115 // index won't exceed uint64
+	return key
+}
+
+// ContractCreatorKey returns the key for db entry: `contract address -> (tx hash, creator)`
+func ContractCreatorKey(address common.Address) []byte {
+	return append([]byte{KeyPrefixContractCreator}, address.Bytes()...)
+}
+
+// SenderNonceKey returns the key for db entry: `(sender, nonce) -> tx hash`
+func SenderNonceKey(sender common.Address, nonce uint64) []byte {
+	key := make([]byte, 0, SenderNonceKeyLength)
+	key = append(key, KeyPrefixSenderNonce)
+	key = append(key, sender.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(nonce)...)
+	return key
+}
+
+// AddressBlockKey returns the key for db entry: `block number -> nil`, marking
+// the eth txs of the block as indexed by address and by sender and nonce.
+func AddressBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixAddressBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// saveAddressIndexes indexes the sender and nonce, the recipient and the
+// created contract of the eth tx into the kv db batch.
+func saveAddressIndexes(batch dbm.Batch, txHash common.Hash, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) error {
+	tx := msg.AsTransaction()
+	from := msg.GetSender()
+
+	// the nonce is consumed by the failed txs as well
+	if err := batch.Set(SenderNonceKey(from, tx.Nonce()), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set sender-nonce key")
+	}
+
+	roles := map[common.Address]uint8{from: servertypes.AddressRoleFrom}
+	if to := tx.To(); to != nil {
+		roles[*to] |= servertypes.AddressRoleTo
+	} else if !txResult.Failed {
+		contract := crypto.CreateAddress(from, tx.Nonce())
+		roles[contract] |= servertypes.AddressRoleCreated
+		if err := batch.Set(ContractCreatorKey(contract), append(txHash.Bytes(), from.Bytes()...)); err != nil {
+			return errorsmod.Wrap(err, "set contract-creator key")
+		}
+	}
+
+	for addr, role := range roles {
+		if err := batch.Set(AddressTxKey(addr, txResult.Height, txResult.EthTxIndex), []byte{role}); err != nil {
+			return errorsmod.Wrap(err, "set address-tx key")
+		}
+	}
+	return nil
+}
+
+func parseAddressTxKey(key []byte) (int64, int32, error) {
+	if len(key) != AddressTxKeyLength {
+		return 0, 0, fmt.Errorf("wrong address tx key length, expect: %d, got: %d", AddressTxKeyLength, len(key))
+	}
+	offset := 1 + common.AddressLength
+	height := int64(sdk.BigEndianToUint64(key[offset : offset+8]))     //#nosec G115 -- int overflow is not a concern here
+	txIndex := int32(sdk.BigEndianToUint64(key[offset+8 : offset+16])) //#nosec G115 -- int overflow is not a concern here
+	return height, txIndex, nil
+}
+
+func parseAddressBlockKey(key []byte) (int64, error) {
+	if len(key) != AddressBlockKeyLength {
+		return 0, fmt.Errorf("wrong address block key length, expect: %d, got: %d", AddressBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,149 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestGetByAddress(t *testing.T) {
+	var (
+		alice = common.BytesToAddress([]byte{0x1})
+		bob   = common.BytesToAddress([]byte{0x2})
+	)
+
+	db := dbm.NewMemDB()
+	for _, entry := range []struct {
+		address common.Address
+		height  int64
+		txIndex int32
+		roles   uint8
+	}{
+		{alice, 1, 0, servertypes.AddressRoleFrom},
+		{alice, 3, 0, servertypes.AddressRoleTo},
+		{alice, 3, 2, servertypes.AddressRoleFrom | servertypes.AddressRoleTo},
+		{bob, 3, 1, servertypes.AddressRoleCreated},
+		{alice, 7, 1, servertypes.AddressRoleFrom},
+	} {
+		require.NoError(t, db.Set(indexer.AddressTxKey(entry.address, entry.height, entry.txIndex), []byte{entry.roles}))
+	}
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	txs, more, err := idxer.GetByAddress(alice, 0, 100, false, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Equal(t, []servertypes.AddressTx{
+		{Height: 1, EthTxIndex: 0, Roles: servertypes.AddressRoleFrom},
+		{Height: 3, EthTxIndex: 0, Roles: servertypes.AddressRoleTo},
+		{Height: 3, EthTxIndex: 2, Roles: servertypes.AddressRoleFrom | servertypes.AddressRoleTo},
+		{Height: 7, EthTxIndex: 1, Roles: servertypes.AddressRoleFrom},
+	}, txs)
+
+	// the txs of a block are never split
+	txs, more, err = idxer.GetByAddress(alice, 0, 100, false, 2)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Len(t, txs, 3)
+
+	txs, more, err = idxer.GetByAddress(alice, 2, 7, true, 1)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Equal(t, []servertypes.AddressTx{{Height: 7, EthTxIndex: 1, Roles: servertypes.AddressRoleFrom}}, txs)
+
+	txs, more, err = idxer.GetByAddress(bob, 4, 1<<63-1, false, 10)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Empty(t, txs)
+}
+
+func TestGetContractCreator(t *testing.T) {
+	var (
+		creator  = common.BytesToAddress([]byte{0x1})
+		contract = common.BytesToAddress([]byte{0x2})
+		txHash   = common.BytesToHash([]byte{0x3})
+	)
+
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set(indexer.ContractCreatorKey(contract), append(txHash.Bytes(), creator.Bytes()...)))
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	res, err := idxer.GetContractCreator(contract)
+	require.NoError(t, err)
+	require.Equal(t, &servertypes.ContractCreator{TxHash: txHash, Creator: creator}, res)
+
+	res, err = idxer.GetContractCreator(creator)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestGetBySenderAndNonce(t *testing.T) {
+	var (
+		sender = common.BytesToAddress([]byte{0x1})
+		txHash = common.BytesToHash([]byte{0x2})
+	)
+
+	db := dbm.NewMemDB()
+	require.NoError(t, db.Set(indexer.SenderNonceKey(sender, 7), txHash.Bytes()))
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	res, err := idxer.GetBySenderAndNonce(sender, 7)
+	require.NoError(t, err)
+	require.Equal(t, &txHash, res)
+
+	res, err = idxer.GetBySenderAndNonce(sender, 8)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestAddressIndexedBlocks(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithAddressIndex(true))
+	require.True(t, idxer.AddressIndexEnabled())
+
+	first, err := idxer.FirstAddressIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	for _, height := range []int64{5, 3, 9} {
+		require.NoError(t, db.Set(indexer.AddressBlockKey(height), []byte{}))
+	}
+	first, err = idxer.FirstAddressIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+	last, err := idxer.LastAddressIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), last)
+}
+
+func TestAddressIndexDisabled(t *testing.T) {
+	testCases := []struct {
+		name    string
+		enabled bool
+		expLast int64
+	}{
+		{"disabled", false, -1},
+		{"enabled", true, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{}, indexer.WithAddressIndex(tc.enabled))
+			require.Equal(t, tc.enabled, idxer.AddressIndexEnabled())
+
+			require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil))
+			last, err := idxer.LastAddressIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.expLast, last)
+		})
+	}
+}
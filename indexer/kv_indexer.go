@@ -23,25 +23,73 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash          = 1
+	KeyPrefixTxIndex         = 2
+	KeyPrefixAddressTx       = 3
+	KeyPrefixContractCreator = 4
+	KeyPrefixLogAddress      = 5
+	KeyPrefixLogTopic        = 6
+	KeyPrefixLogBlock        = 7
+	KeyPrefixSenderNonce     = 8
+	KeyPrefixAddressBlock    = 9
+	KeyPrefixTransfer        = 10
+	KeyPrefixAddressTransfer = 11
+	KeyPrefixTransferBlock   = 12
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ servertypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ servertypes.EVMTxIndexer       = &KVIndexer{}
+	_ servertypes.EVMAddressIndexer  = &KVIndexer{}
+	_ servertypes.EVMLogIndexer      = &KVIndexer{}
+	_ servertypes.EVMTransferIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
-	db        dbm.DB
-	logger    log.Logger
-	clientCtx client.Context
+	db            dbm.DB
+	logger        log.Logger
+	clientCtx     client.Context
+	logIndex      bool
+	addressIndex  bool
+	transferIndex bool
+}
+
+// KVIndexerOpt is an option of the KVIndexer.
+type KVIndexerOpt func(*KVIndexer)
+
+// WithLogIndex enables the index of the logs by address and first topic.
+func WithLogIndex(enabled bool) KVIndexerOpt {
+	return func(kv *KVIndexer) {
+		kv.logIndex = enabled
+	}
+}
+
+// WithAddressIndex enables the index of the eth txs by address and by sender
+// and nonce, together with the creators of the contracts.
+func WithAddressIndex(enabled bool) KVIndexerOpt {
+	return func(kv *KVIndexer) {
+		kv.addressIndex = enabled
+	}
+}
+
+// WithTransferIndex enables the index of the token and native coin transfers
+// by address.
+func WithTransferIndex(enabled bool) KVIndexerOpt {
+	return func(kv *KVIndexer) {
+		kv.transferIndex = enabled
+	}
 }
 
 // NewKVIndexer creates the KVIndexer
-func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context, opts ...KVIndexerOpt) *KVIndexer {
+	kv := &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -56,20 +104,28 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 	defer batch.Close()
 
 	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
+	var (
+		ethTxIndex int32
+		transfers  []*servertypes.Transfer
+	)
+	for txIndex, txBz := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSucessOrExpectedFailure(result) {
 			continue
 		}
 
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(txBz)
 		if err != nil {
 			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
 			continue
 		}
 
 		if !isEthTx(tx) {
+			// the native transfers of the cosmos txs, such as bank sends, erc20
+			// conversions and ibc transfers, are keyed by the cosmos tx hash
+			if kv.transferIndex && result.Code == abci.CodeTypeOK {
+				transfers = append(transfers, nativeTransfers([]common.Hash{common.BytesToHash(txBz.Hash())}, result.Events)...)
+			}
 			continue
 		}
 
@@ -115,6 +171,44 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+			if kv.addressIndex {
+				if err := saveAddressIndexes(batch, txHash, ethMsg, &txResult); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
+			if kv.transferIndex {
+				if transfer := externalTransfer(txHash, ethMsg, &txResult); transfer != nil {
+					transfers = append(transfers, transfer)
+				}
+			}
+		}
+
+		if kv.transferIndex && result.Code == abci.CodeTypeOK {
+			logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //nolint:gosec // G115 // block height is never negative
+			if err != nil {
+				kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			}
+			transfers = append(transfers, tokenTransfers(logs)...)
+			msgHashes := make([]common.Hash, len(tx.GetMsgs()))
+			for msgIndex, msg := range tx.GetMsgs() {
+				msgHashes[msgIndex] = msg.(*evmtypes.MsgEthereumTx).Hash()
+			}
+			transfers = append(transfers, nativeTransfers(msgHashes, result.Events)...)
+		}
+	}
+	if kv.addressIndex {
+		if err := batch.Set(AddressBlockKey(height), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set address-block key", height)
+		}
+	}
+	if kv.transferIndex {
+		if err := saveTransferIndexes(batch, height, transfers); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if kv.logIndex {
+		if err := kv.saveLogIndexes(batch, height, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -140,7 +234,7 @@ func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error
 		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
 	}
 	if len(bz) == 0 {
-		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+		return nil, fmt.Errorf("%w, hash: %s", servertypes.ErrTxNotFound, hash.Hex())
 	}
 	var txKey servertypes.TxResult
 	if err := kv.clientCtx.Codec.Unmarshal(bz, &txKey); err != nil {
@@ -156,7 +250,7 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*serv
 		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
 	}
 	if len(bz) == 0 {
-		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+		return nil, fmt.Errorf("%w, block: %d, eth-index: %d", servertypes.ErrTxNotFound, blockNumber, txIndex)
 	}
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
@@ -0,0 +1,262 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// LogAddressKeyLength is the length of log-address key
+	LogAddressKeyLength = 1 + common.AddressLength + 8 + 8
+	// LogTopicKeyLength is the length of log-topic key
+	LogTopicKeyLength = 1 + common.HashLength + 8 + 8
+	// LogBlockKeyLength is the length of log-block key
+	LogBlockKeyLength = 1 + 8
+)
+
+// logPosition locates a log in the chain.
+type logPosition struct {
+	height   int64
+	logIndex uint64
+}
+
+// LogIndexEnabled returns true if the logs of the indexed blocks are indexed
+// by address and first topic.
+func (kv *KVIndexer) LogIndexEnabled() bool {
+	return kv.logIndex
+}
+
+// LastLogIndexedBlock returns the latest block whose logs are indexed, returns
+// -1 if no block is.
+func (kv *KVIndexer) LastLogIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseLogBlockKey(it.Key())
+}
+
+// FirstLogIndexedBlock returns the first block whose logs are indexed, returns
+// -1 if no block is.
+func (kv *KVIndexer) FirstLogIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstLogIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseLogBlockKey(it.Key())
+}
+
+// UnindexedLogBlocks returns in ascending order the blocks within the
+// inclusive range whose logs are not indexed, e.g. because the log index was
+// disabled while they were indexed. The second return value is false if there
+// are more than limit of them, in which case they are not returned.
+func (kv *KVIndexer) UnindexedLogBlocks(fromBlock, toBlock int64, limit int) ([]int64, bool, error) {
+	if fromBlock > toBlock {
+		return nil, true, nil
+	}
+	// the upper bound is computed as uint64 to not overflow on math.MaxInt64
+	end := append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(toBlock)+1)...) //nolint:gosec // G115 // block number won't exceed uint64
+	it, err := kv.db.Iterator(LogBlockKey(fromBlock), end)
+	if err != nil {
+		return nil, false, errorsmod.Wrap(err, "UnindexedLogBlocks")
+	}
+	defer it.Close()
+
+	var heights []int64
+	next := fromBlock
+	addUntil := func(height int64) bool {
+		if height-next > int64(limit-len(heights)) {
+			return false
+		}
+		for ; next < height; next++ {
+			heights = append(heights, next)
+		}
+		return true
+	}
+	for ; it.Valid(); it.Next() {
+		height, err := parseLogBlockKey(it.Key())
+		if err != nil {
+			return nil, false, err
+		}
+		if !addUntil(height) {
+			return nil, false, nil
+		}
+		next = height + 1
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, errorsmod.Wrap(err, "UnindexedLogBlocks")
+	}
+	if !addUntil(toBlock + 1) {
+		return nil, false, nil
+	}
+	return heights, true, nil
+}
+
+// GetLogBlocks returns in ascending order the blocks within the inclusive
+// range that contain a log emitted by one of the addresses and with one of the
+// first topics. An empty list of addresses or topics matches any of them, but
+// not both.
+func (kv *KVIndexer) GetLogBlocks(addresses []common.Address, topics0 []common.Hash, fromBlock, toBlock int64) ([]int64, error) {
+	if len(addresses) == 0 && len(topics0) == 0 {
+		return nil, errors.New("GetLogBlocks: no address nor topic to look up")
+	}
+	if fromBlock > toBlock {
+		return nil, nil
+	}
+
+	var addressLogs, topicLogs map[logPosition]struct{}
+	if len(addresses) > 0 {
+		addressLogs = make(map[logPosition]struct{})
+		for _, address := range addresses {
+			if err := kv.collectLogPositions(addressLogs, KeyPrefixLogAddress, address.Bytes(), fromBlock, toBlock); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogBlocks %s", address.Hex())
+			}
+		}
+	}
+	if len(topics0) > 0 {
+		topicLogs = make(map[logPosition]struct{})
+		for _, topic := range topics0 {
+			if err := kv.collectLogPositions(topicLogs, KeyPrefixLogTopic, topic.Bytes(), fromBlock, toBlock); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogBlocks %s", topic.Hex())
+			}
+		}
+	}
+
+	matches := addressLogs
+	if matches == nil {
+		matches = topicLogs
+	} else if topicLogs != nil {
+		for pos := range matches {
+			if _, ok := topicLogs[pos]; !ok {
+				delete(matches, pos)
+			}
+		}
+	}
+
+	seen := make(map[int64]struct{}, len(matches))
+	heights := make([]int64, 0, len(matches))
+	for pos := range matches {
+		if _, ok := seen[pos.height]; ok {
+			continue
+		}
+		seen[pos.height] = struct{}{}
+		heights = append(heights, pos.height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// collectLogPositions adds the positions of the logs indexed under the given
+// prefix and value within the inclusive block range to the set.
+func (kv *KVIndexer) collectLogPositions(positions map[logPosition]struct{}, prefix byte, value []byte, fromBlock, toBlock int64) error {
+	start := logKey(prefix, value, fromBlock, 0)
+	// the upper bound is computed as uint64 to not overflow on math.MaxInt64
+	end := append(append([]byte{prefix}, value...), sdk.Uint64ToBigEndian(uint64(toBlock)+1)...) //nolint:gosec // G115 // block number won't exceed uint64
+
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		pos, err := parseLogKey(it.Key(), len(value))
+		if err != nil {
+			return err
+		}
+		positions[pos] = struct{}{}
+	}
+	return it.Error()
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	return logKey(KeyPrefixLogAddress, address.Bytes(), blockNumber, logIndex)
+}
+
+// LogTopicKey returns the key for db entry: `(first topic, block number, log index) -> nil`
+func LogTopicKey(topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	return logKey(KeyPrefixLogTopic, topic.Bytes(), blockNumber, logIndex)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, marking the
+// logs of the block as indexed.
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func logKey(prefix byte, value []byte, blockNumber int64, logIndex uint64) []byte {
+	key := make([]byte, 0, 1+len(value)+8+8)
+	key = append(key, prefix)
+	key = append(key, value...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	key = append(key, sdk.Uint64ToBigEndian(logIndex)...)
+	return key
+}
+
+// saveLogIndexes indexes the logs of all the txs of the block by address and
+// first topic into the kv db batch, and marks the block as indexed. The logs
+// are numbered by their position in the block.
+func (kv *KVIndexer) saveLogIndexes(batch dbm.Batch, height int64, txResults []*abci.ExecTxResult) error {
+	var logIndex uint64
+	for txIndex, result := range txResults {
+		logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //nolint:gosec // G115 // block height is never negative
+		if err != nil {
+			kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for _, log := range logs {
+			if err := batch.Set(LogAddressKey(log.Address, height, logIndex), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			if len(log.Topics) > 0 {
+				if err := batch.Set(LogTopicKey(log.Topics[0], height, logIndex), []byte{}); err != nil {
+					return errorsmod.Wrap(err, "set log-topic key")
+				}
+			}
+			logIndex++
+		}
+	}
+	if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log-block key")
+	}
+	return nil
+}
+
+func parseLogKey(key []byte, valueLength int) (logPosition, error) {
+	if len(key) != 1+valueLength+8+8 {
+		return logPosition{}, fmt.Errorf("wrong log key length, expect: %d, got: %d", 1+valueLength+8+8, len(key))
+	}
+	offset := 1 + valueLength
+	return logPosition{
+		height:   int64(sdk.BigEndianToUint64(key[offset : offset+8])), //#nosec G115 -- int overflow is not a concern here
+		logIndex: sdk.BigEndianToUint64(key[offset+8 : offset+16]),
+	}, nil
+}
+
+func parseLogBlockKey(key []byte) (int64, error) {
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,146 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetLogBlocks(t *testing.T) {
+	var (
+		token    = common.BytesToAddress([]byte{0x1})
+		pool     = common.BytesToAddress([]byte{0x2})
+		transfer = common.BytesToHash([]byte{0x10})
+		swap     = common.BytesToHash([]byte{0x20})
+	)
+
+	txResult := func(logs ...*evmtypes.Log) *abci.ExecTxResult {
+		msgRes, err := codectypes.NewAnyWithValue(&evmtypes.MsgEthereumTxResponse{Logs: logs})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgRes}})
+		require.NoError(t, err)
+		return &abci.ExecTxResult{Data: data}
+	}
+	newLog := func(address common.Address, topics ...common.Hash) *evmtypes.Log {
+		log := &evmtypes.Log{Address: address.Hex()}
+		for _, topic := range topics {
+			log.Topics = append(log.Topics, topic.Hex())
+		}
+		return log
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithLogIndex(true))
+	require.True(t, idxer.LogIndexEnabled())
+
+	first, err := idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	blocks := map[int64][]*abci.ExecTxResult{
+		2: {txResult(newLog(token, transfer))},
+		3: {},
+		4: {txResult(newLog(pool, swap)), txResult(newLog(token, transfer), newLog(pool))},
+		5: {txResult(newLog(token, swap)), txResult(newLog(pool, transfer))},
+	}
+	for height := int64(2); height <= 5; height++ {
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height}}, blocks[height]))
+	}
+
+	first, err = idxer.FirstLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+
+	testCases := map[string]struct {
+		addresses []common.Address
+		topics0   []common.Hash
+		from, to  int64
+		expBlocks []int64
+	}{
+		"by address":              {addresses: []common.Address{pool}, from: 0, to: 10, expBlocks: []int64{4, 5}},
+		"by topic":                {topics0: []common.Hash{transfer}, from: 0, to: 10, expBlocks: []int64{2, 4, 5}},
+		"by address and topic":    {addresses: []common.Address{token}, topics0: []common.Hash{transfer}, from: 0, to: 10, expBlocks: []int64{2, 4}},
+		"in the same log only":    {addresses: []common.Address{pool}, topics0: []common.Hash{transfer}, from: 0, to: 4, expBlocks: []int64{}},
+		"by any of the addresses": {addresses: []common.Address{token, pool}, topics0: []common.Hash{swap}, from: 0, to: 10, expBlocks: []int64{4, 5}},
+		"within the range":        {addresses: []common.Address{token}, from: 3, to: 4, expBlocks: []int64{4}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			heights, err := idxer.GetLogBlocks(tc.addresses, tc.topics0, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBlocks, heights)
+		})
+	}
+
+	_, err = idxer.GetLogBlocks(nil, nil, 0, 10)
+	require.Error(t, err)
+}
+
+func TestLogIndexDisabled(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+	require.False(t, idxer.LogIndexEnabled())
+
+	require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil))
+	last, err := idxer.LastLogIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+}
+
+func TestUnindexedLogBlocks(t *testing.T) {
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithLogIndex(true))
+	noLogIdxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	// the log index is disabled while the blocks 4 and 5 are indexed
+	for height := int64(2); height <= 7; height++ {
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}}
+		if height == 4 || height == 5 {
+			require.NoError(t, noLogIdxer.IndexBlock(block, nil))
+		} else {
+			require.NoError(t, idxer.IndexBlock(block, nil))
+		}
+	}
+
+	testCases := map[string]struct {
+		from, to  int64
+		limit     int
+		expBlocks []int64
+		expOk     bool
+	}{
+		"fully indexed":          {from: 2, to: 3, limit: 0, expBlocks: nil, expOk: true},
+		"gap":                    {from: 2, to: 7, limit: 2, expBlocks: []int64{4, 5}, expOk: true},
+		"before the first block": {from: 0, to: 3, limit: 2, expBlocks: []int64{0, 1}, expOk: true},
+		"after the last block":   {from: 6, to: 9, limit: 2, expBlocks: []int64{8, 9}, expOk: true},
+		"all around":             {from: 1, to: 8, limit: 4, expBlocks: []int64{1, 4, 5, 8}, expOk: true},
+		"gap over the limit":     {from: 2, to: 7, limit: 1, expOk: false},
+		"tail over the limit":    {from: 6, to: 10, limit: 2, expOk: false},
+		"empty range":            {from: 5, to: 4, limit: 0, expBlocks: nil, expOk: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			heights, ok, err := idxer.UnindexedLogBlocks(tc.from, tc.to, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expOk, ok)
+			require.Equal(t, tc.expBlocks, heights)
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log/v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	KeyPrefixTraceFrom    = 1
+	KeyPrefixTraceTo      = 2
+	KeyPrefixTraceBlock   = 3
+	KeyPrefixTraceCreator = 4
+
+	// TraceAddressKeyLength is the length of trace address key
+	TraceAddressKeyLength = 1 + common.AddressLength + 8 + 8
+	// TraceBlockKeyLength is the length of trace block key
+	TraceBlockKeyLength = 1 + 8
+	// traceCreatorValueLength is the length of the trace contract-creator value
+	traceCreatorValueLength = 8 + 8 + common.AddressLength
+)
+
+var _ servertypes.TraceIndexer = &TraceIndexer{}
+
+// TraceIndexer implements an index of the addresses found in the call traces
+// of the eth txs on a KV db.
+type TraceIndexer struct {
+	db     dbm.DB
+	logger log.Logger
+}
+
+// NewTraceIndexer creates the TraceIndexer
+func NewTraceIndexer(db dbm.DB, logger log.Logger) *TraceIndexer {
+	return &TraceIndexer{db, logger}
+}
+
+// IndexBlock stores the trace addresses and the created contracts of all the
+// eth txs of a block and marks the block as indexed, even if it contains no eth
+// txs. A contract created again after a self-destruct keeps its last creator.
+func (ti *TraceIndexer) IndexBlock(height int64, txs []servertypes.TraceTxAddresses) error {
+	batch := ti.db.NewBatch()
+	defer batch.Close()
+
+	for _, tx := range txs {
+		for _, addr := range tx.From {
+			if err := batch.Set(TraceAddressKey(KeyPrefixTraceFrom, addr, height, tx.EthTxIndex), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set trace from key")
+			}
+		}
+		for _, addr := range tx.To {
+			if err := batch.Set(TraceAddressKey(KeyPrefixTraceTo, addr, height, tx.EthTxIndex), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set trace to key")
+			}
+		}
+		for _, created := range tx.Created {
+			if err := batch.Set(TraceCreatorKey(created.Contract), traceCreatorValue(height, tx.EthTxIndex, created.Creator)); err != nil {
+				return errorsmod.Wrap(err, "set trace creator key")
+			}
+		}
+	}
+	if err := batch.Set(TraceBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set trace block key")
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (ti *TraceIndexer) LastIndexedBlock() (int64, error) {
+	it, err := ti.db.ReverseIterator([]byte{KeyPrefixTraceBlock}, []byte{KeyPrefixTraceBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseTraceBlockKey(it.Key())
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (ti *TraceIndexer) FirstIndexedBlock() (int64, error) {
+	it, err := ti.db.Iterator([]byte{KeyPrefixTraceBlock}, []byte{KeyPrefixTraceBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseTraceBlockKey(it.Key())
+}
+
+// GetByFromAddress returns the eth txs within the inclusive block range whose
+// traces have the given address as sender.
+func (ti *TraceIndexer) GetByFromAddress(address common.Address, fromBlock, toBlock int64) ([]servertypes.TracePosition, error) {
+	return ti.getByAddress(KeyPrefixTraceFrom, address, fromBlock, toBlock)
+}
+
+// GetByToAddress returns the eth txs within the inclusive block range whose
+// traces have the given address as recipient.
+func (ti *TraceIndexer) GetByToAddress(address common.Address, fromBlock, toBlock int64) ([]servertypes.TracePosition, error) {
+	return ti.getByAddress(KeyPrefixTraceTo, address, fromBlock, toBlock)
+}
+
+// GetByAddress returns the eth txs within the inclusive block range whose
+// traces have the given address as sender or recipient. At least limit txs are
+// returned when available, but the txs of a block are never split. The second
+// return value reports if there are more txs beyond the returned ones.
+func (ti *TraceIndexer) GetByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	reverse bool,
+	limit int,
+) ([]servertypes.AddressTx, bool, error) {
+	if fromBlock > toBlock {
+		return nil, false, nil
+	}
+
+	fromIt, err := ti.addressIterator(KeyPrefixTraceFrom, address, fromBlock, toBlock, reverse)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer fromIt.Close()
+	toIt, err := ti.addressIterator(KeyPrefixTraceTo, address, fromBlock, toBlock, reverse)
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer toIt.Close()
+
+	var txs []servertypes.AddressTx
+	for fromIt.Valid() || toIt.Valid() {
+		tx, err := nextTraceAddressTx(fromIt, toIt, reverse)
+		if err != nil {
+			return nil, false, err
+		}
+		if limit > 0 && len(txs) >= limit && txs[len(txs)-1].Height != tx.Height {
+			return txs, true, nil
+		}
+		txs = append(txs, tx)
+	}
+	if err := fromIt.Error(); err != nil {
+		return nil, false, err
+	}
+	return txs, false, toIt.Error()
+}
+
+// GetContractCreator returns the eth tx whose traces created the contract,
+// returns nil if the contract creation is not indexed.
+func (ti *TraceIndexer) GetContractCreator(address common.Address) (*servertypes.TraceContractCreator, error) {
+	bz, err := ti.db.Get(TraceCreatorKey(address))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetContractCreator %s", address.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) != traceCreatorValueLength {
+		return nil, fmt.Errorf("wrong trace creator value length, expect: %d, got: %d", traceCreatorValueLength, len(bz))
+	}
+	return &servertypes.TraceContractCreator{
+		TracePosition: servertypes.TracePosition{
+			Height:     int64(sdk.BigEndianToUint64(bz[:8])),   //#nosec G115 -- int overflow is not a concern here
+			EthTxIndex: int32(sdk.BigEndianToUint64(bz[8:16])), //#nosec G115 -- int overflow is not a concern here
+		},
+		Creator: common.BytesToAddress(bz[16:]),
+	}, nil
+}
+
+func (ti *TraceIndexer) getByAddress(prefix byte, address common.Address, fromBlock, toBlock int64) ([]servertypes.TracePosition, error) {
+	it, err := ti.addressIterator(prefix, address, fromBlock, toBlock, false)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "getByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var positions []servertypes.TracePosition
+	for ; it.Valid(); it.Next() {
+		pos, err := parseTraceAddressKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, pos)
+	}
+	return positions, it.Error()
+}
+
+func (ti *TraceIndexer) addressIterator(prefix byte, address common.Address, fromBlock, toBlock int64, reverse bool) (dbm.Iterator, error) {
+	start := TraceAddressKey(prefix, address, fromBlock, 0)
+	// the upper bound is computed as uint64 to not overflow on math.MaxInt64
+	end := append(append([]byte{prefix}, address.Bytes()...), sdk.Uint64ToBigEndian(uint64(toBlock)+1)...) //nolint:gosec // G115 // block number won't exceed uint64
+	if reverse {
+		return ti.db.ReverseIterator(start, end)
+	}
+	return ti.db.Iterator(start, end)
+}
+
+// nextTraceAddressTx advances the sender or the recipient iterator, or both
+// when they are at the same eth tx, and returns the tx with the address roles.
+func nextTraceAddressTx(fromIt, toIt dbm.Iterator, reverse bool) (servertypes.AddressTx, error) {
+	var from, to *servertypes.TracePosition
+	if fromIt.Valid() {
+		pos, err := parseTraceAddressKey(fromIt.Key())
+		if err != nil {
+			return servertypes.AddressTx{}, err
+		}
+		from = &pos
+	}
+	if toIt.Valid() {
+		pos, err := parseTraceAddressKey(toIt.Key())
+		if err != nil {
+			return servertypes.AddressTx{}, err
+		}
+		to = &pos
+	}
+
+	precedes := func(a, b *servertypes.TracePosition) bool {
+		if a.Height != b.Height {
+			return (a.Height < b.Height) != reverse
+		}
+		return (a.EthTxIndex < b.EthTxIndex) != reverse
+	}
+	switch {
+	case to == nil || (from != nil && precedes(from, to)):
+		fromIt.Next()
+		return servertypes.AddressTx{Height: from.Height, EthTxIndex: from.EthTxIndex, Roles: servertypes.AddressRoleFrom}, nil
+	case from == nil || precedes(to, from):
+		toIt.Next()
+		return servertypes.AddressTx{Height: to.Height, EthTxIndex: to.EthTxIndex, Roles: servertypes.AddressRoleTo}, nil
+	default:
+		fromIt.Next()
+		toIt.Next()
+		return servertypes.AddressTx{Height: from.Height, EthTxIndex: from.EthTxIndex, Roles: servertypes.AddressRoleFrom | servertypes.AddressRoleTo}, nil
+	}
+}
+
+// TraceAddressKey returns the key for db entry: `(prefix, address, block number, tx index) -> empty`
+func TraceAddressKey(prefix byte, address common.Address, blockNumber int64, txIndex int32) []byte {
+	key := make([]byte, 0, TraceAddressKeyLength)
+	key = append(key, prefix)
+	key = append(key, address.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	key = append(key, sdk.Uint64ToBigEndian(uint64(txIndex))...)     //nolint:gosec // G115 // index won't exceed uint64
+	return key
+}
+
+// TraceBlockKey returns the key for db entry: `block number -> empty`
+func TraceBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixTraceBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// TraceCreatorKey returns the key for db entry: `(prefix, contract) -> (block number, tx index, creator)`
+func TraceCreatorKey(contract common.Address) []byte {
+	return append([]byte{KeyPrefixTraceCreator}, contract.Bytes()...)
+}
+
+func traceCreatorValue(blockNumber int64, txIndex int32, creator common.Address) []byte {
+	value := make([]byte, 0, traceCreatorValueLength)
+	value = append(value, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+	value = append(value, sdk.Uint64ToBigEndian(uint64(txIndex))...)     //nolint:gosec // G115 // index won't exceed uint64
+	return append(value, creator.Bytes()...)
+}
+
+func parseTraceAddressKey(key []byte) (servertypes.TracePosition, error) {
+	if len(key) != TraceAddressKeyLength {
+		return servertypes.TracePosition{}, fmt.Errorf("wrong trace address key length, expect: %d, got: %d", TraceAddressKeyLength, len(key))
+	}
+	offset := 1 + common.AddressLength
+	return servertypes.TracePosition{
+		Height:     int64(sdk.BigEndianToUint64(key[offset : offset+8])),    //#nosec G115 -- int overflow is not a concern here
+		EthTxIndex: int32(sdk.BigEndianToUint64(key[offset+8 : offset+16])), //#nosec G115 -- int overflow is not a concern here
+	}, nil
+}
+
+func parseTraceBlockKey(key []byte) (int64, error) {
+	if len(key) != TraceBlockKeyLength {
+		return 0, fmt.Errorf("wrong trace block key length, expect: %d, got: %d", TraceBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,102 @@
+package indexer_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+func TestTraceIndexer(t *testing.T) {
+	var (
+		alice    = common.BytesToAddress([]byte{0x1})
+		bob      = common.BytesToAddress([]byte{0x2})
+		contract = common.BytesToAddress([]byte{0x3})
+	)
+
+	idxer := indexer.NewTraceIndexer(dbm.NewMemDB(), log.NewNopLogger())
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	require.NoError(t, idxer.IndexBlock(5, []servertypes.TraceTxAddresses{
+		{EthTxIndex: 0, From: []common.Address{alice}, To: []common.Address{bob}},
+		{EthTxIndex: 1, From: []common.Address{bob}, To: []common.Address{alice}},
+	}))
+	require.NoError(t, idxer.IndexBlock(6, nil))
+	require.NoError(t, idxer.IndexBlock(7, []servertypes.TraceTxAddresses{
+		{
+			EthTxIndex: 0,
+			From:       []common.Address{alice},
+			To:         []common.Address{alice},
+			Created:    []servertypes.TraceCreation{{Contract: contract, Creator: alice}},
+		},
+	}))
+
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(7), last)
+
+	positions, err := idxer.GetByFromAddress(alice, 5, 7)
+	require.NoError(t, err)
+	require.Equal(t, []servertypes.TracePosition{{Height: 5, EthTxIndex: 0}, {Height: 7, EthTxIndex: 0}}, positions)
+
+	positions, err = idxer.GetByToAddress(alice, 5, 6)
+	require.NoError(t, err)
+	require.Equal(t, []servertypes.TracePosition{{Height: 5, EthTxIndex: 1}}, positions)
+
+	positions, err = idxer.GetByToAddress(bob, 6, 7)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+
+	txs, more, err := idxer.GetByAddress(alice, 0, math.MaxInt64, false, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Equal(t, []servertypes.AddressTx{
+		{Height: 5, EthTxIndex: 0, Roles: servertypes.AddressRoleFrom},
+		{Height: 5, EthTxIndex: 1, Roles: servertypes.AddressRoleTo},
+		{Height: 7, EthTxIndex: 0, Roles: servertypes.AddressRoleFrom | servertypes.AddressRoleTo},
+	}, txs)
+
+	// the txs of a block are never split
+	txs, more, err = idxer.GetByAddress(alice, 0, math.MaxInt64, false, 1)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Equal(t, []servertypes.AddressTx{
+		{Height: 5, EthTxIndex: 0, Roles: servertypes.AddressRoleFrom},
+		{Height: 5, EthTxIndex: 1, Roles: servertypes.AddressRoleTo},
+	}, txs)
+
+	txs, more, err = idxer.GetByAddress(alice, 0, 6, true, 0)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.Equal(t, []servertypes.AddressTx{
+		{Height: 5, EthTxIndex: 1, Roles: servertypes.AddressRoleTo},
+		{Height: 5, EthTxIndex: 0, Roles: servertypes.AddressRoleFrom},
+	}, txs)
+
+	creator, err := idxer.GetContractCreator(contract)
+	require.NoError(t, err)
+	require.Equal(t, &servertypes.TraceContractCreator{
+		TracePosition: servertypes.TracePosition{Height: 7, EthTxIndex: 0},
+		Creator:       alice,
+	}, creator)
+
+	creator, err = idxer.GetContractCreator(bob)
+	require.NoError(t, err)
+	require.Nil(t, creator)
+}
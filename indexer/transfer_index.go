@@ -0,0 +1,385 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// TransferKeyLength is the length of transfer key
+	TransferKeyLength = 1 + 8 + 8
+	// AddressTransferKeyLength is the length of address-transfer key
+	AddressTransferKeyLength = 1 + common.AddressLength + 8 + 8
+	// TransferBlockKeyLength is the length of transfer-block key
+	TransferBlockKeyLength = 1 + 8
+
+	// attributeKeyMsgIndex is the attribute baseapp adds to the events of the
+	// messages with the index of the message in the tx
+	attributeKeyMsgIndex = "msg_index"
+)
+
+var (
+	// transferTopic is the topic of the ERC-20 and ERC-721 Transfer events,
+	// told apart by the indexed token id of the ERC-721 ones.
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	// transferSingleTopic is the topic of the ERC-1155 TransferSingle event
+	transferSingleTopic = crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)"))
+	// transferBatchTopic is the topic of the ERC-1155 TransferBatch event
+	transferBatchTopic = crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])"))
+
+	uint256ArrayType, _ = abi.NewType("uint256[]", "", nil)
+	// transferBatchData are the non indexed arguments of TransferBatch
+	transferBatchData = abi.Arguments{{Type: uint256ArrayType}, {Type: uint256ArrayType}}
+
+	feeCollectorAddress = common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+)
+
+// TransferIndexEnabled returns true if the token and native coin transfers of
+// the indexed blocks are indexed by address.
+func (kv *KVIndexer) TransferIndexEnabled() bool {
+	return kv.transferIndex
+}
+
+// LastTransferIndexedBlock returns the latest block whose transfers are
+// indexed, returns -1 if no block is.
+func (kv *KVIndexer) LastTransferIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixTransferBlock}, []byte{KeyPrefixTransferBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastTransferIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseTransferBlockKey(it.Key())
+}
+
+// FirstTransferIndexedBlock returns the first block whose transfers are
+// indexed, returns -1 if no block is.
+func (kv *KVIndexer) FirstTransferIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixTransferBlock}, []byte{KeyPrefixTransferBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstTransferIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseTransferBlockKey(it.Key())
+}
+
+// GetTransfers returns at most limit transfers matching the filter, in
+// ascending order or descending order if reverse is set, and the position of
+// the next matching transfer if there is one. A zero limit returns all.
+func (kv *KVIndexer) GetTransfers(filter servertypes.TransferFilter, limit int) ([]*servertypes.Transfer, *servertypes.TransferPosition, error) {
+	if filter.FromBlock > filter.ToBlock {
+		return nil, nil, nil
+	}
+	from := servertypes.TransferPosition{Height: filter.FromBlock}
+	to := servertypes.TransferPosition{Height: filter.ToBlock, Index: math.MaxUint64}
+	if start := filter.Start; start != nil {
+		if filter.Reverse && transferPositionLess(*start, to) {
+			to = *start
+		} else if !filter.Reverse && transferPositionLess(from, *start) {
+			from = *start
+		}
+	}
+
+	// the address index of the sender or the recipient is iterated when the
+	// transfers are filtered by one of them
+	prefix := []byte{KeyPrefixTransfer}
+	var role uint8
+	switch {
+	case filter.FromAddress != nil:
+		prefix, role = append([]byte{KeyPrefixAddressTransfer}, filter.FromAddress.Bytes()...), servertypes.AddressRoleFrom
+	case filter.ToAddress != nil:
+		prefix, role = append([]byte{KeyPrefixAddressTransfer}, filter.ToAddress.Bytes()...), servertypes.AddressRoleTo
+	}
+	start := transferKey(prefix, from)
+	// the end is exclusive, so past the key of the last position
+	end := append(transferKey(prefix, to), 0)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if filter.Reverse {
+		it, err = kv.db.ReverseIterator(start, end)
+	} else {
+		it, err = kv.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "GetTransfers")
+	}
+	defer it.Close()
+
+	var transfers []*servertypes.Transfer
+	for ; it.Valid(); it.Next() {
+		value := it.Value()
+		if role != 0 {
+			if len(value) == 0 || value[0]&role == 0 {
+				continue
+			}
+			pos, err := parseTransferKey(it.Key(), len(prefix))
+			if err != nil {
+				return nil, nil, err
+			}
+			if value, err = kv.db.Get(TransferKey(pos.Height, pos.Index)); err != nil {
+				return nil, nil, errorsmod.Wrap(err, "GetTransfers")
+			}
+		}
+		var transfer servertypes.Transfer
+		if err := json.Unmarshal(value, &transfer); err != nil {
+			return nil, nil, errorsmod.Wrap(err, "GetTransfers, unmarshal transfer")
+		}
+		if !filter.Matches(&transfer) {
+			continue
+		}
+		if limit > 0 && len(transfers) >= limit {
+			return transfers, &transfer.TransferPosition, nil
+		}
+		transfers = append(transfers, &transfer)
+	}
+	return transfers, nil, it.Error()
+}
+
+// TransferKey returns the key for db entry: `(block number, transfer index) -> transfer`
+func TransferKey(blockNumber int64, index uint64) []byte {
+	return transferKey([]byte{KeyPrefixTransfer}, servertypes.TransferPosition{Height: blockNumber, Index: index})
+}
+
+// AddressTransferKey returns the key for db entry: `(address, block number, transfer index) -> address roles`
+func AddressTransferKey(address common.Address, blockNumber int64, index uint64) []byte {
+	prefix := append([]byte{KeyPrefixAddressTransfer}, address.Bytes()...)
+	return transferKey(prefix, servertypes.TransferPosition{Height: blockNumber, Index: index})
+}
+
+// TransferBlockKey returns the key for db entry: `block number -> nil`,
+// marking the transfers of the block as indexed.
+func TransferBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixTransferBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func transferKey(prefix []byte, pos servertypes.TransferPosition) []byte {
+	key := make([]byte, 0, len(prefix)+8+8)
+	key = append(key, prefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(pos.Height))...) //nolint:gosec // G115 // block number won't exceed uint64
+	key = append(key, sdk.Uint64ToBigEndian(pos.Index)...)
+	return key
+}
+
+// saveTransferIndexes numbers the transfers of the block by their position in
+// it, indexes them by sender and recipient into the kv db batch, and marks the
+// block as indexed.
+func saveTransferIndexes(batch dbm.Batch, height int64, transfers []*servertypes.Transfer) error {
+	for i, transfer := range transfers {
+		transfer.Height = height
+		transfer.Index = uint64(i) //nolint:gosec // G115 // index is never negative
+		bz, err := json.Marshal(transfer)
+		if err != nil {
+			return errorsmod.Wrap(err, "marshal transfer")
+		}
+		if err := batch.Set(TransferKey(height, transfer.Index), bz); err != nil {
+			return errorsmod.Wrap(err, "set transfer key")
+		}
+
+		roles := map[common.Address]uint8{transfer.From: servertypes.AddressRoleFrom}
+		roles[transfer.To] |= servertypes.AddressRoleTo
+		for addr, role := range roles {
+			if err := batch.Set(AddressTransferKey(addr, height, transfer.Index), []byte{role}); err != nil {
+				return errorsmod.Wrap(err, "set address-transfer key")
+			}
+		}
+	}
+	if err := batch.Set(TransferBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set transfer-block key")
+	}
+	return nil
+}
+
+// externalTransfer returns the transfer of the native coin value of the eth
+// tx, or nil if it doesn't transfer any.
+func externalTransfer(txHash common.Hash, msg *evmtypes.MsgEthereumTx, txResult *servertypes.TxResult) *servertypes.Transfer {
+	tx := msg.AsTransaction()
+	if txResult.Failed || tx.Value().Sign() <= 0 {
+		return nil
+	}
+	from := msg.GetSender()
+	to := crypto.CreateAddress(from, tx.Nonce())
+	if tx.To() != nil {
+		to = *tx.To()
+	}
+	return &servertypes.Transfer{
+		TxHash:   txHash,
+		Category: servertypes.TransferCategoryExternal,
+		From:     from,
+		To:       to,
+		Value:    tx.Value(),
+	}
+}
+
+// tokenTransfers returns the transfers of the standard ERC-20, ERC-721 and
+// ERC-1155 transfer logs of the tx.
+func tokenTransfers(logs []*ethtypes.Log) []*servertypes.Transfer {
+	var transfers []*servertypes.Transfer
+	for _, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+		contract := log.Address
+		switch {
+		case log.Topics[0] == transferTopic && len(log.Topics) == 3 && len(log.Data) == common.HashLength:
+			transfers = append(transfers, &servertypes.Transfer{
+				TxHash:   log.TxHash,
+				Category: servertypes.TransferCategoryERC20,
+				From:     common.BytesToAddress(log.Topics[1].Bytes()),
+				To:       common.BytesToAddress(log.Topics[2].Bytes()),
+				Contract: &contract,
+				Value:    new(big.Int).SetBytes(log.Data),
+			})
+		case log.Topics[0] == transferTopic && len(log.Topics) == 4:
+			transfers = append(transfers, &servertypes.Transfer{
+				TxHash:   log.TxHash,
+				Category: servertypes.TransferCategoryERC721,
+				From:     common.BytesToAddress(log.Topics[1].Bytes()),
+				To:       common.BytesToAddress(log.Topics[2].Bytes()),
+				Contract: &contract,
+				TokenID:  log.Topics[3].Big(),
+			})
+		case log.Topics[0] == transferSingleTopic && len(log.Topics) == 4 && len(log.Data) == 2*common.HashLength:
+			transfers = append(transfers, &servertypes.Transfer{
+				TxHash:   log.TxHash,
+				Category: servertypes.TransferCategoryERC1155,
+				From:     common.BytesToAddress(log.Topics[2].Bytes()),
+				To:       common.BytesToAddress(log.Topics[3].Bytes()),
+				Contract: &contract,
+				ERC1155: []servertypes.ERC1155Transfer{{
+					TokenID: new(big.Int).SetBytes(log.Data[:common.HashLength]),
+					Value:   new(big.Int).SetBytes(log.Data[common.HashLength:]),
+				}},
+			})
+		case log.Topics[0] == transferBatchTopic && len(log.Topics) == 4:
+			values, err := transferBatchData.Unpack(log.Data)
+			if err != nil {
+				continue
+			}
+			ids, _ := values[0].([]*big.Int)
+			amounts, _ := values[1].([]*big.Int)
+			if len(ids) != len(amounts) {
+				continue
+			}
+			batch := make([]servertypes.ERC1155Transfer, len(ids))
+			for i := range ids {
+				batch[i] = servertypes.ERC1155Transfer{TokenID: ids[i], Value: amounts[i]}
+			}
+			transfers = append(transfers, &servertypes.Transfer{
+				TxHash:   log.TxHash,
+				Category: servertypes.TransferCategoryERC1155,
+				From:     common.BytesToAddress(log.Topics[2].Bytes()),
+				To:       common.BytesToAddress(log.Topics[3].Bytes()),
+				Contract: &contract,
+				ERC1155:  batch,
+			})
+		}
+	}
+	return transfers
+}
+
+// nativeTransfers returns the bank transfers of the events of the tx, but the
+// payment and refund of its fees. The transfers are attributed to the hash of
+// the message that emitted them, the first one if unknown.
+func nativeTransfers(msgHashes []common.Hash, events []abci.Event) []*servertypes.Transfer {
+	var transfers []*servertypes.Transfer
+	for _, event := range events {
+		if event.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		var from, to common.Address
+		var coins sdk.Coins
+		var err error
+		txHash := msgHashes[0]
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case attributeKeyMsgIndex:
+				if msgIndex, err := strconv.Atoi(attr.Value); err == nil && msgIndex >= 0 && msgIndex < len(msgHashes) {
+					txHash = msgHashes[msgIndex]
+				}
+			case banktypes.AttributeKeySender:
+				from, err = parseBech32Address(attr.Value)
+			case banktypes.AttributeKeyRecipient:
+				to, err = parseBech32Address(attr.Value)
+			case sdk.AttributeKeyAmount:
+				coins, err = sdk.ParseCoinsNormalized(attr.Value)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err != nil || from == feeCollectorAddress || to == feeCollectorAddress {
+			continue
+		}
+		for _, coin := range coins {
+			transfers = append(transfers, &servertypes.Transfer{
+				TxHash:   txHash,
+				Category: servertypes.TransferCategoryNative,
+				From:     from,
+				To:       to,
+				Value:    coin.Amount.BigInt(),
+				Denom:    coin.Denom,
+			})
+		}
+	}
+	return transfers
+}
+
+func parseBech32Address(value string) (common.Address, error) {
+	addr, err := sdk.AccAddressFromBech32(value)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addr), nil
+}
+
+func transferPositionLess(a, b servertypes.TransferPosition) bool {
+	if a.Height != b.Height {
+		return a.Height < b.Height
+	}
+	return a.Index < b.Index
+}
+
+func parseTransferKey(key []byte, prefixLength int) (servertypes.TransferPosition, error) {
+	if len(key) != prefixLength+8+8 {
+		return servertypes.TransferPosition{}, fmt.Errorf("wrong transfer key length, expect: %d, got: %d", prefixLength+8+8, len(key))
+	}
+	return servertypes.TransferPosition{
+		Height: int64(sdk.BigEndianToUint64(key[prefixLength : prefixLength+8])), //#nosec G115 -- int overflow is not a concern here
+		Index:  sdk.BigEndianToUint64(key[prefixLength+8:]),
+	}, nil
+}
+
+func parseTransferBlockKey(key []byte) (int64, error) {
+	if len(key) != TransferBlockKeyLength {
+		return 0, fmt.Errorf("wrong transfer block key length, expect: %d, got: %d", TransferBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil //#nosec G115 -- int overflow is not a concern here
+}
@@ -0,0 +1,133 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetTransfers(t *testing.T) {
+	var (
+		alice = common.BytesToAddress([]byte{0x1})
+		bob   = common.BytesToAddress([]byte{0x2})
+		token = common.BytesToAddress([]byte{0x3})
+	)
+
+	db := dbm.NewMemDB()
+	transfers := []*servertypes.Transfer{
+		{TransferPosition: servertypes.TransferPosition{Height: 1, Index: 0}, Category: servertypes.TransferCategoryExternal, From: alice, To: bob, Value: big.NewInt(1)},
+		{TransferPosition: servertypes.TransferPosition{Height: 1, Index: 1}, Category: servertypes.TransferCategoryERC20, From: bob, To: alice, Contract: &token, Value: big.NewInt(2)},
+		{TransferPosition: servertypes.TransferPosition{Height: 3, Index: 0}, Category: servertypes.TransferCategoryNative, From: alice, To: alice, Value: big.NewInt(3), Denom: "atest"},
+		{TransferPosition: servertypes.TransferPosition{Height: 4, Index: 2}, Category: servertypes.TransferCategoryERC721, From: alice, To: bob, Contract: &token, TokenID: big.NewInt(4)},
+	}
+	for _, transfer := range transfers {
+		bz, err := json.Marshal(transfer)
+		require.NoError(t, err)
+		require.NoError(t, db.Set(indexer.TransferKey(transfer.Height, transfer.Index), bz))
+		roles := map[common.Address]uint8{transfer.From: servertypes.AddressRoleFrom}
+		roles[transfer.To] |= servertypes.AddressRoleTo
+		for addr, role := range roles {
+			require.NoError(t, db.Set(indexer.AddressTransferKey(addr, transfer.Height, transfer.Index), []byte{role}))
+		}
+	}
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+
+	res, next, err := idxer.GetTransfers(servertypes.TransferFilter{ToBlock: 10}, 0)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Equal(t, transfers, res)
+
+	// paginated by the position of the next transfer
+	res, next, err = idxer.GetTransfers(servertypes.TransferFilter{ToBlock: 10}, 2)
+	require.NoError(t, err)
+	require.Equal(t, transfers[:2], res)
+	require.Equal(t, &transfers[2].TransferPosition, next)
+	res, next, err = idxer.GetTransfers(servertypes.TransferFilter{ToBlock: 10, Start: next}, 2)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Equal(t, transfers[2:], res)
+
+	// filtered by sender
+	res, _, err = idxer.GetTransfers(servertypes.TransferFilter{FromAddress: &alice, ToBlock: 10, Reverse: true}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*servertypes.Transfer{transfers[3], transfers[2], transfers[0]}, res)
+
+	// filtered by recipient, block range and category
+	res, _, err = idxer.GetTransfers(servertypes.TransferFilter{
+		ToAddress:  &alice,
+		FromBlock:  1,
+		ToBlock:    3,
+		Categories: []string{servertypes.TransferCategoryERC20},
+	}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*servertypes.Transfer{transfers[1]}, res)
+
+	// filtered by contract, from a start position in reverse order
+	res, next, err = idxer.GetTransfers(servertypes.TransferFilter{
+		Contracts: []common.Address{token},
+		ToBlock:   10,
+		Reverse:   true,
+		Start:     &servertypes.TransferPosition{Height: 3},
+	}, 1)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Equal(t, []*servertypes.Transfer{transfers[1]}, res)
+}
+
+func TestIndexBlockCosmosTxTransfers(t *testing.T) {
+	var (
+		alice = sdk.AccAddress([]byte{0x1})
+		bob   = sdk.AccAddress([]byte{0x2})
+	)
+
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(constants.ExampleAttoDenom, 5))
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(alice, bob, coins)))
+	txBz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx, indexer.WithTransferIndex(true))
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{
+		Events: []abci.Event{{Type: banktypes.EventTypeTransfer, Attributes: []abci.EventAttribute{
+			{Key: banktypes.AttributeKeySender, Value: alice.String()},
+			{Key: banktypes.AttributeKeyRecipient, Value: bob.String()},
+			{Key: sdk.AttributeKeyAmount, Value: coins.String()},
+			{Key: "msg_index", Value: "0"},
+		}}},
+	}}))
+
+	// the transfer of the cosmos tx is keyed by its cosmos tx hash
+	res, _, err := idxer.GetTransfers(servertypes.TransferFilter{ToBlock: 1}, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*servertypes.Transfer{{
+		TransferPosition: servertypes.TransferPosition{Height: 1, Index: 0},
+		TxHash:           common.BytesToHash(cmttypes.Tx(txBz).Hash()),
+		Category:         servertypes.TransferCategoryNative,
+		From:             common.BytesToAddress(alice),
+		To:               common.BytesToAddress(bob),
+		Value:            big.NewInt(5),
+		Denom:            constants.ExampleAttoDenom,
+	}}, res)
+}
@@ -60,7 +60,14 @@ func (app *EVMD) configureEVMMempool(appOpts servertypes.AppOptions, logger log.
 				sdkmempool.NewDefaultSignerExtractionAdapter(),
 			),
 		)
-		app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
+		// reserve block space to the lanes and keep the bundles of the
+		// mempool atomic in the proposals
+		txSelector, err := evmmempool.NewProposalTxSelector(krakatoaMempool, server.GetMempoolLanes(appOpts, logger))
+		if err != nil {
+			return fmt.Errorf("creating proposal tx selector: %w", err)
+		}
+		abciProposalHandler.SetTxSelector(txSelector)
+		app.SetPrepareProposal(txSelector.PrepareProposalHandler(abciProposalHandler.PrepareProposalHandler()))
 
 		app.EVMMempool = krakatoaMempool
 		app.SetMempool(krakatoaMempool)
@@ -113,6 +120,10 @@ func (app *EVMD) createKrakatoaMempoolConfig(appOpts servertypes.AppOptions, log
 		EVMMempoolConfig:         *mempoolConfig,
 		PendingTxProposalTimeout: server.GetPendingTxProposalTimeout(appOpts, logger),
 		InsertQueueSize:          server.GetMempoolInsertQueueSize(appOpts, logger),
+		Journal:                  server.GetMempoolJournal(appOpts, logger),
+		Rejournal:                server.GetMempoolRejournal(appOpts, logger),
+		JournalLocals:            server.GetMempoolJournalLocals(appOpts, logger),
+		TxStatusRetention:        server.GetMempoolTxStatusRetention(appOpts, logger),
 	}
 }
 
@@ -0,0 +1,461 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBundleTxs is the max number of txs of a bundle.
+	MaxBundleTxs = 32
+	// MaxBundles is the max number of bundles kept by the mempool.
+	MaxBundles = 1000
+	// MaxBundleSimulations is the max number of bundles with revert
+	// protection simulated when building a proposal.
+	MaxBundleSimulations = 50
+	// DefaultMinBundleTip is the default min aggregate effective tip of the
+	// bundles, 1 gwei.
+	DefaultMinBundleTip = 1_000_000_000
+)
+
+var (
+	// ErrBundleReverted is returned when a tx of a bundle with revert
+	// protection reverts in its simulation.
+	ErrBundleReverted = errors.New("bundle tx reverted")
+	// ErrBundleExpired is returned when a bundle can't be included in any
+	// future block.
+	ErrBundleExpired = errors.New("bundle expired")
+	// ErrBundleUnderpriced is returned when the aggregate effective tip of a
+	// bundle is lower than the min bundle tip of the mempool.
+	ErrBundleUnderpriced = errors.New("bundle underpriced")
+)
+
+// EVMTxApplier is implemented by the VM keepers that can execute EVM txs. It's
+// used to simulate the bundles with revert protection.
+type EVMTxApplier interface {
+	ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*vmtypes.MsgEthereumTxResponse, error)
+}
+
+// Bundle is an ordered set of EVM txs that are included contiguously in one
+// block, or not at all.
+type Bundle struct {
+	Txs []*ethtypes.Transaction
+	// BlockNumber is the first block the bundle can be included in.
+	BlockNumber uint64
+	// MaxBlockNumber is the last block the bundle can be included in, the
+	// bundle only targets BlockNumber if it's zero.
+	MaxBlockNumber uint64
+	// MinTimestamp and MaxTimestamp bound the time of the blocks the bundle
+	// can be included in, if they're not zero. The bundle expires after
+	// MaxTimestamp.
+	MinTimestamp uint64
+	MaxTimestamp uint64
+	// RevertProtection drops the bundle instead of including it if any of
+	// its txs reverts when simulated on top of the latest block.
+	RevertProtection bool
+}
+
+// Hash returns the hash of the bundle, the hash of the concatenated hashes of
+// its txs.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// Validate performs a stateless validation of the bundle.
+func (b *Bundle) Validate() error {
+	if len(b.Txs) == 0 {
+		return errors.New("bundle has no txs")
+	}
+	if len(b.Txs) > MaxBundleTxs {
+		return fmt.Errorf("bundle has %d txs, more than the max of %d", len(b.Txs), MaxBundleTxs)
+	}
+	if b.BlockNumber == 0 {
+		return errors.New("bundle block number is not set")
+	}
+	if b.MaxBlockNumber != 0 && b.MaxBlockNumber < b.BlockNumber {
+		return fmt.Errorf("max block number %d is lower than block number %d", b.MaxBlockNumber, b.BlockNumber)
+	}
+	if b.MaxTimestamp != 0 && b.MaxTimestamp < b.MinTimestamp {
+		return fmt.Errorf("max timestamp %d is lower than min timestamp %d", b.MaxTimestamp, b.MinTimestamp)
+	}
+	seen := make(map[common.Hash]struct{}, len(b.Txs))
+	for _, tx := range b.Txs {
+		if _, ok := seen[tx.Hash()]; ok {
+			return fmt.Errorf("bundle has tx %s twice", tx.Hash())
+		}
+		seen[tx.Hash()] = struct{}{}
+	}
+	return nil
+}
+
+// lastBlockNumber returns the last block the bundle can be included in.
+func (b *Bundle) lastBlockNumber() uint64 {
+	return max(b.BlockNumber, b.MaxBlockNumber)
+}
+
+// includable returns true if the bundle can be included in the block at
+// height and time.
+func (b *Bundle) includable(height, time uint64) bool {
+	return height >= b.BlockNumber && height <= b.lastBlockNumber() &&
+		time >= b.MinTimestamp && (b.MaxTimestamp == 0 || time <= b.MaxTimestamp)
+}
+
+// expired returns true if the bundle can't be included in any block after
+// the one at height and time.
+func (b *Bundle) expired(height, time uint64) bool {
+	return height >= b.lastBlockNumber() || (b.MaxTimestamp != 0 && time >= b.MaxTimestamp)
+}
+
+// tip returns the aggregate effective tip of the bundle at the base fee, the
+// gas weighted average of the effective tips of its txs.
+func (b *Bundle) tip(baseFee *big.Int) *big.Int {
+	fees, gas := new(big.Int), new(big.Int)
+	for _, tx := range b.Txs {
+		// the tip is negative if the tx can't pay the base fee
+		tip, _ := tx.EffectiveGasTip(baseFee)
+		txGas := new(big.Int).SetUint64(tx.Gas())
+		fees.Add(fees, tip.Mul(tip, txGas))
+		gas.Add(gas, txGas)
+	}
+	if gas.Sign() == 0 {
+		return fees
+	}
+	return fees.Quo(fees, gas)
+}
+
+// bundleTx is the position of a tx in a bundle.
+type bundleTx struct {
+	bundle *Bundle
+	index  int
+}
+
+// bundleStore holds the bundles of the mempool, indexed by the hashes of
+// their txs. It's safe for concurrent use.
+type bundleStore struct {
+	mu      sync.RWMutex
+	bundles map[common.Hash]*Bundle
+	txs     map[common.Hash]bundleTx
+	// simulated is the height of the latest block the bundles with revert
+	// protection were simulated on top of
+	simulated map[common.Hash]uint64
+}
+
+func newBundleStore() *bundleStore {
+	return &bundleStore{
+		bundles:   make(map[common.Hash]*Bundle),
+		txs:       make(map[common.Hash]bundleTx),
+		simulated: make(map[common.Hash]uint64),
+	}
+}
+
+// add adds a bundle, returning an error if one of its txs already is in
+// another bundle.
+func (s *bundleStore) add(bundle *Bundle) (common.Hash, error) {
+	hash := bundle.Hash()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bundles[hash]; ok {
+		return common.Hash{}, fmt.Errorf("bundle %s already known", hash)
+	}
+	if len(s.bundles) >= MaxBundles {
+		return common.Hash{}, fmt.Errorf("bundles pool is full, max %d bundles", MaxBundles)
+	}
+	for _, tx := range bundle.Txs {
+		if _, ok := s.txs[tx.Hash()]; ok {
+			return common.Hash{}, fmt.Errorf("tx %s already is in another bundle", tx.Hash())
+		}
+	}
+	s.bundles[hash] = bundle
+	for i, tx := range bundle.Txs {
+		s.txs[tx.Hash()] = bundleTx{bundle: bundle, index: i}
+	}
+	return hash, nil
+}
+
+// get returns the bundle of a tx and its position in it, or nil if the tx is
+// not in any bundle.
+func (s *bundleStore) get(txHash common.Hash) (*Bundle, int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	btx, ok := s.txs[txHash]
+	if !ok {
+		return nil, 0
+	}
+	return btx.bundle, btx.index
+}
+
+// remove removes a bundle.
+func (s *bundleStore) remove(bundle *Bundle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeLocked(bundle)
+}
+
+func (s *bundleStore) removeLocked(bundle *Bundle) {
+	delete(s.bundles, bundle.Hash())
+	delete(s.simulated, bundle.Hash())
+	for _, tx := range bundle.Txs {
+		delete(s.txs, tx.Hash())
+	}
+}
+
+// setSimulated records that the bundle was simulated on top of the block at
+// height, if it's still in the store.
+func (s *bundleStore) setSimulated(bundle *Bundle, height uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bundles[bundle.Hash()]; ok {
+		s.simulated[bundle.Hash()] = height
+	}
+}
+
+// isSimulated returns true if the bundle was simulated on top of the block at
+// height.
+func (s *bundleStore) isSimulated(bundle *Bundle, height uint64) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	simulated, ok := s.simulated[bundle.Hash()]
+	return ok && simulated == height
+}
+
+// includable returns the bundles that can be included in the block at height
+// and time, and removes the ones that expired before it.
+func (s *bundleStore) includable(height, time uint64) []*Bundle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var bundles []*Bundle
+	for _, bundle := range s.bundles {
+		switch {
+		case bundle.includable(height, time):
+			bundles = append(bundles, bundle)
+		case height > bundle.lastBlockNumber() || (bundle.MaxTimestamp != 0 && time > bundle.MaxTimestamp):
+			s.removeLocked(bundle)
+		}
+	}
+	return bundles
+}
+
+// InsertBundle adds a bundle of EVM txs to the mempool, that is placed as a
+// unit in the proposals of the blocks it targets, ordered by its aggregate
+// effective tip. The bundle is validated on top of the latest block, and
+// simulated if it has revert protection. It returns the hash of the bundle.
+//
+// NOTE: the bundles are local to this node, they are not gossiped and are
+// only included in the blocks it proposes.
+func (m *KrakatoaMempool) InsertBundle(_ context.Context, bundle *Bundle) (common.Hash, error) {
+	if err := bundle.Validate(); err != nil {
+		return common.Hash{}, fmt.Errorf("invalid bundle: %w", err)
+	}
+
+	head := m.blockchain.CurrentBlock()
+	if bundle.expired(head.Number.Uint64(), head.Time) {
+		return common.Hash{}, fmt.Errorf("%w: latest block is %d", ErrBundleExpired, head.Number.Uint64())
+	}
+	if tip := bundle.tip(head.BaseFee); tip.Cmp(m.minBundleTip.ToBig()) < 0 {
+		return common.Hash{}, fmt.Errorf("%w: aggregate tip %s, min %s", ErrBundleUnderpriced, tip, m.minBundleTip)
+	}
+	for _, tx := range bundle.Txs {
+		if m.txPool.Has(tx.Hash()) {
+			return common.Hash{}, fmt.Errorf("bundle tx %s already is in the mempool", tx.Hash())
+		}
+	}
+	if bundle.RevertProtection && m.txApplier == nil {
+		return common.Hash{}, errors.New("bundle revert protection is not supported")
+	}
+	height, err := m.simulateBundle(bundle)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := m.bundles.add(bundle)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	m.bundles.setSimulated(bundle, height)
+	return hash, nil
+}
+
+// simulateBundle validates the txs of the bundle in order on top of the latest
+// block, and executes them if the bundle has revert protection, returning an
+// error wrapping ErrBundleReverted if any of them reverts. It returns the
+// height of the block the bundle was simulated on top of.
+func (m *KrakatoaMempool) simulateBundle(bundle *Bundle) (uint64, error) {
+	head := m.blockchain.CurrentBlock()
+	if m.anteHandler == nil {
+		return head.Number.Uint64(), nil
+	}
+
+	latestCtx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return 0, fmt.Errorf("getting latest context: %w", err)
+	}
+	rechecker := NewTxRechecker(m.anteHandler, NewTxEncoder(m.txConfig))
+	rechecker.Update(latestCtx, head)
+	ctx, _ := rechecker.GetContext()
+
+	for _, tx := range bundle.Txs {
+		newCtx, err := rechecker.RecheckEVM(ctx, tx)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bundle tx %s: %w", tx.Hash(), err)
+		}
+		if !newCtx.IsZero() {
+			ctx = newCtx
+		}
+		if !bundle.RevertProtection {
+			continue
+		}
+		res, err := m.txApplier.ApplyTransaction(ctx, tx)
+		if err != nil {
+			return 0, fmt.Errorf("applying bundle tx %s: %w", tx.Hash(), err)
+		}
+		if res.Failed() {
+			return 0, fmt.Errorf("%w: %s: %s", ErrBundleReverted, tx.Hash(), res.VmError)
+		}
+	}
+	return head.Number.Uint64(), nil
+}
+
+// addBundles adds the bundles that can be included in the block at height and
+// time to the evm iterator, by decreasing aggregate effective tip at the base
+// fee. The ones with revert protection are simulated again once per block,
+// at most MaxBundleSimulations of them per proposal and until ctx is done,
+// and dropped if they revert. The ones that aren't simulated on top of the
+// latest block, or whose tip is lower than the min bundle tip, are skipped.
+func (m *KrakatoaMempool) addBundles(ctx context.Context, evmIterator *miner.TransactionsByPriceAndNonce, height, time uint64, baseFee *big.Int) {
+	type tippedBundle struct {
+		bundle *Bundle
+		tip    *big.Int
+	}
+	var bundles []tippedBundle
+	for _, bundle := range m.bundles.includable(height, time) {
+		if tip := bundle.tip(baseFee); tip.Cmp(m.minBundleTip.ToBig()) >= 0 {
+			bundles = append(bundles, tippedBundle{bundle: bundle, tip: tip})
+		}
+	}
+	slices.SortFunc(bundles, func(a, b tippedBundle) int {
+		if c := b.tip.Cmp(a.tip); c != 0 {
+			return c
+		}
+		hashA, hashB := a.bundle.Hash(), b.bundle.Hash()
+		return bytes.Compare(hashA[:], hashB[:])
+	})
+
+	signer := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	simulations := 0
+	for _, tb := range bundles {
+		bundle := tb.bundle
+		if bundle.RevertProtection && !m.bundles.isSimulated(bundle, height-1) {
+			if simulations >= MaxBundleSimulations || ctx.Err() != nil {
+				m.logger.Debug("skipping bundle not simulated", "hash", bundle.Hash())
+				continue
+			}
+			simulations++
+			simulated, err := m.simulateBundle(bundle)
+			if err != nil {
+				m.logger.Debug("dropping bundle", "hash", bundle.Hash(), "err", err)
+				m.bundles.remove(bundle)
+				continue
+			}
+			m.bundles.setSimulated(bundle, simulated)
+		}
+
+		senders, err := bundleSenders(signer, bundle)
+		if err != nil {
+			m.logger.Debug("skipping bundle", "hash", bundle.Hash(), "err", err)
+			continue
+		}
+		lazies := make([]*txpool.LazyTransaction, len(bundle.Txs))
+		for i, tx := range bundle.Txs {
+			lazies[i] = &txpool.LazyTransaction{
+				Hash:      tx.Hash(),
+				Tx:        tx,
+				Time:      tx.Time(),
+				GasFeeCap: uint256.MustFromBig(tx.GasFeeCap()),
+				GasTipCap: uint256.MustFromBig(tx.GasTipCap()),
+				Gas:       tx.Gas(),
+				BlobGas:   tx.BlobGas(),
+			}
+		}
+		if err := evmIterator.AddBundle(lazies, senders); err != nil {
+			m.logger.Debug("skipping bundle", "hash", bundle.Hash(), "err", err)
+		}
+	}
+}
+
+// bundleSenders returns the distinct senders of the txs of the bundle.
+func bundleSenders(signer ethtypes.Signer, bundle *Bundle) ([]common.Address, error) {
+	var senders []common.Address
+	for _, tx := range bundle.Txs {
+		from, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("bundle tx %s sender: %w", tx.Hash(), err)
+		}
+		if !slices.Contains(senders, from) {
+			senders = append(senders, from)
+		}
+	}
+	return senders, nil
+}
+
+// bundleOf returns the bundle of an EVM tx and its position in it, or nil if
+// the tx is not an EVM tx of a bundle.
+func (m *KrakatoaMempool) bundleOf(tx sdk.Tx) (*Bundle, int) {
+	ethMsg, err := evmTxFromCosmosTx(tx)
+	if err != nil {
+		return nil, 0
+	}
+	return m.bundles.get(ethMsg.Hash())
+}
+
+// bundleUsage returns the block space used by the txs of the bundle, as
+// returned by the mempool iterator, and their senders.
+func (m *KrakatoaMempool) bundleUsage(ctx context.Context, bundle *Bundle) (txBytes, txGas uint64, signers []common.Address, err error) {
+	bondDenom := m.vmKeeper.GetEvmCoinInfo(sdk.UnwrapSDKContext(ctx)).Denom
+	ethSigner := ethtypes.LatestSignerForChainID(m.blockchain.Config().ChainID)
+	txs := make([]cmttypes.Tx, len(bundle.Txs))
+	for i, tx := range bundle.Txs {
+		var msg vmtypes.MsgEthereumTx
+		if err := msg.FromSignedEthereumTx(tx, ethSigner); err != nil {
+			return 0, 0, nil, fmt.Errorf("converting bundle tx %s: %w", tx.Hash(), err)
+		}
+		cosmosTx, err := msg.BuildTx(m.txConfig.NewTxBuilder(), bondDenom)
+		if err != nil {
+			return 0, 0, nil, fmt.Errorf("building bundle tx %s: %w", tx.Hash(), err)
+		}
+		if txs[i], err = m.txConfig.TxEncoder()(cosmosTx); err != nil {
+			return 0, 0, nil, fmt.Errorf("encoding bundle tx %s: %w", tx.Hash(), err)
+		}
+		txGas += tx.Gas()
+		signers = append(signers, common.BytesToAddress(msg.From))
+	}
+	return uint64(cmttypes.ComputeProtoSizeForTxs(txs)), txGas, signers, nil //nolint:gosec // G115 // tx sizes are never negative
+}
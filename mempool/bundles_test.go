@@ -0,0 +1,177 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/mempool/txpool"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBundleValidate(t *testing.T) {
+	_, b := setupIteratorTest(t)
+	_, key := newAddrKey(t)
+	tx0 := buildEVMTx(t, key, 0, big.NewInt(2e9), big.NewInt(1e9), b.Config().ChainID).Tx
+	tx1 := buildEVMTx(t, key, 1, big.NewInt(2e9), big.NewInt(1e9), b.Config().ChainID).Tx
+
+	testCases := []struct {
+		name   string
+		bundle Bundle
+		errMsg string
+	}{
+		{"valid", Bundle{Txs: []*ethtypes.Transaction{tx0, tx1}, BlockNumber: 10}, ""},
+		{"no txs", Bundle{BlockNumber: 10}, "no txs"},
+		{"no block number", Bundle{Txs: []*ethtypes.Transaction{tx0}}, "block number is not set"},
+		{"block range", Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 10, MaxBlockNumber: 9}, "lower than block number"},
+		{"timestamp range", Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 10, MinTimestamp: 5, MaxTimestamp: 4}, "lower than min timestamp"},
+		{"duplicate tx", Bundle{Txs: []*ethtypes.Transaction{tx0, tx0}, BlockNumber: 10}, "twice"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bundle.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+
+	bundle := Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 10, MaxBlockNumber: 12, MaxTimestamp: 100}
+	require.False(t, bundle.includable(9, 50))
+	require.True(t, bundle.includable(12, 50))
+	require.False(t, bundle.includable(11, 101))
+	require.False(t, bundle.expired(11, 50))
+	require.True(t, bundle.expired(12, 50))
+	require.True(t, bundle.expired(11, 100))
+	// the tip of a tx is capped by its fee cap left after the base fee
+	require.Equal(t, big.NewInt(1e9), bundle.tip(nil))
+	require.Equal(t, big.NewInt(5e8), bundle.tip(big.NewInt(15e8)))
+}
+
+func TestBundleStore(t *testing.T) {
+	_, b := setupIteratorTest(t)
+	_, key := newAddrKey(t)
+	tx0 := buildEVMTx(t, key, 0, big.NewInt(2e9), big.NewInt(1e9), b.Config().ChainID).Tx
+	tx1 := buildEVMTx(t, key, 1, big.NewInt(2e9), big.NewInt(1e9), b.Config().ChainID).Tx
+
+	store := newBundleStore()
+	expiring := &Bundle{Txs: []*ethtypes.Transaction{tx0}, BlockNumber: 5}
+	_, err := store.add(expiring)
+	require.NoError(t, err)
+	// a tx can only be in one bundle
+	_, err = store.add(&Bundle{Txs: []*ethtypes.Transaction{tx1, tx0}, BlockNumber: 5})
+	require.ErrorContains(t, err, "another bundle")
+
+	bundle := &Bundle{Txs: []*ethtypes.Transaction{tx1}, BlockNumber: 5, MaxBlockNumber: 10}
+	hash, err := store.add(bundle)
+	require.NoError(t, err)
+	require.Equal(t, bundle.Hash(), hash)
+
+	got, index := store.get(tx1.Hash())
+	require.Equal(t, bundle, got)
+	require.Equal(t, 0, index)
+
+	require.ElementsMatch(t, []*Bundle{expiring, bundle}, store.includable(5, 0))
+	// the expired bundles are pruned
+	require.Equal(t, []*Bundle{bundle}, store.includable(6, 0))
+	got, _ = store.get(tx0.Hash())
+	require.Nil(t, got)
+
+	store.remove(bundle)
+	require.Empty(t, store.includable(6, 0))
+}
+
+func TestTransactionsByPriceAndNonceBundle(t *testing.T) {
+	_, b := setupIteratorTest(t)
+	chainID := b.Config().ChainID
+	addrA, keyA := newAddrKey(t)
+	addrB, keyB := newAddrKey(t)
+	addrC, keyC := newAddrKey(t)
+
+	a0 := buildEVMTx(t, keyA, 0, big.NewInt(10e9), big.NewInt(3e9), chainID)
+	b0 := buildEVMTx(t, keyB, 0, big.NewInt(10e9), big.NewInt(1e9), chainID)
+	// the aggregate tip of the bundle is 2 gwei, between the ones of a0 and b0
+	c0 := buildEVMTx(t, keyC, 0, big.NewInt(10e9), big.NewInt(1e9), chainID)
+	c1 := buildEVMTx(t, keyC, 1, big.NewInt(10e9), big.NewInt(3e9), chainID)
+	// the pool tx of the sender of the bundle conflicts with the bundle
+	pooled := buildEVMTx(t, keyC, 0, big.NewInt(20e9), big.NewInt(5e9), chainID)
+
+	evmIter := makeEVMIterator(map[common.Address][]*txpool.LazyTransaction{
+		addrA: {a0},
+		addrB: {b0},
+		addrC: {pooled},
+	}, big.NewInt(1e9))
+	require.NoError(t, evmIter.AddBundle([]*txpool.LazyTransaction{c0, c1}, []common.Address{addrC}))
+	// a sender can only be in one bundle
+	c2 := buildEVMTx(t, keyC, 2, big.NewInt(10e9), big.NewInt(3e9), chainID)
+	require.ErrorContains(t, evmIter.AddBundle([]*txpool.LazyTransaction{c2}, []common.Address{addrC}), "another bundle")
+
+	var order []common.Hash
+	for !evmIter.Empty() {
+		tx, _ := evmIter.Peek()
+		order = append(order, tx.Hash)
+		evmIter.Shift()
+	}
+	require.Equal(t, []common.Hash{a0.Hash, c0.Hash, c1.Hash, b0.Hash}, order)
+
+	// a bundle tx that can't pay the base fee invalidates the bundle
+	low := buildEVMTx(t, keyC, 2, big.NewInt(1e8), big.NewInt(1e8), chainID)
+	require.Error(t, evmIter.AddBundle([]*txpool.LazyTransaction{low}, nil))
+}
+
+func TestProposalTxSelectorBundles(t *testing.T) {
+	bundle := &Bundle{Txs: make([]*ethtypes.Transaction, 3)}
+	// txs 1 to 3 are the bundle
+	selector := newTestTxSelector(t, nil, func(tx sdk.Tx) (*Bundle, int) {
+		id := tx.(selectorTx).id
+		if id >= 1 && id <= 3 {
+			return bundle, id - 1
+		}
+		return nil, 0
+	})
+	selector.bundleUsage = func(context.Context, *Bundle) (uint64, uint64, []common.Address, error) {
+		txs := []cmttypes.Tx{{1}, {2}, {3}}
+		return uint64(cmttypes.ComputeProtoSizeForTxs(txs)), 300, nil, nil //nolint:gosec // G115 // test tx sizes
+	}
+
+	ctx := context.Background()
+	selectTxs := func(maxBlockGas uint64, ids ...int) {
+		selector.Clear()
+		for _, id := range ids {
+			if selector.SelectTxForProposal(ctx, 1_000_000, maxBlockGas, selectorTx{id: id, gas: 100}, []byte{byte(id)}) {
+				break
+			}
+		}
+		selector.endSelection()
+	}
+
+	testCases := []struct {
+		name        string
+		maxBlockGas uint64
+		ids         []int
+		selected    [][]byte
+	}{
+		{"whole bundle", 0, []int{0, 1, 2, 3, 4}, [][]byte{{0}, {1}, {2}, {3}, {4}}},
+		// the txs already selected are kept, their signers' sequences are
+		// recorded by the proposal handler
+		{"interrupted bundle", 0, []int{0, 1, 2, 4}, [][]byte{{0}, {1}, {2}, {4}}},
+		{"bundle missing its first tx", 0, []int{0, 2, 3, 4}, [][]byte{{0}, {4}}},
+		// the whole bundle doesn't fit when its first tx is returned
+		{"bundle not fitting", 250, []int{0, 1, 2, 3, 4}, [][]byte{{0}, {4}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selectTxs(tc.maxBlockGas, tc.ids...)
+			require.Equal(t, tc.selected, selector.SelectedTxs(ctx))
+		})
+	}
+}
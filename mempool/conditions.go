@@ -0,0 +1,204 @@
+package mempool
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTxConditionsCost is the max number of storage roots and slots that the
+// conditions of a tx can refer to, since they are evaluated on each recheck.
+const MaxTxConditionsCost = 1000
+
+// KnownAccount is the expected storage of an account, either its storage root
+// or the values of some of its storage slots.
+type KnownAccount struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// UnmarshalJSON decodes a known account given either as a storage root hash
+// or as an object of slot to value.
+func (ka *KnownAccount) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var root common.Hash
+		if err := json.Unmarshal(data, &root); err != nil {
+			return err
+		}
+		*ka = KnownAccount{StorageRoot: &root}
+		return nil
+	}
+
+	var slots map[common.Hash]common.Hash
+	if err := json.Unmarshal(data, &slots); err != nil {
+		return err
+	}
+	*ka = KnownAccount{StorageSlots: slots}
+	return nil
+}
+
+// MarshalJSON encodes a known account in the same form it was given.
+func (ka KnownAccount) MarshalJSON() ([]byte, error) {
+	if ka.StorageRoot != nil {
+		return json.Marshal(ka.StorageRoot)
+	}
+	return json.Marshal(ka.StorageSlots)
+}
+
+// TxConditions are the preconditions of a tx submitted with
+// eth_sendRawTransactionConditional. The tx is only kept in the mempool while
+// all of them hold against the latest block.
+type TxConditions struct {
+	KnownAccounts  map[common.Address]KnownAccount `json:"knownAccounts,omitempty"`
+	BlockNumberMin *hexutil.Uint64                 `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Uint64                 `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                 `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                 `json:"timestampMax,omitempty"`
+}
+
+// Cost returns the number of storage roots and slots the conditions refer to.
+func (c *TxConditions) Cost() int {
+	cost := 0
+	for _, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			cost++
+		}
+		cost += len(account.StorageSlots)
+	}
+	return cost
+}
+
+// Validate performs a stateless validation of the conditions.
+func (c *TxConditions) Validate() error {
+	if c.BlockNumberMin != nil && c.BlockNumberMax != nil && *c.BlockNumberMin > *c.BlockNumberMax {
+		return fmt.Errorf("blockNumberMin %d is greater than blockNumberMax %d", *c.BlockNumberMin, *c.BlockNumberMax)
+	}
+	if c.TimestampMin != nil && c.TimestampMax != nil && *c.TimestampMin > *c.TimestampMax {
+		return fmt.Errorf("timestampMin %d is greater than timestampMax %d", *c.TimestampMin, *c.TimestampMax)
+	}
+	if cost := c.Cost(); cost > MaxTxConditionsCost {
+		return fmt.Errorf("conditions cost %d exceeds the max of %d", cost, MaxTxConditionsCost)
+	}
+	return nil
+}
+
+// Check returns an error wrapping ErrTxConditionsNotMet if any of the
+// conditions does not hold against the block and state of the context.
+func (c *TxConditions) Check(ctx sdk.Context, vmKeeper VMKeeperI) error {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // G115 // block height is never negative
+	if c.BlockNumberMin != nil && height < uint64(*c.BlockNumberMin) {
+		return fmt.Errorf("%w: block number %d is lower than %d", ErrTxConditionsNotMet, height, *c.BlockNumberMin)
+	}
+	if c.BlockNumberMax != nil && height > uint64(*c.BlockNumberMax) {
+		return fmt.Errorf("%w: block number %d is greater than %d", ErrTxConditionsNotMet, height, *c.BlockNumberMax)
+	}
+
+	timestamp := uint64(ctx.BlockTime().Unix()) //nolint:gosec // G115 // block time is never before the epoch
+	if c.TimestampMin != nil && timestamp < uint64(*c.TimestampMin) {
+		return fmt.Errorf("%w: timestamp %d is lower than %d", ErrTxConditionsNotMet, timestamp, *c.TimestampMin)
+	}
+	if c.TimestampMax != nil && timestamp > uint64(*c.TimestampMax) {
+		return fmt.Errorf("%w: timestamp %d is greater than %d", ErrTxConditionsNotMet, timestamp, *c.TimestampMax)
+	}
+
+	for addr, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			if root := storageRoot(ctx, vmKeeper, addr); root != *account.StorageRoot {
+				return fmt.Errorf("%w: storage root of %s is %s, expected %s", ErrTxConditionsNotMet, addr, root, account.StorageRoot)
+			}
+			continue
+		}
+		for slot, expected := range account.StorageSlots {
+			if value := vmKeeper.GetState(ctx, addr, slot); value != expected {
+				return fmt.Errorf("%w: storage slot %s of %s is %s, expected %s", ErrTxConditionsNotMet, slot, addr, value, expected)
+			}
+		}
+	}
+	return nil
+}
+
+// storageRoot computes the root of the storage trie of an account as
+// ethereum would, since the keeper doesn't store accounts in tries.
+func storageRoot(ctx sdk.Context, vmKeeper VMKeeperI, addr common.Address) common.Hash {
+	type entry struct {
+		key   []byte
+		value []byte
+	}
+	var entries []entry
+	vmKeeper.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+		if value == (common.Hash{}) {
+			return true
+		}
+		// values are rlp encoded byte strings, so this never fails
+		enc, _ := rlp.EncodeToBytes(common.TrimLeftZeroes(value[:]))
+		entries = append(entries, entry{key: crypto.Keccak256(key[:]), value: enc})
+		return true
+	})
+	if len(entries) == 0 {
+		return ethtypes.EmptyRootHash
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+	st := trie.NewStackTrie(nil)
+	for _, e := range entries {
+		if err := st.Update(e.key, e.value); err != nil {
+			// keys are sorted and of the same length, so this never happens
+			panic(err)
+		}
+	}
+	return st.Hash()
+}
+
+// txConditionsStore holds the conditions of the txs inserted in the mempool
+// with InsertConditional, by tx hash.
+type txConditionsStore struct {
+	conditions map[common.Hash]*TxConditions
+	lock       sync.RWMutex
+}
+
+func newTxConditionsStore() *txConditionsStore {
+	return &txConditionsStore{conditions: make(map[common.Hash]*TxConditions)}
+}
+
+// Set stores the conditions of a tx, it returns an error if the tx already
+// has conditions.
+func (s *txConditionsStore) Set(hash common.Hash, conditions *TxConditions) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.conditions[hash]; ok {
+		return errors.New("tx already has conditions")
+	}
+	s.conditions[hash] = conditions
+	return nil
+}
+
+// Get returns the conditions of a tx, or nil if it has none.
+func (s *txConditionsStore) Get(hash common.Hash) *TxConditions {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.conditions[hash]
+}
+
+// Remove removes the conditions of a tx.
+func (s *txConditionsStore) Remove(hash common.Hash) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.conditions, hash)
+}
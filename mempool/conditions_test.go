@@ -0,0 +1,162 @@
+package mempool_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/mocks"
+)
+
+func TestTxConditionsJSON(t *testing.T) {
+	var conditions mempool.TxConditions
+	err := json.Unmarshal([]byte(`{
+		"knownAccounts": {
+			"0x0000000000000000000000000000000000000001": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+			"0x0000000000000000000000000000000000000002": {
+				"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002"
+			}
+		},
+		"blockNumberMin": "0x10",
+		"timestampMax": "0x20"
+	}`), &conditions)
+	require.NoError(t, err)
+
+	root := conditions.KnownAccounts[common.BytesToAddress([]byte{0x1})]
+	require.Equal(t, types.EmptyRootHash, *root.StorageRoot)
+	slots := conditions.KnownAccounts[common.BytesToAddress([]byte{0x2})]
+	require.Nil(t, slots.StorageRoot)
+	require.Equal(t, common.BytesToHash([]byte{0x2}), slots.StorageSlots[common.BytesToHash([]byte{0x1})])
+	require.Equal(t, hexutil.Uint64(0x10), *conditions.BlockNumberMin)
+	require.Nil(t, conditions.BlockNumberMax)
+	require.Equal(t, hexutil.Uint64(0x20), *conditions.TimestampMax)
+	require.Equal(t, 2, conditions.Cost())
+
+	bz, err := json.Marshal(conditions)
+	require.NoError(t, err)
+	var decoded mempool.TxConditions
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, conditions, decoded)
+}
+
+func TestTxConditionsValidate(t *testing.T) {
+	u64 := func(v uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&v) }
+
+	tooManySlots := make(map[common.Hash]common.Hash)
+	for i := 0; i <= mempool.MaxTxConditionsCost; i++ {
+		tooManySlots[common.BigToHash(big.NewInt(int64(i)))] = common.Hash{}
+	}
+
+	testCases := map[string]struct {
+		conditions mempool.TxConditions
+		expErr     string
+	}{
+		"empty":                   {},
+		"valid ranges":            {conditions: mempool.TxConditions{BlockNumberMin: u64(1), BlockNumberMax: u64(1), TimestampMin: u64(1), TimestampMax: u64(2)}},
+		"invalid block range":     {conditions: mempool.TxConditions{BlockNumberMin: u64(2), BlockNumberMax: u64(1)}, expErr: "blockNumberMin"},
+		"invalid timestamp range": {conditions: mempool.TxConditions{TimestampMin: u64(2), TimestampMax: u64(1)}, expErr: "timestampMin"},
+		"too costly": {
+			conditions: mempool.TxConditions{KnownAccounts: map[common.Address]mempool.KnownAccount{{}: {StorageSlots: tooManySlots}}},
+			expErr:     "exceeds the max",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.conditions.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTxConditionsCheck(t *testing.T) {
+	var (
+		u64      = func(v uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&v) }
+		contract = common.BytesToAddress([]byte{0x1})
+		empty    = common.BytesToAddress([]byte{0x2})
+		slot     = common.BytesToHash([]byte{0x1})
+		value    = common.BytesToHash([]byte{0x2a})
+		now      = time.Unix(1000, 0)
+	)
+
+	vmKeeper := mocks.NewVMKeeperI(t)
+	vmKeeper.On("GetState", mock.Anything, contract, slot).Return(value).Maybe()
+	vmKeeper.On("ForEachStorage", mock.Anything, contract, mock.Anything).Run(func(args mock.Arguments) {
+		cb := args.Get(2).(func(common.Hash, common.Hash) bool)
+		cb(slot, value)
+	}).Maybe()
+	vmKeeper.On("ForEachStorage", mock.Anything, empty, mock.Anything).Maybe()
+
+	// compute the expected storage root of the contract with a regular trie
+	tr := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	enc, err := rlp.EncodeToBytes(common.TrimLeftZeroes(value[:]))
+	require.NoError(t, err)
+	require.NoError(t, tr.Update(crypto.Keccak256(slot[:]), enc))
+	root := tr.Hash()
+	otherRoot := common.BytesToHash([]byte{0xff})
+
+	ctx := createMockContext().WithBlockHeight(10).WithBlockTime(now)
+
+	testCases := map[string]struct {
+		conditions mempool.TxConditions
+		expErr     string
+	}{
+		"no conditions":        {},
+		"within block range":   {conditions: mempool.TxConditions{BlockNumberMin: u64(10), BlockNumberMax: u64(10)}},
+		"before min block":     {conditions: mempool.TxConditions{BlockNumberMin: u64(11)}, expErr: "block number 10 is lower than 11"},
+		"after max block":      {conditions: mempool.TxConditions{BlockNumberMax: u64(9)}, expErr: "block number 10 is greater than 9"},
+		"within time range":    {conditions: mempool.TxConditions{TimestampMin: u64(1000), TimestampMax: u64(1000)}},
+		"before min timestamp": {conditions: mempool.TxConditions{TimestampMin: u64(1001)}, expErr: "timestamp 1000 is lower than 1001"},
+		"after max timestamp":  {conditions: mempool.TxConditions{TimestampMax: u64(999)}, expErr: "timestamp 1000 is greater than 999"},
+		"matching slot": {
+			conditions: mempool.TxConditions{KnownAccounts: map[common.Address]mempool.KnownAccount{
+				contract: {StorageSlots: map[common.Hash]common.Hash{slot: value}},
+			}},
+		},
+		"changed slot": {
+			conditions: mempool.TxConditions{KnownAccounts: map[common.Address]mempool.KnownAccount{
+				contract: {StorageSlots: map[common.Hash]common.Hash{slot: {}}},
+			}},
+			expErr: "storage slot",
+		},
+		"matching storage root": {
+			conditions: mempool.TxConditions{KnownAccounts: map[common.Address]mempool.KnownAccount{
+				contract: {StorageRoot: &root},
+				empty:    {StorageRoot: &types.EmptyRootHash},
+			}},
+		},
+		"changed storage root": {
+			conditions: mempool.TxConditions{KnownAccounts: map[common.Address]mempool.KnownAccount{
+				contract: {StorageRoot: &otherRoot},
+			}},
+			expErr: "storage root",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.conditions.Check(ctx, vmKeeper)
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, mempool.ErrTxConditionsNotMet)
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
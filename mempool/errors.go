@@ -14,6 +14,7 @@ var (
 	ErrMultiMsgEthereumTransaction = errors.New("transaction contains multiple messages with an EVM msg")
 	ErrNonceGap                    = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow                    = errors.New("tx nonce is lower than account nonce")
+	ErrTxConditionsNotMet          = errors.New("tx conditions not met")
 	// ErrQueueFull is aliased from the internal queue package so that external
 	// packages (e.g. evmd) can check for this error without importing internal/.
 	ErrQueueFull = queue.ErrQueueFull
@@ -0,0 +1,371 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// journalEntryEVM is the kind of the journal entries of EVM txs, encoded
+	// in their binary representation.
+	journalEntryEVM uint8 = iota
+	// journalEntryCosmos is the kind of the journal entries of Cosmos txs,
+	// encoded with the tx encoder of the mempool.
+	journalEntryCosmos
+)
+
+// errNoActiveJournal is returned if a tx is attempted to be inserted into the
+// journal, but no such file is currently open.
+var errNoActiveJournal = errors.New("no active journal")
+
+// journalEntry is a tx of the mempool as stored in the journal.
+type journalEntry struct {
+	Kind uint8
+	Tx   []byte
+}
+
+// txJournal is a rotating log of the txs of the mempool, so that they survive
+// node restarts.
+type txJournal struct {
+	mu     sync.Mutex
+	path   string         // Filesystem path to store the transactions at
+	writer io.WriteCloser // Output stream to write new transactions into
+}
+
+// newTxJournal creates a new transaction journal at path.
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load parses the journal from disk and returns its entries in the order
+// they were written. A missing journal is empty, and an entry truncated by a
+// crash ends it.
+func (j *txJournal) load() ([]journalEntry, error) {
+	input, err := os.Open(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	var entries []journalEntry
+	stream := rlp.NewStream(input, 0)
+	for {
+		var entry journalEntry
+		err := stream.Decode(&entry)
+		switch {
+		case err == nil:
+			entries = append(entries, entry)
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return entries, nil
+		default:
+			return entries, err
+		}
+	}
+}
+
+// insert adds the specified entry to the end of the journal.
+func (j *txJournal) insert(entry journalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	return rlp.Encode(j.writer, &entry)
+}
+
+// rotate regenerates the journal from the given entries, then keeps it open
+// to append the entries inserted afterwards.
+func (j *txJournal) rotate(entries []journalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// Close the current journal (if any is open)
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		if err = rlp.Encode(replacement, &entries[i]); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	return nil
+}
+
+// close flushes the journal contents to disk and closes the file.
+func (j *txJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
+
+// StartJournal reinserts the txs of the journal that are still valid at the
+// latest block into the mempool, then journals the txs inserted into the
+// mempool and compacts the journal at every rejournal interval, until the
+// mempool is closed. It is a no-op if the journal is disabled, and must be
+// called once, when the chain state is loaded.
+func (m *KrakatoaMempool) StartJournal() error {
+	if m.journal == nil {
+		return nil
+	}
+
+	entries, err := m.journal.load()
+	if err != nil {
+		m.logger.Error("failed to load mempool journal, some txs may be lost", "path", m.journal.path, "err", err)
+	}
+	m.reinsertJournaled(entries)
+
+	if err := m.rejournal(); err != nil {
+		return fmt.Errorf("rotating mempool journal: %w", err)
+	}
+
+	m.journalWg.Add(1)
+	go m.journalLoop()
+	return nil
+}
+
+// reinsertJournaled revalidates the journaled txs in order with a
+// TxRechecker against the latest block, and reinserts the valid ones into the
+// mempool.
+func (m *KrakatoaMempool) reinsertJournaled(entries []journalEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	var (
+		rechecker *TxRechecker
+		checkCtx  sdk.Context
+	)
+	if m.anteHandler != nil {
+		latestCtx, err := m.blockchain.GetLatestContext()
+		if err != nil {
+			m.logger.Error("failed to get latest context, dropping mempool journal", "err", err)
+			return
+		}
+		rechecker = NewTxRechecker(m.anteHandler, NewTxEncoder(m.txConfig))
+		rechecker.Update(latestCtx, m.blockchain.CurrentBlock())
+		checkCtx, _ = rechecker.GetContext()
+	}
+
+	var (
+		evmTxs    []*ethtypes.Transaction
+		cosmosTxs []sdk.Tx
+		dropped   int
+	)
+	for _, entry := range entries {
+		switch entry.Kind {
+		case journalEntryEVM:
+			tx := new(ethtypes.Transaction)
+			if err := tx.UnmarshalBinary(entry.Tx); err != nil {
+				dropped++
+				continue
+			}
+			if rechecker != nil {
+				newCtx, err := rechecker.RecheckEVM(checkCtx, tx)
+				// the txs queued behind a nonce gap are kept like on recheck
+				if err != nil && !errors.Is(err, ErrNonceGap) {
+					dropped++
+					continue
+				}
+				if !newCtx.IsZero() {
+					checkCtx = newCtx
+				}
+			}
+			evmTxs = append(evmTxs, tx)
+		case journalEntryCosmos:
+			tx, err := m.txConfig.TxDecoder()(entry.Tx)
+			if err != nil {
+				dropped++
+				continue
+			}
+			if rechecker != nil {
+				newCtx, err := rechecker.RecheckCosmos(checkCtx, tx)
+				if err != nil {
+					dropped++
+					continue
+				}
+				if !newCtx.IsZero() {
+					checkCtx = newCtx
+				}
+			}
+			cosmosTxs = append(cosmosTxs, tx)
+		default:
+			dropped++
+		}
+	}
+
+	for i, err := range m.txPool.Add(evmTxs, true) {
+		if err != nil {
+			dropped++
+			continue
+		}
+		if m.journalLocals {
+			m.markLocal(evmTxs[i].Hash())
+		}
+	}
+	for _, tx := range cosmosTxs {
+		if err := m.insertAndReapCosmosTx(tx); err != nil {
+			dropped++
+		}
+	}
+	m.logger.Info("loaded mempool journal", "path", m.journal.path, "txs", len(entries), "dropped", dropped)
+}
+
+// journalLoop compacts the journal at every rejournal interval until the
+// mempool is closed.
+func (m *KrakatoaMempool) journalLoop() {
+	defer m.journalWg.Done()
+
+	ticker := time.NewTicker(m.rejournalInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.rejournal(); err != nil {
+				m.logger.Error("failed to rotate mempool journal", "err", err)
+			}
+		case <-m.journalShutdown:
+			return
+		}
+	}
+}
+
+// rejournal regenerates the journal from the txs currently in the mempool,
+// the EVM txs of each account in nonce order.
+func (m *KrakatoaMempool) rejournal() error {
+	var entries []journalEntry
+	pending, queued := m.legacyTxPool.Content()
+	for _, txs := range []map[common.Address][]*ethtypes.Transaction{pending, queued} {
+		for _, list := range txs {
+			for _, tx := range list {
+				if m.journalLocals && !m.isLocal(tx.Hash()) {
+					continue
+				}
+				bz, err := tx.MarshalBinary()
+				if err != nil {
+					return fmt.Errorf("encoding evm tx %s: %w", tx.Hash(), err)
+				}
+				entries = append(entries, journalEntry{Kind: journalEntryEVM, Tx: bz})
+			}
+		}
+	}
+
+	if !m.journalLocals {
+		for it := m.recheckCosmosPool.Select(context.Background(), nil); it != nil; it = it.Next() {
+			bz, err := m.txConfig.TxEncoder()(it.Tx())
+			if err != nil {
+				return fmt.Errorf("encoding cosmos tx: %w", err)
+			}
+			entries = append(entries, journalEntry{Kind: journalEntryCosmos, Tx: bz})
+		}
+	}
+
+	if err := m.journal.rotate(entries); err != nil {
+		return err
+	}
+	m.logger.Debug("regenerated mempool journal", "txs", len(entries))
+	return nil
+}
+
+// journalEVMTx appends an EVM tx inserted into the mempool to the journal.
+func (m *KrakatoaMempool) journalEVMTx(tx *ethtypes.Transaction) {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		m.logger.Error("failed to encode evm tx for the mempool journal", "hash", tx.Hash(), "err", err)
+		return
+	}
+	m.journalEntry(journalEntry{Kind: journalEntryEVM, Tx: bz})
+}
+
+// journalCosmosTx appends a Cosmos tx inserted into the mempool to the
+// journal.
+func (m *KrakatoaMempool) journalCosmosTx(tx sdk.Tx) {
+	bz, err := m.txConfig.TxEncoder()(tx)
+	if err != nil {
+		m.logger.Error("failed to encode cosmos tx for the mempool journal", "err", err)
+		return
+	}
+	m.journalEntry(journalEntry{Kind: journalEntryCosmos, Tx: bz})
+}
+
+func (m *KrakatoaMempool) journalEntry(entry journalEntry) {
+	// the txs inserted before the journal is started are journaled by its
+	// first rotation
+	if err := m.journal.insert(entry); err != nil && !errors.Is(err, errNoActiveJournal) {
+		m.logger.Error("failed to journal tx", "err", err)
+	}
+}
+
+// markLocal marks an EVM tx as submitted over RPC, so that it's journaled
+// when only the local txs are.
+func (m *KrakatoaMempool) markLocal(hash common.Hash) {
+	m.localsMu.Lock()
+	defer m.localsMu.Unlock()
+	m.locals[hash] = struct{}{}
+}
+
+func (m *KrakatoaMempool) isLocal(hash common.Hash) bool {
+	m.localsMu.Lock()
+	defer m.localsMu.Unlock()
+	_, ok := m.locals[hash]
+	return ok
+}
+
+func (m *KrakatoaMempool) unmarkLocal(hash common.Hash) {
+	m.localsMu.Lock()
+	defer m.localsMu.Unlock()
+	delete(m.locals, hash)
+}
+
+// closeJournal stops the rotation of the journal and closes it.
+func (m *KrakatoaMempool) closeJournal() error {
+	if m.journal == nil {
+		return nil
+	}
+	m.journalShutdownOnce.Do(func() {
+		close(m.journalShutdown)
+	})
+	m.journalWg.Wait()
+	return m.journal.close()
+}
@@ -0,0 +1,48 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mempool.rlp")
+	journal := newTxJournal(path)
+
+	// a missing journal is empty
+	entries, err := journal.load()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// entries can only be inserted once the journal is rotated
+	require.ErrorIs(t, journal.insert(journalEntry{Kind: journalEntryEVM, Tx: []byte{1}}), errNoActiveJournal)
+
+	first := journalEntry{Kind: journalEntryEVM, Tx: []byte{1, 2}}
+	second := journalEntry{Kind: journalEntryCosmos, Tx: []byte{3}}
+	require.NoError(t, journal.rotate([]journalEntry{first}))
+	require.NoError(t, journal.insert(second))
+	require.NoError(t, journal.close())
+
+	entries, err = newTxJournal(path).load()
+	require.NoError(t, err)
+	require.Equal(t, []journalEntry{first, second}, entries)
+
+	// rotating drops the previous entries
+	journal = newTxJournal(path)
+	require.NoError(t, journal.rotate([]journalEntry{second}))
+	require.NoError(t, journal.close())
+	entries, err = journal.load()
+	require.NoError(t, err)
+	require.Equal(t, []journalEntry{second}, entries)
+
+	// an entry truncated by a crash ends the journal
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, append(bz, 0xc5, 0x01), 0o600))
+	entries, err = journal.load()
+	require.NoError(t, err)
+	require.Equal(t, []journalEntry{second}, entries)
+}
@@ -45,6 +45,22 @@ type KrakatoaMempoolConfig struct {
 	// pending insertion into the mempool. Note the insert queue is only used
 	// for EVM txs.
 	InsertQueueSize int
+	// Journal is the path of the file the txs of the mempool are journaled
+	// to, so that they survive node restarts. Journaling is disabled if it's
+	// empty.
+	Journal string
+	// Rejournal is the interval at which the journal is regenerated from the
+	// txs in the mempool.
+	Rejournal time.Duration
+	// JournalLocals restricts the journal to the EVM txs submitted over RPC.
+	JournalLocals bool
+	// TxStatusRetention is how long the reason an EVM tx exited the mempool
+	// is retained for, to report its status. Disabled if it's not positive.
+	TxStatusRetention time.Duration
+	// MinBundleTip is the min aggregate effective tip of the bundles, the
+	// gas weighted average of the effective tips of their txs. It defaults
+	// to DefaultMinBundleTip if it's nil.
+	MinBundleTip *uint256.Int
 }
 
 // KrakatoaMempool is an application side mempool implementation that operates
@@ -77,9 +93,28 @@ type KrakatoaMempool struct {
 	/** Transaction Tracking **/
 	txTracker *txTracker
 
+	/** Transaction Conditions **/
+	txConditions *txConditionsStore
+
 	/** Transaction Inserting **/
 	cosmosInsertQueue *queue.Queue[sdk.Tx]
 	evmInsertQueue    *queue.Queue[ethtypes.Transaction]
+
+	/** Transaction Journaling **/
+	journal             *txJournal // nil if journaling is disabled
+	journalLocals       bool
+	rejournalInterval   time.Duration
+	anteHandler         sdk.AnteHandler
+	locals              map[common.Hash]struct{} // EVM txs submitted over RPC
+	localsMu            sync.Mutex
+	journalShutdown     chan struct{}
+	journalShutdownOnce sync.Once
+	journalWg           sync.WaitGroup
+
+	/** Transaction Bundles **/
+	bundles      *bundleStore
+	minBundleTip *uint256.Int
+	txApplier    EVMTxApplier // nil if the vm keeper can't execute txs
 }
 
 func NewKrakatoaMempool(
@@ -109,7 +144,8 @@ func NewKrakatoaMempool(
 	if config.LegacyPoolConfig != nil {
 		legacyConfig = *config.LegacyPoolConfig
 	}
-	legacyPool := legacypool.New(legacyConfig, logger, blockchain, legacypool.WithRecheck(evmRechecker))
+	txConditions := newTxConditionsStore()
+	legacyPool := legacypool.New(legacyConfig, logger, blockchain, legacypool.WithRecheck(newConditionalRechecker(evmRechecker, txConditions, vmKeeper)))
 
 	tracker := reserver.NewReservationTracker()
 	txPool, err := txpool.New(uint64(0), blockchain, tracker, []txpool.SubPool{legacyPool})
@@ -173,13 +209,42 @@ func NewKrakatoaMempool(
 		minTip:                   config.MinTip,
 		pendingTxProposalTimeout: config.PendingTxProposalTimeout,
 		reapList:                 NewReapList(NewTxEncoder(txConfig)),
-		txTracker:                newTxTracker(),
+		txTracker:                newTxTracker(config.TxStatusRetention),
+		txConditions:             txConditions,
+		journalLocals:            config.JournalLocals,
+		rejournalInterval:        config.Rejournal,
+		anteHandler:              config.AnteHandler,
+		locals:                   make(map[common.Hash]struct{}),
+		journalShutdown:          make(chan struct{}),
+		bundles:                  newBundleStore(),
+		minBundleTip:             config.MinBundleTip,
+	}
+	if krakatoaMempool.minBundleTip == nil {
+		krakatoaMempool.minBundleTip = uint256.NewInt(DefaultMinBundleTip)
+	}
+	if txApplier, ok := vmKeeper.(EVMTxApplier); ok {
+		krakatoaMempool.txApplier = txApplier
+	}
+	if config.Journal != "" {
+		krakatoaMempool.journal = newTxJournal(config.Journal)
+		if krakatoaMempool.rejournalInterval <= 0 {
+			logger.Warn("rejournal interval is not positive, setting to fallback", "fallback_interval", legacypool.DefaultConfig.Rejournal)
+			krakatoaMempool.rejournalInterval = legacypool.DefaultConfig.Rejournal
+		}
 	}
 
 	// Setup queues
 	krakatoaMempool.evmInsertQueue = queue.New(
 		func(txs []*ethtypes.Transaction) []error {
-			return txPool.Add(txs, AllowUnsafeSyncInsert)
+			errs := txPool.Add(txs, AllowUnsafeSyncInsert)
+			if krakatoaMempool.journal != nil {
+				for i, err := range errs {
+					if err == nil && (!krakatoaMempool.journalLocals || krakatoaMempool.isLocal(txs[i].Hash())) {
+						krakatoaMempool.journalEVMTx(txs[i])
+					}
+				}
+			}
+			return errs
 		},
 		config.InsertQueueSize,
 	)
@@ -194,6 +259,9 @@ func NewKrakatoaMempool(
 				// should be added to the reap list, we do not need to wait
 				// until the next blocks recheck.
 				errs[i] = krakatoaMempool.insertAndReapCosmosTx(*tx)
+				if errs[i] == nil && krakatoaMempool.journal != nil && !krakatoaMempool.journalLocals {
+					krakatoaMempool.journalCosmosTx(*tx)
+				}
 			}
 			return errs
 		},
@@ -203,6 +271,7 @@ func NewKrakatoaMempool(
 	legacyPool.OnTxEnqueued = krakatoaMempool.onEVMTxEnqueued()
 	legacyPool.OnTxPromoted = krakatoaMempool.onEVMTxPromoted()
 	legacyPool.OnTxRemoved = krakatoaMempool.onEVMTxRemoved()
+	legacyPool.OnTxReplaced = krakatoaMempool.onEVMTxReplaced()
 
 	vmKeeper.SetEvmMempool(krakatoaMempool)
 
@@ -237,8 +306,8 @@ func (m *KrakatoaMempool) onEVMTxPromoted() func(tx *ethtypes.Transaction) {
 
 // onEVMTxRemoved defines a hook to run whenever an evm tx is removed from a
 // pool (queued or pending).
-func (m *KrakatoaMempool) onEVMTxRemoved() func(tx *ethtypes.Transaction, pool legacypool.PoolType) {
-	return func(tx *ethtypes.Transaction, pool legacypool.PoolType) {
+func (m *KrakatoaMempool) onEVMTxRemoved() func(tx *ethtypes.Transaction, pool legacypool.PoolType, reason txpool.RemovalReason) {
+	return func(tx *ethtypes.Transaction, pool legacypool.PoolType, reason txpool.RemovalReason) {
 		// tx was invalidated for some reason or was included in a block
 		// (either way it is no longer in the mempool), if this tx is in the
 		// reap list we need remove it from there (no longer need to gossip to
@@ -246,8 +315,22 @@ func (m *KrakatoaMempool) onEVMTxRemoved() func(tx *ethtypes.Transaction, pool l
 		// later time, in which case we should gossip it again) by readding to
 		// the reap guard.
 		m.reapList.DropEVMTx(tx)
+		m.txConditions.Remove(tx.Hash())
+		if m.journalLocals {
+			m.unmarkLocal(tx.Hash())
+		}
 
 		_ = m.txTracker.RemoveTxFromPool(tx.Hash(), pool)
+		from, _ := ethtypes.Sender(ethtypes.LatestSigner(m.blockchain.Config()), tx)
+		m.txTracker.Removed(tx.Hash(), from, tx.Nonce(), reason)
+	}
+}
+
+// onEVMTxReplaced defines a hook to run whenever an evm tx is replaced by a
+// tx with the same nonce and a higher price.
+func (m *KrakatoaMempool) onEVMTxReplaced() func(old, tx *ethtypes.Transaction) {
+	return func(old, tx *ethtypes.Transaction) {
+		m.txTracker.Replaced(old.Hash(), tx.Hash())
 	}
 }
 
@@ -295,6 +378,41 @@ func (m *KrakatoaMempool) Insert(ctx context.Context, tx sdk.Tx) error {
 	return nil
 }
 
+// InsertConditional adds an EVM transaction to the EVM transaction pool that
+// is only kept there while its conditions hold. The conditions are checked
+// against the latest block on insertion, then on each recheck of the
+// transaction, which evicts it as soon as they no longer hold.
+//
+// NOTE: the conditions are local to this node, peers the transaction is
+// gossiped to include it regardless of them.
+func (m *KrakatoaMempool) InsertConditional(ctx context.Context, tx sdk.Tx, conditions *TxConditions) error {
+	ethMsg, err := evmTxFromCosmosTx(tx)
+	if err != nil {
+		return fmt.Errorf("inserting conditional tx: %w", err)
+	}
+	if err := conditions.Validate(); err != nil {
+		return fmt.Errorf("invalid tx conditions: %w", err)
+	}
+
+	chainCtx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return fmt.Errorf("getting latest context: %w", err)
+	}
+	if err := conditions.Check(chainCtx, m.vmKeeper); err != nil {
+		return err
+	}
+
+	hash := ethMsg.AsTransaction().Hash()
+	if err := m.txConditions.Set(hash, conditions); err != nil {
+		return fmt.Errorf("inserting conditional tx %s: %w", hash, err)
+	}
+	if err := m.Insert(ctx, tx); err != nil {
+		m.txConditions.Remove(hash)
+		return err
+	}
+	return nil
+}
+
 // InsertAsync adds a transaction to the appropriate mempool (EVM or Cosmos). EVM
 // transactions are routed to the EVM transaction pool, while all other
 // transactions are inserted into the Cosmos sdkmempool. EVM transactions are
@@ -393,6 +511,19 @@ func (m *KrakatoaMempool) SelectBy(goCtx context.Context, txs [][]byte, filter f
 	for iter != nil && filter(iter.Tx()) {
 		iter = iter.Next()
 	}
+	if done, ok := goCtx.Value(selectDoneKey{}).(func()); ok {
+		done()
+	}
+}
+
+// selectDoneKey is the context key of the func called when the iteration of
+// SelectBy ends.
+type selectDoneKey struct{}
+
+// ContextWithSelectDone returns a copy of ctx with which SelectBy calls done
+// when its iteration ends.
+func ContextWithSelectDone(ctx sdk.Context, done func()) sdk.Context {
+	return ctx.WithValue(selectDoneKey{}, done)
 }
 
 // buildIterator ensures that EVM mempool has checked txs for reorgs up to COMMITTED
@@ -455,6 +586,12 @@ func (m *KrakatoaMempool) RemoveWithReason(ctx context.Context, tx sdk.Tx, reaso
 
 	if reason.Caller == sdkmempool.CallerRunTxFinalize {
 		_ = m.txTracker.IncludedInBlock(hash)
+		if sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); ok {
+			m.txTracker.Included(hash, sdkCtx.BlockHeight())
+		}
+		if bundle, _ := m.bundles.get(hash); bundle != nil {
+			m.bundles.remove(bundle)
+		}
 	}
 
 	return nil
@@ -533,6 +670,10 @@ func (m *KrakatoaMempool) Close() error {
 		}
 	}
 
+	if err := m.closeJournal(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close journal: %w", err))
+	}
+
 	if err := m.recheckCosmosPool.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close cosmos pool: %w", err))
 	}
@@ -601,7 +742,11 @@ func (m *KrakatoaMempool) evmIterator(ctx context.Context, height *big.Int, base
 		defer cancel()
 	}
 	evmPendingTxs := m.txPool.Rechecked(ctx, height, filter)
-	return miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxs, baseFee)
+	evmIterator := miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxs, baseFee)
+	// the txs are selected for the block after the one at height
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	m.addBundles(ctx, evmIterator, height.Uint64()+1, uint64(blockTime), baseFee) //nolint:gosec // G115 // block time is never negative
+	return evmIterator
 }
 
 // cosmosIterator returns an iterator over the current valid txs in the cosmos
@@ -625,6 +770,22 @@ func (m *KrakatoaMempool) TrackTx(hash common.Hash) error {
 	return m.txTracker.Track(hash)
 }
 
+// MarkLocal marks an EVM tx as submitted over RPC before it's inserted or
+// broadcast, so that it's journaled when only the local txs are.
+func (m *KrakatoaMempool) MarkLocal(hash common.Hash) {
+	if m.journal != nil && m.journalLocals {
+		m.markLocal(hash)
+	}
+}
+
+// UnmarkLocal drops the mark of an EVM tx that failed to be submitted, unless
+// the tx is in the mempool already.
+func (m *KrakatoaMempool) UnmarkLocal(hash common.Hash) {
+	if m.journal != nil && m.journalLocals && m.txPool.Get(hash) == nil {
+		m.unmarkLocal(hash)
+	}
+}
+
 // RecheckEVMTxs triggers a synchronous recheck of evm transactions.
 // This should only be used for testing.
 func (m *KrakatoaMempool) RecheckEVMTxs(newHead *ethtypes.Header) {
@@ -5,12 +5,14 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"math/big"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
@@ -499,6 +501,229 @@ func TestKrakatoaMempool_InsertSynchronous(t *testing.T) {
 	require.Contains(t, err.Error(), "insufficient funds", "error should indicate insufficient funds")
 }
 
+func TestKrakatoaMempool_InsertConditional(t *testing.T) {
+	mp, s := setupKrakatoaMempoolWithAccounts(t, 3)
+	vmKeeper, txConfig, bus, accounts := s.vmKeeper, s.txConfig, s.eventBus, s.accounts
+	err := bus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+		Header: cmttypes.Header{
+			Height:  1,
+			Time:    time.Now(),
+			ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mp.GetTxPool().Sync())
+
+	var (
+		legacyPool = mp.GetTxPool().Subpools[0].(*legacypool.LegacyPool)
+		contract   = common.BytesToAddress([]byte{0xc})
+		slot       = common.BytesToHash([]byte{0x1})
+		value      = common.BytesToHash([]byte{0x2a})
+		maxBlock   = hexutil.Uint64(0)
+	)
+	vmKeeper.On("GetState", mock.Anything, contract, slot).Return(value)
+	conditions := &mempool.TxConditions{
+		KnownAccounts: map[common.Address]mempool.KnownAccount{
+			contract: {StorageSlots: map[common.Hash]common.Hash{slot: value}},
+		},
+	}
+
+	// conditions that don't hold are rejected on insertion
+	tx := createMsgEthereumTx(t, txConfig, accounts[0].key, 0, big.NewInt(1e8))
+	err = mp.InsertConditional(context.Background(), tx, &mempool.TxConditions{BlockNumberMax: &maxBlock})
+	require.ErrorIs(t, err, mempool.ErrTxConditionsNotMet)
+	require.Equal(t, 0, mp.CountTx())
+
+	require.NoError(t, mp.InsertConditional(context.Background(), tx, conditions))
+	require.NoError(t, mp.GetTxPool().Sync())
+	pending, _ := legacyPool.ContentFrom(accounts[0].address)
+	require.Len(t, pending, 1)
+
+	// the tx stays in the pool while the slot is unchanged
+	err = bus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+		Header: cmttypes.Header{
+			Height:  2,
+			Time:    time.Now(),
+			ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mp.GetTxPool().Sync())
+	pending, _ = legacyPool.ContentFrom(accounts[0].address)
+	require.Len(t, pending, 1)
+
+	// and is evicted on the recheck after it changed
+	vmKeeper.On("GetState", mock.Anything, contract, slot).Unset()
+	vmKeeper.On("GetState", mock.Anything, contract, slot).Return(common.Hash{})
+	err = bus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+		Header: cmttypes.Header{
+			Height:  3,
+			Time:    time.Now(),
+			ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mp.GetTxPool().Sync())
+	require.Eventually(t, func() bool {
+		p, q := legacyPool.ContentFrom(accounts[0].address)
+		return len(p) == 0 && len(q) == 0
+	}, 10*time.Second, 25*time.Millisecond)
+}
+
+func TestKrakatoaMempool_TxStatus(t *testing.T) {
+	mp, s := setupKrakatoaMempoolWithAccounts(t, 3)
+	txConfig, bus, accounts := s.txConfig, s.eventBus, s.accounts
+	err := bus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+		Header: cmttypes.Header{
+			Height:  1,
+			Time:    time.Now(),
+			ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mp.GetTxPool().Sync())
+
+	insert := func(key *ecdsa.PrivateKey, nonce uint64, gasPrice *big.Int) common.Hash {
+		tx := createMsgEthereumTx(t, txConfig, key, nonce, gasPrice)
+		require.NoError(t, mp.Insert(context.Background(), tx))
+		require.NoError(t, mp.GetTxPool().Sync())
+		return tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).Hash()
+	}
+
+	// the base fee is 1 gwei
+	pending := insert(accounts[0].key, 0, big.NewInt(11e8))
+	require.Equal(t, mempool.TxStatus{State: mempool.TxStatePending}, mp.TxStatus(pending))
+
+	queued := insert(accounts[1].key, 1, big.NewInt(11e8))
+	expectedNonce := uint64(0)
+	require.Equal(t, mempool.TxStatus{State: mempool.TxStateQueued, Nonce: 1, ExpectedNonce: &expectedNonce}, mp.TxStatus(queued))
+
+	underpriced := insert(accounts[2].key, 0, big.NewInt(1e8))
+	require.Equal(t, mempool.TxStatus{State: mempool.TxStateUnderpriced, BaseFee: big.NewInt(1e9)}, mp.TxStatus(underpriced))
+
+	// replaced by a tx with the same nonce and a higher price
+	replacement := insert(accounts[0].key, 0, big.NewInt(13e8))
+	status := mp.TxStatus(pending)
+	require.Equal(t, mempool.TxStateReplaced, status.State)
+	require.Equal(t, replacement, status.ReplacedBy)
+	require.Equal(t, legacypool.RemovalReasonReplaced, status.Reason)
+
+	// evicted after failing the recheck
+	tx := createMsgEthereumTx(t, txConfig, accounts[2].key, 0, big.NewInt(1e8))
+	err = mp.RemoveWithReason(context.Background(), tx, mempooltypes.RemoveReason{
+		Caller: mempooltypes.CallerRunTxRecheck,
+		Error:  errors.New("recheck failed"),
+	})
+	require.NoError(t, err)
+	status = mp.TxStatus(underpriced)
+	require.Equal(t, mempool.TxStateEvicted, status.State)
+	require.Equal(t, legacypool.RemovalReasonRunTxRecheck, status.Reason)
+	require.False(t, status.RemovedAt.IsZero())
+
+	// included in a block, even once it's removed from the pool
+	storeKey := storetypes.NewKVStoreKey("test")
+	transientKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, transientKey).WithBlockHeight(5)
+	tx = createMsgEthereumTx(t, txConfig, accounts[0].key, 0, big.NewInt(13e8))
+	err = mp.RemoveWithReason(ctx, tx, mempooltypes.RemoveReason{Caller: mempooltypes.CallerRunTxFinalize})
+	require.NoError(t, err)
+	mp.GetTxPool().Subpools[0].(*legacypool.LegacyPool).RemoveTx(replacement, false, true, legacypool.RemovalReasonOld)
+	status = mp.TxStatus(replacement)
+	require.Equal(t, mempool.TxStateIncluded, status.State)
+	require.Equal(t, uint64(5), status.BlockNumber)
+
+	require.Equal(t, mempool.TxStatus{State: mempool.TxStateUnknown}, mp.TxStatus(common.Hash{0x1}))
+
+	// the replaced and evicted txs are reported with their sender, but not
+	// the included ones
+	evicted := mp.EvictedTxs()
+	require.Len(t, evicted, 2)
+	require.Equal(t, pending, evicted[0].Hash)
+	require.Equal(t, accounts[0].address, evicted[0].From)
+	require.Equal(t, legacypool.RemovalReasonReplaced, evicted[0].Reason)
+	require.Equal(t, underpriced, evicted[1].Hash)
+	require.Equal(t, accounts[2].address, evicted[1].From)
+	require.Equal(t, uint64(0), evicted[1].Nonce)
+	require.Equal(t, legacypool.RemovalReasonRunTxRecheck, evicted[1].Reason)
+}
+
+func TestKrakatoaMempool_Journal(t *testing.T) {
+	testCases := []struct {
+		name   string
+		locals bool
+	}{
+		{"all txs", false},
+		{"local txs", true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "mempool.rlp")
+			accounts := newTestAccounts(t, 3)
+
+			// start starts a mempool journaled at path, which rechecks the
+			// journaled txs with the ante handler if it's set
+			start := func(anteHandler sdk.AnteHandler) (*mempool.KrakatoaMempool, testMempoolDependencies) {
+				mp, s := setupKrakatoaMempool(t, accounts, func(config *mempool.KrakatoaMempoolConfig) {
+					config.Journal = path
+					config.Rejournal = time.Hour
+					config.JournalLocals = tc.locals
+					config.AnteHandler = anteHandler
+				})
+				err := s.eventBus.PublishEventNewBlockHeader(cmttypes.EventDataNewBlockHeader{
+					Header: cmttypes.Header{
+						Height:  1,
+						Time:    time.Now(),
+						ChainID: strconv.Itoa(constants.EighteenDecimalsChainID),
+					},
+				})
+				require.NoError(t, err)
+				require.NoError(t, mp.GetTxPool().Sync())
+				require.NoError(t, mp.StartJournal())
+				return mp, s
+			}
+
+			mp, s := start(nil)
+			txs := make([]common.Hash, len(accounts))
+			for i, acc := range accounts {
+				// the tx of the last account is queued behind a nonce gap
+				nonce := uint64(0)
+				if i == len(accounts)-1 {
+					nonce = 1
+				}
+				tx := createMsgEthereumTx(t, s.txConfig, acc.key, nonce, big.NewInt(2e9))
+				txs[i] = tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx).Hash()
+				// all the txs are submitted over RPC, but the submission of the
+				// tx of the first account fails before its insertion
+				mp.MarkLocal(txs[i])
+				if i == 0 {
+					mp.UnmarkLocal(txs[i])
+				}
+				require.NoError(t, mp.Insert(context.Background(), tx))
+			}
+			require.NoError(t, mp.GetTxPool().Sync())
+			require.NoError(t, mp.Close())
+
+			// the tx of the second account no longer passes the ante handler,
+			// and the nonce gap of the last one is kept
+			mp, _ = start(func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+				msg := tx.GetMsgs()[0].(*vmtypes.MsgEthereumTx)
+				switch {
+				case msg.Hash() == txs[1]:
+					return ctx, errors.New("insufficient funds")
+				case msg.AsTransaction().Nonce() > 0:
+					return ctx, mempool.ErrNonceGap
+				}
+				return ctx, nil
+			})
+			defer mp.Close()
+
+			require.Equal(t, !tc.locals, mp.GetTxPool().Get(txs[0]) != nil)
+			require.Nil(t, mp.GetTxPool().Get(txs[1]))
+			require.NotNil(t, mp.GetTxPool().Get(txs[2]))
+		})
+	}
+}
+
 func TestKrakatoaMempool_InsertMultiMsgEthereumTx(t *testing.T) {
 	mp, s := setupKrakatoaMempoolWithAccounts(t, 3)
 	txConfig, bus := s.txConfig, s.eventBus
@@ -584,14 +809,12 @@ func setTestBech32Prefixes(t *testing.T) {
 
 func setupKrakatoaMempoolWithAccounts(t *testing.T, numAccounts int) (*mempool.KrakatoaMempool, testMempoolDependencies) {
 	t.Helper()
+	return setupKrakatoaMempool(t, newTestAccounts(t, numAccounts), nil)
+}
 
-	// EVM txs use Add(sync=false) by default; without waiting on promotion, CountTx/Sync can race.
-	// This matches TxPool.Add / LegacyPool.Add docs: use sync inserts only in tests.
-	prevAllowUnsafeSyncInsert := mempool.AllowUnsafeSyncInsert
-	mempool.AllowUnsafeSyncInsert = true
-	t.Cleanup(func() { mempool.AllowUnsafeSyncInsert = prevAllowUnsafeSyncInsert })
+func newTestAccounts(t *testing.T, numAccounts int) []testAccount {
+	t.Helper()
 
-	// Create accounts
 	accounts := make([]testAccount, numAccounts)
 	for i := range numAccounts {
 		key, err := crypto.GenerateKey()
@@ -603,6 +826,23 @@ func setupKrakatoaMempoolWithAccounts(t *testing.T, numAccounts int) (*mempool.K
 			initialBalance: 100000000000100,
 		}
 	}
+	return accounts
+}
+
+// setupKrakatoaMempool creates a mempool for the accounts, with its config
+// updated by configure if it's set.
+func setupKrakatoaMempool(
+	t *testing.T,
+	accounts []testAccount,
+	configure func(config *mempool.KrakatoaMempoolConfig),
+) (*mempool.KrakatoaMempool, testMempoolDependencies) {
+	t.Helper()
+
+	// EVM txs use Add(sync=false) by default; without waiting on promotion, CountTx/Sync can race.
+	// This matches TxPool.Add / LegacyPool.Add docs: use sync inserts only in tests.
+	prevAllowUnsafeSyncInsert := mempool.AllowUnsafeSyncInsert
+	mempool.AllowUnsafeSyncInsert = true
+	t.Cleanup(func() { mempool.AllowUnsafeSyncInsert = prevAllowUnsafeSyncInsert })
 
 	// Setup EVM chain config
 	vmtypes.NewEVMConfigurator().ResetTestConfig()
@@ -688,7 +928,11 @@ func setupKrakatoaMempoolWithAccounts(t *testing.T, numAccounts int) (*mempool.K
 			BlockGasLimit:    30000000,
 			MinTip:           uint256.NewInt(0),
 		},
-		InsertQueueSize: 1000,
+		InsertQueueSize:   1000,
+		TxStatusRetention: time.Minute,
+	}
+	if configure != nil {
+		configure(krakatoaConfig)
 	}
 
 	// Create mempool
@@ -0,0 +1,121 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultLaneName is the name of the lane of the txs matched by no lane, if
+// no default lane is configured.
+const defaultLaneName = "default"
+
+// Lane reserves a share of the block space of the proposals to the txs whose
+// messages all have one of its type URLs, e.g. the IBC relayer messages. The
+// lane with no type URLs is the default lane, matching the txs no other lane
+// matches. The space reserved to a lane that isn't used by its txs is used by
+// the txs of the other lanes once the mempool iteration ends.
+type Lane struct {
+	// Name identifies the lane in the metrics.
+	Name string
+	// Share is the percentage of the max block gas and bytes reserved to the
+	// lane.
+	Share uint64
+	// MsgTypeURLs are the type URLs of the messages of the txs of the lane,
+	// e.g. "/cosmos.evm.vm.v1.MsgEthereumTx" for the EVM txs.
+	MsgTypeURLs []string
+}
+
+// ValidateLanes returns an error if the lanes are invalid: their shares must
+// not exceed 100%, and their names and message type URLs must be unique.
+func ValidateLanes(lanes []Lane) error {
+	var (
+		share       uint64
+		names       = make(map[string]struct{}, len(lanes))
+		typeURLs    = make(map[string]string)
+		defaultLane string
+	)
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return errors.New("lane name is empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return fmt.Errorf("lane %s is defined twice", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Share > 100 {
+			return fmt.Errorf("lane %s share must be at most 100%%, got %d%%", lane.Name, lane.Share)
+		}
+		share += lane.Share
+
+		if len(lane.MsgTypeURLs) == 0 {
+			if defaultLane != "" {
+				return fmt.Errorf("lanes %s and %s both have no message type URLs", defaultLane, lane.Name)
+			}
+			defaultLane = lane.Name
+		}
+		for _, typeURL := range lane.MsgTypeURLs {
+			if other, ok := typeURLs[typeURL]; ok {
+				return fmt.Errorf("message type URL %s is in lanes %s and %s", typeURL, other, lane.Name)
+			}
+			typeURLs[typeURL] = lane.Name
+		}
+	}
+	if share > 100 {
+		return fmt.Errorf("lane shares must add up to at most 100%%, got %d%%", share)
+	}
+	return nil
+}
+
+// laneMatcher matches the txs to the index of their lane.
+type laneMatcher struct {
+	lanes       []Lane
+	byTypeURL   map[string]int
+	defaultLane int
+}
+
+// newLaneMatcher creates a matcher of the given lanes, adding a default lane
+// without reserved space if none is configured.
+func newLaneMatcher(lanes []Lane) (*laneMatcher, error) {
+	if err := ValidateLanes(lanes); err != nil {
+		return nil, err
+	}
+
+	m := &laneMatcher{
+		lanes:       lanes,
+		byTypeURL:   make(map[string]int),
+		defaultLane: -1,
+	}
+	for i, lane := range lanes {
+		if len(lane.MsgTypeURLs) == 0 {
+			m.defaultLane = i
+		}
+		for _, typeURL := range lane.MsgTypeURLs {
+			m.byTypeURL[typeURL] = i
+		}
+	}
+	if m.defaultLane == -1 {
+		m.lanes = append(append([]Lane(nil), lanes...), Lane{Name: defaultLaneName})
+		m.defaultLane = len(m.lanes) - 1
+	}
+	return m, nil
+}
+
+// match returns the index of the lane of the tx, the default lane if its
+// messages don't all match the same lane.
+func (m *laneMatcher) match(tx sdk.Tx) int {
+	lane := -1
+	for _, msg := range tx.GetMsgs() {
+		i, ok := m.byTypeURL[sdk.MsgTypeURL(msg)]
+		if !ok || (lane != -1 && i != lane) {
+			return m.defaultLane
+		}
+		lane = i
+	}
+	if lane == -1 {
+		return m.defaultLane
+	}
+	return lane
+}
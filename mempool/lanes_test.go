@@ -0,0 +1,177 @@
+package mempool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var (
+	evmTypeURL  = sdk.MsgTypeURL(&vmtypes.MsgEthereumTx{})
+	sendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteTypeURL = sdk.MsgTypeURL(&govtypes.MsgVote{})
+)
+
+// selectorTx is a tx of the selector tests, identified by its id.
+type selectorTx struct {
+	sdk.Tx
+	id     int
+	gas    uint64
+	msgs   []sdk.Msg
+	signer common.Address
+}
+
+func (tx selectorTx) GetGas() uint64 { return tx.gas }
+
+func (tx selectorTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+// newTestTxSelector creates a ProposalTxSelector of the lanes, with the given
+// bundles of the txs.
+func newTestTxSelector(t *testing.T, lanes []Lane, bundleOf func(tx sdk.Tx) (*Bundle, int)) *ProposalTxSelector {
+	t.Helper()
+	matcher, err := newLaneMatcher(lanes)
+	require.NoError(t, err)
+	if bundleOf == nil {
+		bundleOf = func(sdk.Tx) (*Bundle, int) { return nil, 0 }
+	}
+	signersOf := func(tx sdk.Tx) []common.Address {
+		if signer := tx.(selectorTx).signer; signer != (common.Address{}) {
+			return []common.Address{signer}
+		}
+		return nil
+	}
+	ts := &ProposalTxSelector{bundleOf: bundleOf, signersOf: signersOf, lanes: matcher}
+	ts.Clear()
+	return ts
+}
+
+func TestValidateLanes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		lanes  []Lane
+		errMsg string
+	}{
+		{"no lanes", nil, ""},
+		{
+			"valid",
+			[]Lane{
+				{Name: "evm", Share: 50, MsgTypeURLs: []string{evmTypeURL}},
+				{Name: "gov", Share: 10, MsgTypeURLs: []string{voteTypeURL}},
+				{Name: "cosmos", Share: 40},
+			},
+			"",
+		},
+		{"no name", []Lane{{Share: 10}}, "name is empty"},
+		{"duplicate name", []Lane{{Name: "a", MsgTypeURLs: []string{evmTypeURL}}, {Name: "a"}}, "defined twice"},
+		{"shares above 100%", []Lane{{Name: "a", Share: 60, MsgTypeURLs: []string{evmTypeURL}}, {Name: "b", Share: 50}}, "at most 100%"},
+		{"two default lanes", []Lane{{Name: "a"}, {Name: "b"}}, "no message type URLs"},
+		{"duplicate type URL", []Lane{{Name: "a", MsgTypeURLs: []string{evmTypeURL}}, {Name: "b", MsgTypeURLs: []string{evmTypeURL}}}, "in lanes a and b"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateLanes(tc.lanes)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestLaneMatcher(t *testing.T) {
+	matcher, err := newLaneMatcher([]Lane{
+		{Name: "evm", Share: 50, MsgTypeURLs: []string{evmTypeURL}},
+		{Name: "gov", Share: 10, MsgTypeURLs: []string{voteTypeURL}},
+	})
+	require.NoError(t, err)
+	require.Len(t, matcher.lanes, 3)
+	require.Equal(t, defaultLaneName, matcher.lanes[2].Name)
+
+	require.Equal(t, 0, matcher.match(selectorTx{msgs: []sdk.Msg{&vmtypes.MsgEthereumTx{}}}))
+	require.Equal(t, 1, matcher.match(selectorTx{msgs: []sdk.Msg{&govtypes.MsgVote{}, &govtypes.MsgVote{}}}))
+	// the txs whose messages don't all match the same lane are in the default lane
+	require.Equal(t, 2, matcher.match(selectorTx{msgs: []sdk.Msg{&govtypes.MsgVote{}, &banktypes.MsgSend{}}}))
+	require.Equal(t, 2, matcher.match(selectorTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}}))
+	require.Equal(t, 2, matcher.match(selectorTx{}))
+}
+
+func TestProposalTxSelectorLanes(t *testing.T) {
+	ctx := context.Background()
+	lanes := []Lane{
+		{Name: "evm", Share: 50, MsgTypeURLs: []string{evmTypeURL}},
+		{Name: "gov", Share: 20, MsgTypeURLs: []string{voteTypeURL}},
+		{Name: "cosmos", Share: 0, MsgTypeURLs: []string{sendTypeURL}},
+	}
+	evmTx := func(id int) selectorTx {
+		return selectorTx{id: id, gas: 100, msgs: []sdk.Msg{&vmtypes.MsgEthereumTx{}}}
+	}
+	voteTx := func(id int) selectorTx {
+		return selectorTx{id: id, gas: 100, msgs: []sdk.Msg{&govtypes.MsgVote{}}}
+	}
+	sendTx := func(id int) selectorTx {
+		return selectorTx{id: id, gas: 100, msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+	}
+	signedBy := func(tx selectorTx, signer common.Address) selectorTx {
+		tx.signer = signer
+		return tx
+	}
+	alice, bob := common.Address{0x1}, common.Address{0x2}
+
+	testCases := []struct {
+		name     string
+		txs      []selectorTx
+		selected [][]byte
+	}{
+		{
+			// the 200 gas reserved to the gov lane can't be used by the evm
+			// txs returned before the gov txs
+			name:     "reserved space",
+			txs:      []selectorTx{evmTx(0), evmTx(1), evmTx(2), evmTx(3), evmTx(4), evmTx(5), evmTx(6), evmTx(7), evmTx(8), voteTx(9), voteTx(10)},
+			selected: [][]byte{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}, {9}, {10}},
+		},
+		{
+			// the space reserved to the gov lane it doesn't use spills over
+			// to the txs that were deferred
+			name:     "spillover",
+			txs:      []selectorTx{evmTx(0), evmTx(1), evmTx(2), evmTx(3), evmTx(4), evmTx(5), evmTx(6), evmTx(7), evmTx(8), voteTx(9)},
+			selected: [][]byte{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}, {9}, {8}},
+		},
+		{
+			// the lanes only use the unreserved space beyond their share
+			name:     "unreserved space",
+			txs:      []selectorTx{sendTx(0), sendTx(1), sendTx(2), sendTx(3), voteTx(4), voteTx(5), voteTx(6)},
+			selected: [][]byte{{0}, {1}, {2}, {4}, {5}, {3}, {6}},
+		},
+		{
+			// the next nonce of the signer of a deferred tx is not selected
+			// before it
+			name: "deferred signer",
+			txs: []selectorTx{
+				evmTx(0), evmTx(1), evmTx(2), evmTx(3), evmTx(4), evmTx(5), evmTx(6), evmTx(7),
+				signedBy(evmTx(8), alice), signedBy(voteTx(9), alice), signedBy(voteTx(10), bob),
+			},
+			selected: [][]byte{{0}, {1}, {2}, {3}, {4}, {5}, {6}, {7}, {10}, {8}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector := newTestTxSelector(t, lanes, nil)
+			for _, tx := range tc.txs {
+				if selector.SelectTxForProposal(ctx, 1_000_000, 1000, tx, []byte{byte(tx.id)}) {
+					break
+				}
+			}
+			selector.endSelection()
+			require.Equal(t, tc.selected, selector.SelectedTxs(ctx))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package miner
 
 import (
 	"container/heap"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -32,6 +33,11 @@ type txWithMinerFee struct {
 	tx   *txpool.LazyTransaction
 	from common.Address
 	fees *uint256.Int
+
+	// bundled is set if the transaction is the first one of a bundle, whose
+	// aggregate gasTipCap are the fees, and the rest of which is bundle
+	bundled bool
+	bundle  []*txpool.LazyTransaction
 }
 
 // newTxWithMinerFee creates a wrapped transaction, calculating the effective
@@ -87,11 +93,18 @@ func (s *txByPriceAndTime) Pop() interface{} {
 // TransactionsByPriceAndNonce represents a set of transactions that can return
 // transactions in a profit-maximizing sorted order, while supporting removing
 // entire batches of transactions for non-executable accounts.
+//
+// Bundles are returned as a unit: once the first transaction of a bundle is
+// shifted, the rest of the bundle is returned before any other transaction.
 type TransactionsByPriceAndNonce struct {
 	txs     map[common.Address][]*txpool.LazyTransaction // Per account nonce-sorted list of transactions
 	heads   txByPriceAndTime                             // Next transaction for each unique account (price heap)
 	signer  types.Signer                                 // Signer for the set of transactions
 	baseFee *uint256.Int                                 // Current base fee
+
+	bundle        []*txpool.LazyTransaction   // Remaining transactions of the bundle being returned
+	bundleFees    *uint256.Int                // Aggregate miner gasTipCap of the bundle being returned
+	bundleSenders map[common.Address]struct{} // Senders of the transactions of the bundles
 }
 
 // NewTransactionsByPriceAndNonce creates a transaction set that can retrieve
@@ -127,8 +140,71 @@ func NewTransactionsByPriceAndNonce(signer types.Signer, txs map[common.Address]
 	}
 }
 
+// AddBundle adds a bundle of transactions that are returned contiguously, in
+// order, and sorted among the other transactions by their aggregate effective
+// miner gasTipCap, i.e. the gas weighted average of their gasTipCaps.
+//
+// The other transactions of the senders of the bundle are discarded, as their
+// nonces would conflict with the ones of the bundle. Returns error if any of
+// them can't pay the base fee, or if one of the senders is the one of another
+// bundle.
+func (t *TransactionsByPriceAndNonce) AddBundle(txs []*txpool.LazyTransaction, senders []common.Address) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	for _, from := range senders {
+		if _, ok := t.bundleSenders[from]; ok {
+			return fmt.Errorf("sender %s already is in another bundle", from)
+		}
+	}
+	var (
+		fees = new(uint256.Int)
+		gas  = new(uint256.Int)
+	)
+	for _, tx := range txs {
+		wrapped, err := newTxWithMinerFee(tx, common.Address{}, t.baseFee)
+		if err != nil {
+			return err
+		}
+		txGas := uint256.NewInt(tx.Gas)
+		fees.Add(fees, new(uint256.Int).Mul(wrapped.fees, txGas))
+		gas.Add(gas, txGas)
+	}
+	if !gas.IsZero() {
+		fees.Div(fees, gas)
+	}
+	if t.bundleSenders == nil {
+		t.bundleSenders = make(map[common.Address]struct{})
+	}
+	for _, from := range senders {
+		t.bundleSenders[from] = struct{}{}
+		t.removeAccount(from)
+	}
+	heap.Push(&t.heads, &txWithMinerFee{
+		tx:      txs[0],
+		fees:    fees,
+		bundled: true,
+		bundle:  txs[1:],
+	})
+	return nil
+}
+
+// removeAccount discards the transactions of the account that aren't bundled.
+func (t *TransactionsByPriceAndNonce) removeAccount(from common.Address) {
+	delete(t.txs, from)
+	for i, head := range t.heads {
+		if !head.bundled && head.from == from {
+			heap.Remove(&t.heads, i)
+			return
+		}
+	}
+}
+
 // Peek returns the next transaction by price.
 func (t *TransactionsByPriceAndNonce) Peek() (*txpool.LazyTransaction, *uint256.Int) {
+	if len(t.bundle) > 0 {
+		return t.bundle[0], t.bundleFees
+	}
 	if len(t.heads) == 0 {
 		return nil, nil
 	}
@@ -136,7 +212,19 @@ func (t *TransactionsByPriceAndNonce) Peek() (*txpool.LazyTransaction, *uint256.
 }
 
 // Shift replaces the current best head with the next one from the same account.
+//
+// Shifting the first transaction of a bundle, or one of the rest, moves to
+// the next transaction of the bundle instead.
 func (t *TransactionsByPriceAndNonce) Shift() {
+	if len(t.bundle) > 0 {
+		t.bundle = t.bundle[1:]
+		return
+	}
+	if head := t.heads[0]; head.bundled {
+		t.bundle, t.bundleFees = head.bundle, head.fees
+		heap.Pop(&t.heads)
+		return
+	}
 	acc := t.heads[0].from
 	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
 		if wrapped, err := newTxWithMinerFee(txs[0], acc, t.baseFee); err == nil {
@@ -151,17 +239,23 @@ func (t *TransactionsByPriceAndNonce) Shift() {
 // Pop removes the best transaction, *not* replacing it with the next one from
 // the same account. This should be used when a transaction cannot be executed
 // and hence all subsequent ones should be discarded from the same account.
+//
+// Popping a transaction of a bundle discards the rest of the bundle.
 func (t *TransactionsByPriceAndNonce) Pop() {
+	if len(t.bundle) > 0 {
+		t.bundle = nil
+		return
+	}
 	heap.Pop(&t.heads)
 }
 
 // Empty returns if the price heap is empty. It can be used to check it simpler
 // than calling peek and checking for nil return.
 func (t *TransactionsByPriceAndNonce) Empty() bool {
-	return len(t.heads) == 0
+	return len(t.heads) == 0 && len(t.bundle) == 0
 }
 
 // Clear removes the entire content of the heap.
 func (t *TransactionsByPriceAndNonce) Clear() {
-	t.heads, t.txs = nil, nil
+	t.heads, t.txs, t.bundle, t.bundleSenders = nil, nil, nil, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	"github.com/cosmos/evm/utils"
 
 	storetypes "cosmossdk.io/store/types"
@@ -100,3 +101,34 @@ func (r *TxRechecker) Update(ctx sdk.Context, header *ethtypes.Header) {
 	}
 	r.ctx = cached
 }
+
+// conditionalRechecker wraps an evm rechecker to also check the conditions of
+// the txs inserted with InsertConditional, so that they are evicted from the
+// pool once their conditions no longer hold.
+type conditionalRechecker struct {
+	legacypool.Rechecker
+
+	conditions *txConditionsStore
+	vmKeeper   VMKeeperI
+}
+
+func newConditionalRechecker(rechecker legacypool.Rechecker, conditions *txConditionsStore, vmKeeper VMKeeperI) *conditionalRechecker {
+	return &conditionalRechecker{
+		Rechecker:  rechecker,
+		conditions: conditions,
+		vmKeeper:   vmKeeper,
+	}
+}
+
+// RecheckEVM checks the conditions of the tx, if any, against the context
+// before revalidating it with the wrapped rechecker.
+//
+// NOTE: This function is not thread safe with itself or any other Rechecker functions.
+func (r *conditionalRechecker) RecheckEVM(ctx sdk.Context, tx *ethtypes.Transaction) (sdk.Context, error) {
+	if conditions := r.conditions.Get(tx.Hash()); conditions != nil {
+		if err := conditions.Check(ctx, r.vmKeeper); err != nil {
+			return sdk.Context{}, err
+		}
+	}
+	return r.Rechecker.RecheckEVM(ctx, tx)
+}
@@ -0,0 +1,324 @@
+package mempool
+
+import (
+	"context"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	laneTxs          metric.Int64Histogram
+	laneGasUsed      metric.Int64Histogram
+	laneBytesUsed    metric.Int64Histogram
+	laneSpilloverTxs metric.Int64Histogram
+)
+
+func init() {
+	var err error
+	laneTxs, err = meter.Int64Histogram(
+		"mempool.lane.txs",
+		metric.WithDescription("Number of transactions of a lane selected per proposal"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	laneGasUsed, err = meter.Int64Histogram(
+		"mempool.lane.gas_used",
+		metric.WithDescription("Gas limit of the transactions of a lane selected per proposal"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	laneBytesUsed, err = meter.Int64Histogram(
+		"mempool.lane.bytes_used",
+		metric.WithDescription("Size of the transactions of a lane selected per proposal"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	laneSpilloverTxs, err = meter.Int64Histogram(
+		"mempool.lane.spillover_txs",
+		metric.WithDescription("Number of transactions of a lane selected per proposal in the space reserved to other lanes"),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ baseapp.TxSelector = (*ProposalTxSelector)(nil)
+
+// laneUsage is the block space used by the selected txs of a lane.
+type laneUsage struct {
+	txs       int
+	txBytes   uint64
+	txGas     uint64
+	spillover int
+}
+
+// deferredTx is a tx that only fits in the block space reserved to other
+// lanes, selected once the mempool iteration ends if that space is unused.
+type deferredTx struct {
+	txBz    []byte
+	lane    int
+	txBytes uint64
+	txGas   uint64
+}
+
+// ProposalTxSelector is a baseapp.TxSelector for the proposals built from the
+// KrakatoaMempool. It reserves a share of the block space to each lane, and
+// selects the txs of the bundles of the mempool only if all of them fit. The
+// other txs are selected like the default tx selector does.
+type ProposalTxSelector struct {
+	bundleOf    func(tx sdk.Tx) (*Bundle, int)
+	bundleUsage func(ctx context.Context, bundle *Bundle) (txBytes, txGas uint64, signers []common.Address, err error)
+	signersOf   func(tx sdk.Tx) []common.Address
+	lanes       *laneMatcher
+
+	maxTxBytes    uint64
+	maxBlockGas   uint64
+	totalTxBytes  uint64
+	totalTxGas    uint64
+	selectedTxs   [][]byte
+	usage         []laneUsage
+	deferred      []deferredTx
+	deferredBytes uint64
+	// deferredSigners are the signers of the deferred txs, whose later txs
+	// are skipped to keep the selected txs of a signer in nonce order
+	deferredSigners map[common.Address]struct{}
+
+	// open is the bundle whose txs are being selected, all of them were
+	// checked to fit when its first tx was selected
+	open     *Bundle
+	openNext int
+}
+
+// NewProposalTxSelector creates a tx selector for the proposals built from
+// the mempool, reserving block space to the lanes and keeping the bundles of
+// the mempool atomic. The txs of a bundle are only selected if the whole
+// bundle fits when its first tx is returned to the selector, and the block
+// space left unused by the lanes spills over to the txs of the other lanes
+// once the mempool iteration ends.
+//
+// NOTE: a tx that only fits in the space reserved to other lanes is selected
+// after the mempool iteration, so the later txs of its signers are skipped.
+// The mempool iteration must be ended by the handler returned by
+// PrepareProposalHandler.
+func NewProposalTxSelector(mempool *KrakatoaMempool, lanes []Lane) (*ProposalTxSelector, error) {
+	matcher, err := newLaneMatcher(lanes)
+	if err != nil {
+		return nil, err
+	}
+	ts := &ProposalTxSelector{
+		bundleOf:    mempool.bundleOf,
+		bundleUsage: mempool.bundleUsage,
+		signersOf:   txSigners,
+		lanes:       matcher,
+	}
+	ts.Clear()
+	return ts, nil
+}
+
+// PrepareProposalHandler wraps the PrepareProposal handler selecting the txs
+// of the proposals with the selector, so that the deferred txs are selected
+// once its mempool iteration ends.
+func (ts *ProposalTxSelector) PrepareProposalHandler(handler sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		return handler(ContextWithSelectDone(ctx, ts.endSelection), req)
+	}
+}
+
+// SelectedTxs returns a copy of the selected txs.
+func (ts *ProposalTxSelector) SelectedTxs(_ context.Context) [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+// Clear clears the selected txs.
+func (ts *ProposalTxSelector) Clear() {
+	*ts = ProposalTxSelector{
+		bundleOf:        ts.bundleOf,
+		bundleUsage:     ts.bundleUsage,
+		signersOf:       ts.signersOf,
+		lanes:           ts.lanes,
+		usage:           make([]laneUsage, len(ts.lanes.lanes)),
+		deferredSigners: make(map[common.Address]struct{}),
+	}
+}
+
+// txSigners returns the signers of the tx, none if they can't be retrieved.
+func txSigners(tx sdk.Tx) []common.Address {
+	signers, err := signerAddressesFromTx(tx)
+	if err != nil {
+		return nil
+	}
+	return signers
+}
+
+// SelectTxForProposal selects the tx if it fits in the proposal, without
+// using the space reserved to the other lanes. The txs of a bundle are only
+// selected if they're returned in order, starting with its first tx, and the
+// whole bundle fits when it's returned.
+func (ts *ProposalTxSelector) SelectTxForProposal(ctx context.Context, maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	ts.maxTxBytes, ts.maxBlockGas = maxTxBytes, maxBlockGas
+	bundle, index := ts.bundleOf(memTx)
+	lane := ts.lanes.match(memTx)
+
+	// the bundle being selected is interrupted, the txs already selected
+	// are kept as their signers' sequences were already recorded
+	if ts.open != nil && (bundle != ts.open || index != ts.openNext) {
+		ts.open = nil
+	}
+	if bundle != nil && bundle != ts.open {
+		// the rest of a bundle whose first tx was not selected
+		if index != 0 || !ts.bundleFits(ctx, bundle, lane) {
+			return ts.full()
+		}
+		ts.open, ts.openNext = bundle, 0
+	}
+
+	txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})) //nolint:gosec // G115 // tx sizes are never negative
+	var txGasLimit uint64
+	if gasTx, ok := memTx.(baseapp.GasTx); ok {
+		txGasLimit = gasTx.GetGas()
+	}
+	signers := ts.signersOf(memTx)
+
+	switch {
+	case ts.open != nil:
+		ts.selectTx(txBz, lane, txSize, txGasLimit)
+	case ts.hasDeferredSigner(signers):
+		// the tx would be selected before the deferred tx of its signer
+		return ts.full()
+	case ts.fits(lane, txSize, txGasLimit, true):
+		ts.selectTx(txBz, lane, txSize, txGasLimit)
+	case ts.fits(lane, txSize, txGasLimit, false) && ts.deferredBytes+txSize <= maxTxBytes:
+		ts.deferred = append(ts.deferred, deferredTx{txBz: txBz, lane: lane, txBytes: txSize, txGas: txGasLimit})
+		ts.deferredBytes += txSize
+		for _, signer := range signers {
+			ts.deferredSigners[signer] = struct{}{}
+		}
+	}
+
+	if ts.open != nil {
+		ts.openNext++
+		if ts.openNext < len(ts.open.Txs) {
+			// the selection is never halted in the middle of a bundle
+			return false
+		}
+		ts.open = nil
+	}
+	return ts.full()
+}
+
+// bundleFits returns true if all the txs of the bundle fit in the block,
+// without using the space reserved to the other lanes, and none of their
+// signers has a deferred tx.
+func (ts *ProposalTxSelector) bundleFits(ctx context.Context, bundle *Bundle, lane int) bool {
+	txBytes, txGas, signers, err := ts.bundleUsage(ctx, bundle)
+	if err != nil {
+		return false
+	}
+	return !ts.hasDeferredSigner(signers) && ts.fits(lane, txBytes, txGas, true)
+}
+
+// hasDeferredSigner returns true if one of the signers has a deferred tx.
+func (ts *ProposalTxSelector) hasDeferredSigner(signers []common.Address) bool {
+	for _, signer := range signers {
+		if _, ok := ts.deferredSigners[signer]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fits returns true if the tx of the lane fits in the block, without using the
+// space reserved to the other lanes if reserve is set.
+func (ts *ProposalTxSelector) fits(lane int, txBytes, txGas uint64, reserve bool) bool {
+	var reservedBytes, reservedGas uint64
+	if reserve {
+		reservedBytes, reservedGas = ts.reserved(lane)
+	}
+	return ts.totalTxBytes+txBytes+reservedBytes <= ts.maxTxBytes &&
+		(ts.maxBlockGas == 0 || ts.totalTxGas+txGas+reservedGas <= ts.maxBlockGas)
+}
+
+// reserved returns the block space reserved to the lanes other than lane that
+// their selected txs don't use.
+func (ts *ProposalTxSelector) reserved(lane int) (txBytes, txGas uint64) {
+	for i, l := range ts.lanes.lanes {
+		if i == lane || l.Share == 0 {
+			continue
+		}
+		if reserved := share(ts.maxTxBytes, l.Share); reserved > ts.usage[i].txBytes {
+			txBytes += reserved - ts.usage[i].txBytes
+		}
+		if reserved := share(ts.maxBlockGas, l.Share); reserved > ts.usage[i].txGas {
+			txGas += reserved - ts.usage[i].txGas
+		}
+	}
+	return txBytes, txGas
+}
+
+// share returns the percentage of limit, without overflowing.
+func share(limit, percentage uint64) uint64 {
+	hi, lo := bits.Mul64(limit, percentage)
+	q, _ := bits.Div64(hi, lo, 100)
+	return q
+}
+
+func (ts *ProposalTxSelector) selectTx(txBz []byte, lane int, txBytes, txGas uint64) {
+	ts.selectedTxs = append(ts.selectedTxs, txBz)
+	ts.totalTxBytes += txBytes
+	ts.totalTxGas += txGas
+	ts.usage[lane].txs++
+	ts.usage[lane].txBytes += txBytes
+	ts.usage[lane].txGas += txGas
+}
+
+// full returns true if no more txs can be selected.
+func (ts *ProposalTxSelector) full() bool {
+	return ts.totalTxBytes >= ts.maxTxBytes || (ts.maxBlockGas > 0 && ts.totalTxGas >= ts.maxBlockGas)
+}
+
+// endSelection selects the deferred txs that fit in the space left unused by
+// the other lanes, once no more txs are returned to the selector.
+func (ts *ProposalTxSelector) endSelection() {
+	ts.open = nil
+
+	for _, tx := range ts.deferred {
+		if ts.fits(tx.lane, tx.txBytes, tx.txGas, false) {
+			ts.selectTx(tx.txBz, tx.lane, tx.txBytes, tx.txGas)
+			ts.usage[tx.lane].spillover++
+		}
+	}
+	ts.deferred, ts.deferredBytes = nil, 0
+	clear(ts.deferredSigners)
+
+	// no tx was returned to the selector
+	if ts.maxTxBytes == 0 {
+		return
+	}
+	ctx := context.Background()
+	for i, lane := range ts.lanes.lanes {
+		usage := ts.usage[i]
+		attrs := metric.WithAttributes(attribute.String("lane", lane.Name))
+		laneTxs.Record(ctx, int64(usage.txs), attrs)
+		laneGasUsed.Record(ctx, int64(usage.txGas), attrs)     //nolint:gosec // G115 // gas is bounded by the block gas limit
+		laneBytesUsed.Record(ctx, int64(usage.txBytes), attrs) //nolint:gosec // G115 // bytes are bounded by the block size
+		laneSpilloverTxs.Record(ctx, int64(usage.spillover), attrs)
+	}
+}
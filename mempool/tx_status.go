@@ -0,0 +1,134 @@
+package mempool
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/mempool/txpool"
+)
+
+// TxState is the stage of its lifecycle an EVM tx is in.
+type TxState string
+
+const (
+	// TxStateUnknown is the state of the txs the mempool has no record of.
+	TxStateUnknown TxState = "unknown"
+	// TxStatePending is the state of the txs that are executable.
+	TxStatePending TxState = "pending"
+	// TxStateQueued is the state of the txs that are not executable yet,
+	// usually because of a nonce gap.
+	TxStateQueued TxState = "queued"
+	// TxStateUnderpriced is the state of the txs of the mempool whose fee cap
+	// is below the current base fee.
+	TxStateUnderpriced TxState = "underpriced"
+	// TxStateReplaced is the state of the txs replaced by a tx with the same
+	// nonce and a higher price.
+	TxStateReplaced TxState = "replaced"
+	// TxStateEvicted is the state of the txs removed from the mempool without
+	// being included in a block.
+	TxStateEvicted TxState = "evicted"
+	// TxStateIncluded is the state of the txs included in a block.
+	TxStateIncluded TxState = "included"
+)
+
+// TxStatus is the status of an EVM tx in its lifecycle.
+type TxStatus struct {
+	State TxState
+	// Nonce is the nonce of the tx, if it's in the mempool.
+	Nonce uint64
+	// ExpectedNonce is the nonce of the next tx of the sender the mempool
+	// expects, if the tx is queued. The tx is queued because of a nonce gap if
+	// its nonce is greater.
+	ExpectedNonce *uint64
+	// BaseFee is the current base fee, if the tx is underpriced.
+	BaseFee *big.Int
+	// ReplacedBy is the hash of the tx that replaced the tx, if it's replaced.
+	ReplacedBy common.Hash
+	// Reason is why the tx was evicted, if it was.
+	Reason txpool.RemovalReason
+	// BlockNumber is the number of the block the tx was included in, if it
+	// was.
+	BlockNumber uint64
+	// RemovedAt is when the tx exited the mempool, if it did.
+	RemovedAt time.Time
+}
+
+// TxStatus returns the status of the EVM tx in its lifecycle. The txs that
+// exited the mempool are only reported for the configured retention window.
+func (m *KrakatoaMempool) TxStatus(hash common.Hash) TxStatus {
+	if tx := m.txPool.Get(hash); tx != nil {
+		if status, ok := m.poolTxStatus(tx); ok {
+			return status
+		}
+	}
+
+	removal, ok := m.txTracker.Removal(hash)
+	switch {
+	case !ok:
+		return TxStatus{State: TxStateUnknown}
+	case removal.Height > 0:
+		return TxStatus{State: TxStateIncluded, BlockNumber: uint64(removal.Height), RemovedAt: removal.RemovedAt} //nolint:gosec // G115 // block heights are never negative
+	case removal.ReplacedBy != (common.Hash{}):
+		return TxStatus{State: TxStateReplaced, ReplacedBy: removal.ReplacedBy, Reason: removal.Reason, RemovedAt: removal.RemovedAt}
+	default:
+		return TxStatus{State: TxStateEvicted, Reason: removal.Reason, RemovedAt: removal.RemovedAt}
+	}
+}
+
+// poolTxStatus returns the status of a tx of the mempool, false if it exited
+// the mempool in the meantime.
+func (m *KrakatoaMempool) poolTxStatus(tx *ethtypes.Transaction) (TxStatus, bool) {
+	status := TxStatus{Nonce: tx.Nonce()}
+	switch m.legacyTxPool.Status(tx.Hash()) {
+	case txpool.TxStatusPending:
+		status.State = TxStatePending
+	case txpool.TxStatusQueued:
+		status.State = TxStateQueued
+		from, err := ethtypes.Sender(ethtypes.LatestSigner(m.blockchain.Config()), tx)
+		if err != nil {
+			return TxStatus{}, false
+		}
+		expectedNonce := m.legacyTxPool.Nonce(from)
+		status.ExpectedNonce = &expectedNonce
+	default:
+		return TxStatus{}, false
+	}
+
+	if header := m.blockchain.CurrentBlock(); header != nil && header.BaseFee != nil && tx.GasFeeCap().Cmp(header.BaseFee) < 0 {
+		status.State = TxStateUnderpriced
+		status.BaseFee = new(big.Int).Set(header.BaseFee)
+	}
+	return status, true
+}
+
+// EvictedTx is an EVM tx that exited the mempool without being included in a
+// block.
+type EvictedTx struct {
+	Hash      common.Hash
+	From      common.Address
+	Nonce     uint64
+	Reason    txpool.RemovalReason
+	RemovedAt time.Time
+}
+
+// EvictedTxs returns the EVM txs that exited the mempool without being
+// included in a block within the configured retention window, oldest first.
+func (m *KrakatoaMempool) EvictedTxs() []EvictedTx {
+	evicted := m.txTracker.Evicted()
+	txs := make([]EvictedTx, 0, len(evicted))
+	for hash, removal := range evicted {
+		txs = append(txs, EvictedTx{
+			Hash:      hash,
+			From:      removal.From,
+			Nonce:     removal.Nonce,
+			Reason:    removal.Reason,
+			RemovedAt: removal.RemovedAt,
+		})
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].RemovedAt.Before(txs[j].RemovedAt) })
+	return txs
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -37,16 +38,25 @@ var (
 )
 
 // txTracker tracks timestamps about important events in a transactions
-// lifecycle and exposes metrics about these via prometheus.
+// lifecycle and exposes metrics about these via prometheus. It also retains
+// why the evm txs exited the mempool for the retention window.
 type txTracker struct {
 	txCheckpoints map[common.Hash]*checkpoints
 	lock          sync.RWMutex
+
+	retention    time.Duration
+	removals     map[common.Hash]*txRemoval
+	removalOrder []removalEntry
+	removalsLock sync.Mutex
 }
 
-// newTxTracker creates a new txTracker instance
-func newTxTracker() *txTracker {
+// newTxTracker creates a new txTracker instance, retaining the removals of
+// the txs for the retention window (disabled if it's not positive).
+func newTxTracker(retention time.Duration) *txTracker {
 	return &txTracker{
 		txCheckpoints: make(map[common.Hash]*checkpoints),
+		retention:     retention,
+		removals:      make(map[common.Hash]*txRemoval),
 	}
 }
 
@@ -164,3 +174,122 @@ type checkpoints struct {
 
 	LastEnteredPendingPoolAt time.Time
 }
+
+// txRemoval is why a tx exited the mempool.
+type txRemoval struct {
+	From       common.Address
+	Nonce      uint64
+	Reason     txpool.RemovalReason
+	ReplacedBy common.Hash // set if the tx was replaced
+	Height     int64       // set if the tx was included in a block
+	RemovedAt  time.Time
+}
+
+// removalEntry is the time a removal was recorded at, to prune it once it's
+// out of the retention window.
+type removalEntry struct {
+	hash common.Hash
+	at   time.Time
+}
+
+// Removed records that a tx of from exited the mempool for the reason,
+// unless it was included in a block.
+func (txt *txTracker) Removed(hash common.Hash, from common.Address, nonce uint64, reason txpool.RemovalReason) {
+	txt.recordRemoval(hash, func(removal *txRemoval) bool {
+		if removal.Height != 0 {
+			return false
+		}
+		removal.From, removal.Nonce = from, nonce
+		removal.Reason, removal.ReplacedBy = reason, common.Hash{}
+		return true
+	})
+}
+
+// Replaced records that a tx was replaced by the tx with hash by.
+func (txt *txTracker) Replaced(hash, by common.Hash) {
+	txt.recordRemoval(hash, func(removal *txRemoval) bool {
+		if removal.Height != 0 {
+			return false
+		}
+		removal.Reason, removal.ReplacedBy = legacypool.RemovalReasonReplaced, by
+		return true
+	})
+}
+
+// Included records that a tx was included in the block at height.
+func (txt *txTracker) Included(hash common.Hash, height int64) {
+	txt.recordRemoval(hash, func(removal *txRemoval) bool {
+		removal.Reason, removal.ReplacedBy, removal.Height = "", common.Hash{}, height
+		return true
+	})
+}
+
+// Removal returns why the tx exited the mempool, if it did within the
+// retention window.
+func (txt *txTracker) Removal(hash common.Hash) (txRemoval, bool) {
+	txt.removalsLock.Lock()
+	defer txt.removalsLock.Unlock()
+
+	txt.pruneRemovals(time.Now())
+	removal, ok := txt.removals[hash]
+	if !ok {
+		return txRemoval{}, false
+	}
+	return *removal, true
+}
+
+// Evicted returns the txs that exited the mempool without being included in
+// a block within the retention window, by hash.
+func (txt *txTracker) Evicted() map[common.Hash]txRemoval {
+	txt.removalsLock.Lock()
+	defer txt.removalsLock.Unlock()
+
+	txt.pruneRemovals(time.Now())
+	evicted := make(map[common.Hash]txRemoval)
+	for hash, removal := range txt.removals {
+		if removal.Height == 0 {
+			evicted[hash] = *removal
+		}
+	}
+	return evicted
+}
+
+// recordRemoval updates the removal of the tx with update, which returns
+// false if the removal must be left unchanged.
+func (txt *txTracker) recordRemoval(hash common.Hash, update func(removal *txRemoval) bool) {
+	if txt.retention <= 0 {
+		return
+	}
+	txt.removalsLock.Lock()
+	defer txt.removalsLock.Unlock()
+
+	now := time.Now()
+	txt.pruneRemovals(now)
+
+	removal, ok := txt.removals[hash]
+	if !ok {
+		removal = &txRemoval{}
+	}
+	if !update(removal) {
+		return
+	}
+	removal.RemovedAt = now
+	txt.removals[hash] = removal
+	txt.removalOrder = append(txt.removalOrder, removalEntry{hash: hash, at: now})
+}
+
+// pruneRemovals drops the removals recorded before the retention window.
+func (txt *txTracker) pruneRemovals(now time.Time) {
+	var pruned int
+	for _, entry := range txt.removalOrder {
+		if now.Sub(entry.at) < txt.retention {
+			break
+		}
+		// the removal may have been recorded again since
+		if removal, ok := txt.removals[entry.hash]; ok && !removal.RemovedAt.After(entry.at) {
+			delete(txt.removals, entry.hash)
+		}
+		pruned++
+	}
+	txt.removalOrder = txt.removalOrder[pruned:]
+}
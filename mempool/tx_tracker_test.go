@@ -0,0 +1,60 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+)
+
+func TestTxTrackerRemovals(t *testing.T) {
+	txt := newTxTracker(time.Minute)
+	evicted, replaced, included := common.Hash{0x1}, common.Hash{0x2}, common.Hash{0x3}
+	from := common.Address{0x1}
+
+	txt.Removed(evicted, from, 1, legacypool.RemovalReasonLifetime)
+	removal, ok := txt.Removal(evicted)
+	require.True(t, ok)
+	require.Equal(t, legacypool.RemovalReasonLifetime, removal.Reason)
+
+	txt.Removed(replaced, from, 2, legacypool.RemovalReasonReplaced)
+	txt.Replaced(replaced, included)
+	removal, ok = txt.Removal(replaced)
+	require.True(t, ok)
+	require.Equal(t, included, removal.ReplacedBy)
+
+	// the removal of an included tx doesn't override its inclusion
+	txt.Included(included, 5)
+	txt.Removed(included, from, 3, legacypool.RemovalReasonOld)
+	removal, ok = txt.Removal(included)
+	require.True(t, ok)
+	require.Equal(t, int64(5), removal.Height)
+
+	_, ok = txt.Removal(common.Hash{0x4})
+	require.False(t, ok)
+
+	// the included txs are not reported as evicted
+	evictions := txt.Evicted()
+	require.Len(t, evictions, 2)
+	require.Equal(t, from, evictions[evicted].From)
+	require.Equal(t, uint64(1), evictions[evicted].Nonce)
+	require.Equal(t, legacypool.RemovalReasonLifetime, evictions[evicted].Reason)
+	require.Contains(t, evictions, replaced)
+
+	// the removals are pruned once out of the retention window
+	txt = newTxTracker(time.Nanosecond)
+	txt.Removed(evicted, from, 1, legacypool.RemovalReasonLifetime)
+	time.Sleep(time.Millisecond)
+	_, ok = txt.Removal(evicted)
+	require.False(t, ok)
+	require.Empty(t, txt.removalOrder)
+
+	// and not retained if the retention is disabled
+	txt = newTxTracker(0)
+	txt.Removed(evicted, from, 1, legacypool.RemovalReasonLifetime)
+	_, ok = txt.Removal(evicted)
+	require.False(t, ok)
+}
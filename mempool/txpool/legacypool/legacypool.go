@@ -63,6 +63,10 @@ const (
 	// more expensive to propagate; larger transactions also take more resources
 	// to validate whether they fit into the pool or not.
 	txMaxSize = 4 * txSlotSize // 128KB
+
+	// maxAccountSlotsScale is the maximum factor the per-account limits are
+	// scaled by with the balance of the account.
+	maxAccountSlotsScale = 4
 )
 
 var (
@@ -101,6 +105,11 @@ const (
 	RemovalReasonRunTxRecheck           txpool.RemovalReason = "runtx_recheck"
 	RemovalReasonRunTxFinalize          txpool.RemovalReason = "runtx_finalize"
 	RemovalReasonPreparePropsoalInvalid txpool.RemovalReason = "prepare_proposal_invalid"
+	RemovalReasonRecheck                txpool.RemovalReason = "recheck"             // Tx failed the pools RecheckTxFn
+	RemovalReasonReplaced               txpool.RemovalReason = "replaced"            // Tx was replaced by a tx with the same nonce and a higher price
+	RemovalReasonReplaceUnderpriced     txpool.RemovalReason = "replace_underpriced" // Tx was promoted while a pending tx with the same nonce had a higher price
+	RemovalReasonUnderpricedBytes       txpool.RemovalReason = "underpriced_bytes"   // New tx came in that has a better price. The pool is over its byte budget so we kicked a tx out to make room.
+	RemovalReasonQueuedAge              txpool.RemovalReason = "queued_age"          // Tx has been in queued for too long, regardless of the account activity
 )
 
 var (
@@ -111,6 +120,8 @@ var (
 	queuedRemovedTruncatedOverflow = metrics.NewRegisteredMeter("txpool/queued/removed/truncated_overflow", nil)
 	queuedRemovedTruncatedLast     = metrics.NewRegisteredMeter("txpool/queued/removed/truncated_last", nil)
 	queuedRemovedUnderpricedFull   = metrics.NewRegisteredMeter("txpool/queued/removed/underpriced_full", nil)
+	queuedRemovedUnderpricedBytes  = metrics.NewRegisteredMeter("txpool/queued/removed/underpriced_bytes", nil)
+	queuedRemovedAge               = metrics.NewRegisteredMeter("txpool/queued/removed/queued_age", nil)
 	queuedRemovedOld               = metrics.NewRegisteredMeter("txpool/queued/removed/old", nil)
 	queuedRemovedCostly            = metrics.NewRegisteredMeter("txpool/queued/removed/costly", nil)
 	queuedRemovedCapped            = metrics.NewRegisteredMeter("txpool/queued/removed/capped", nil)
@@ -124,6 +135,7 @@ var (
 	pendingRemovedTruncatedOverflow = metrics.NewRegisteredMeter("txpool/pending/removed/truncated_overflow", nil)
 	pendingRemovedTruncatedLast     = metrics.NewRegisteredMeter("txpool/pending/removed/truncated_last", nil)
 	pendingRemovedUnderpricedFull   = metrics.NewRegisteredMeter("txpool/pending/removed/underpriced_full", nil)
+	pendingRemovedUnderpricedBytes  = metrics.NewRegisteredMeter("txpool/pending/removed/underpriced_bytes", nil)
 	pendingRemovedOld               = metrics.NewRegisteredMeter("txpool/pending/removed/old", nil)
 	pendingRemovedCostly            = metrics.NewRegisteredMeter("txpool/pending/removed/costly", nil)
 	pendingRemovedCapped            = metrics.NewRegisteredMeter("txpool/pending/removed/capped", nil)
@@ -187,6 +199,7 @@ var (
 	pendingGauge = metrics.NewRegisteredGauge("txpool/pending", nil)
 	queuedGauge  = metrics.NewRegisteredGauge("txpool/queued", nil)
 	slotsGauge   = metrics.NewRegisteredGauge("txpool/slots", nil)
+	bytesGauge   = metrics.NewRegisteredGauge("txpool/bytes", nil)
 
 	reheapTimer = metrics.NewRegisteredTimer("txpool/reheap", nil)
 )
@@ -248,8 +261,15 @@ type Config struct {
 	GlobalSlots  uint64 // Maximum number of executable transaction slots for all accounts
 	AccountQueue uint64 // Maximum number of non-executable transaction slots permitted per account
 	GlobalQueue  uint64 // Maximum number of non-executable transaction slots for all accounts
+	GlobalBytes  uint64 // Maximum size in bytes of all the transactions of the pool (0 = unbounded)
+
+	// AccountSlotsBalance is the balance for which an account is granted
+	// AccountSlots and AccountQueue. The accounts with a higher balance get
+	// proportionally more, up to maxAccountSlotsScale times (nil = no scaling).
+	AccountSlotsBalance *uint256.Int
 
-	Lifetime time.Duration // Maximum amount of time non-executable transaction are queued
+	Lifetime         time.Duration // Maximum amount of time non-executable transaction are queued
+	QueuedTxLifetime time.Duration // Maximum amount of time a single non-executable transaction is queued (0 = unbounded)
 }
 
 // DefaultConfig contains the default configurations for the transaction pool.
@@ -300,6 +320,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultConfig.Lifetime)
 		conf.Lifetime = DefaultConfig.Lifetime
 	}
+	if conf.AccountSlotsBalance != nil && conf.AccountSlotsBalance.IsZero() {
+		log.Warn("Sanitizing invalid txpool account slots balance", "provided", conf.AccountSlotsBalance, "updated", "disabled")
+		conf.AccountSlotsBalance = nil
+	}
 	return conf
 }
 
@@ -363,7 +387,10 @@ type LegacyPool struct {
 	OnTxPromoted func(tx *types.Transaction)
 	// OnTxRemoved is called when a tx is removed from the mempool (either
 	// explicitly via RemoveTx or implicitly during Reset)
-	OnTxRemoved func(tx *types.Transaction, pool PoolType)
+	OnTxRemoved func(tx *types.Transaction, pool PoolType, reason txpool.RemovalReason)
+	// OnTxReplaced is called when a tx is replaced by a tx with the same nonce
+	// and a higher price, after OnTxRemoved is called for the old tx
+	OnTxReplaced func(old, tx *types.Transaction)
 	// OnTxRemoved is called when a tx is added to the queued pool
 	OnTxEnqueued func(tx *types.Transaction)
 }
@@ -507,6 +534,19 @@ func (pool *LegacyPool) loop() {
 						pool.removeTx(tx.Hash(), true, true, RemovalReasonLifetime)
 					}
 					queuedEvictionMeter.Mark(int64(len(list)))
+					continue
+				}
+				// Any transaction queued for too long should be removed too,
+				// even if the account keeps sending new ones
+				if pool.config.QueuedTxLifetime > 0 {
+					var evicted int64
+					for _, tx := range pool.queue[addr].Flatten() {
+						if time.Since(tx.Time()) > pool.config.QueuedTxLifetime {
+							pool.removeTx(tx.Hash(), true, true, RemovalReasonQueuedAge)
+							evicted++
+						}
+					}
+					queuedEvictionMeter.Mark(evicted)
 				}
 			}
 			pool.mu.Unlock()
@@ -876,7 +916,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}()
 	}
 	// If the transaction pool is full, discard underpriced transactions
-	if uint64(pool.all.Slots()+numSlots(tx)) > pool.config.GlobalSlots+pool.config.GlobalQueue {
+	if slotsOver, bytesOver := pool.overflow(tx); slotsOver > 0 || bytesOver > 0 {
 		// If the new transaction is underpriced, don't accept it
 		if pool.priced.Underpriced(tx) {
 			log.Trace("Discarding underpriced transaction", "hash", hash, "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
@@ -895,7 +935,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 
 		// New transaction is better than our worse ones, make room for it.
 		// If we can't make enough room for new one, abort the operation.
-		drop, success := pool.priced.Discard(pool.all.Slots() - int(pool.config.GlobalSlots+pool.config.GlobalQueue) + numSlots(tx))
+		drop, success := pool.priced.Discard(slotsOver, bytesOver)
 
 		// Special case, we still can't make the room for the new remote one.
 		if !success {
@@ -925,12 +965,16 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}
 
 		// Kick out the underpriced remote transactions.
+		reason := RemovalReasonUnderpricedFull
+		if slotsOver <= 0 {
+			reason = RemovalReasonUnderpricedBytes
+		}
 		for _, tx := range drop {
 			log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
 			underpricedTxMeter.Mark(1)
 
 			sender, _ := types.Sender(pool.signer, tx)
-			dropped := pool.removeTx(tx.Hash(), false, sender != from, RemovalReasonUnderpricedFull) // Don't unreserve the sender of the tx being added if last from the acc
+			dropped := pool.removeTx(tx.Hash(), false, sender != from, reason) // Don't unreserve the sender of the tx being added if last from the acc
 
 			pool.changesSinceReorg += dropped
 		}
@@ -948,7 +992,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		if old != nil {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
-			pool.markTxRemoved(from, old, Pending)
+			pool.markTxSuperseded(from, old, tx, Pending)
 			pendingReplaceMeter.Mark(1)
 		}
 		pool.all.Add(tx)
@@ -974,6 +1018,34 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 	return replaced, nil
 }
 
+// overflow returns the number of slots and bytes that must be freed to fit the
+// given transaction into the pool, non-positive if it fits already.
+func (pool *LegacyPool) overflow(tx *types.Transaction) (int, uint64) {
+	slots := pool.all.Slots() + numSlots(tx) - int(pool.config.GlobalSlots+pool.config.GlobalQueue) //nolint:gosec // G115 // the pool limits never overflow an int
+	if pool.config.GlobalBytes == 0 {
+		return slots, 0
+	}
+	size := pool.all.Bytes() + tx.Size()
+	if size <= pool.config.GlobalBytes {
+		return slots, 0
+	}
+	return slots, size - pool.config.GlobalBytes
+}
+
+// accountLimit returns the given per-account limit scaled by the balance of the
+// account: an account holding AccountSlotsBalance is granted the limit, and the
+// richer ones proportionally more, up to maxAccountSlotsScale times the limit.
+func (pool *LegacyPool) accountLimit(addr common.Address, limit uint64) uint64 {
+	if pool.config.AccountSlotsBalance == nil || pool.currentState == nil {
+		return limit
+	}
+	scaled, overflow := new(uint256.Int).MulDivOverflow(pool.currentState.GetBalance(addr), uint256.NewInt(limit), pool.config.AccountSlotsBalance)
+	if overflow || !scaled.IsUint64() {
+		return limit * maxAccountSlotsScale
+	}
+	return min(max(scaled.Uint64(), limit), limit*maxAccountSlotsScale)
+}
+
 // isGapped reports whether the given transaction is immediately executable.
 func (pool *LegacyPool) isGapped(from common.Address, tx *types.Transaction) bool {
 	// Short circuit if transaction falls within the scope of the pending list
@@ -1018,7 +1090,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
-		pool.markTxRemoved(from, old, Queue)
+		pool.markTxSuperseded(from, old, tx, Queue)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -1056,7 +1128,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		// An older transaction was better, discard this
 		pool.all.Remove(hash)
 		pool.priced.Removed(1)
-		pool.markTxRemoved(addr, tx, Queue)
+		pool.markTxRemoved(addr, tx, Queue, RemovalReasonReplaceUnderpriced)
 		pendingDiscardMeter.Mark(1)
 		return false
 	}
@@ -1066,7 +1138,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		// should remove it from there
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
-		pool.markTxRemoved(addr, old, Pending)
+		pool.markTxSuperseded(addr, old, tx, Pending)
 		pendingReplaceMeter.Mark(1)
 	} else {
 		// Nothing was replaced, bump the pending counter
@@ -1305,7 +1377,7 @@ func (pool *LegacyPool) removeTx(hash common.Hash, outofbound bool, unreserve bo
 	// Remove the transaction from the pending lists and reset the account nonce
 	if pending := pool.pending[addr]; pending != nil {
 		if removed, invalids := pending.Remove(tx); removed {
-			pool.markTxRemoved(addr, tx, Pending)
+			pool.markTxRemoved(addr, tx, Pending, reason)
 			pendingRemovalMetric(reason).Mark(1)
 
 			// If no more pending transactions are left, remove the list
@@ -1328,7 +1400,7 @@ func (pool *LegacyPool) removeTx(hash common.Hash, outofbound bool, unreserve bo
 	// Transaction is in the future queue
 	if future := pool.queue[addr]; future != nil {
 		if removed, _ := future.Remove(tx); removed {
-			pool.markTxRemoved(addr, tx, Queue)
+			pool.markTxRemoved(addr, tx, Queue, reason)
 			queueRemovalMetric(reason).Mark(1)
 
 			// Reduce the queued counter
@@ -1521,7 +1593,12 @@ func (pool *LegacyPool) runReorg(input reorgInput) {
 		pool.demoteUnexecutables(input.cancelReset, input.reset)
 		if input.reset.newHead != nil {
 			if pool.chainconfig.IsLondon(new(big.Int).Add(input.reset.newHead.Number, big.NewInt(1))) {
-				pendingBaseFee := eip1559.CalcBaseFee(pool.chainconfig, input.reset.newHead)
+				// Rank the transactions by their effective tip at the feemarket
+				// base fee, if the chain reports it
+				pendingBaseFee := input.reset.newHead.BaseFee
+				if pendingBaseFee == nil {
+					pendingBaseFee = eip1559.CalcBaseFee(pool.chainconfig, input.reset.newHead)
+				}
 				pool.priced.SetBaseFee(pendingBaseFee)
 			} else {
 				pool.priced.Reheap()
@@ -1643,7 +1720,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address, cancelled
 		forwards := list.Forward(pool.currentState.GetNonce(addr))
 		for _, tx := range forwards {
 			pool.all.Remove(tx.Hash())
-			pool.markTxRemoved(addr, tx, Queue)
+			pool.markTxRemoved(addr, tx, Queue, RemovalReasonOld)
 			queueRemovalMetric(RemovalReasonOld).Mark(1)
 		}
 		log.Trace("Removed old queued transactions", "count", len(forwards))
@@ -1651,7 +1728,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address, cancelled
 		costDrops, _ := list.CostFilter(pool.currentState.GetBalance(addr), gasLimit)
 		for _, tx := range costDrops {
 			pool.all.Remove(tx.Hash())
-			pool.markTxRemoved(addr, tx, Queue)
+			pool.markTxRemoved(addr, tx, Queue, RemovalReasonCostly)
 			queueRemovalMetric(RemovalReasonCostly).Mark(1)
 		}
 		log.Trace("Removed unpayable queued transactions", "count", len(costDrops))
@@ -1677,7 +1754,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address, cancelled
 		})
 		for _, tx := range recheckDrops {
 			pool.all.Remove(tx.Hash())
-			pool.markTxRemoved(addr, tx, Queue)
+			pool.markTxRemoved(addr, tx, Queue, RemovalReasonRecheck)
 		}
 		log.Trace("Removed queued transactions that failed recheck", "count", len(recheckDrops))
 		queuedRecheckDropMeter.Mark(int64(len(recheckDrops)))
@@ -1697,11 +1774,11 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address, cancelled
 		queuedGauge.Dec(int64(len(readies)))
 
 		// Drop all transactions over the allowed limit
-		caps := list.Cap(int(pool.config.AccountQueue))
+		caps := list.Cap(int(pool.accountLimit(addr, pool.config.AccountQueue))) //nolint:gosec // G115 // the account limits never overflow an int
 		for _, tx := range caps {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
-			pool.markTxRemoved(addr, tx, Queue)
+			pool.markTxRemoved(addr, tx, Queue, RemovalReasonCapExceeded)
 			queueRemovalMetric(RemovalReasonCapExceeded).Mark(1)
 			log.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 		}
@@ -1725,20 +1802,21 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address, cancelled
 }
 
 // truncatePending removes transactions from the pending queue if the pool is above the
-// pending limit. The algorithm tries to reduce transaction counts by an approximately
-// equal number for all for accounts with many pending transactions.
+// pending limit. The algorithm drops the transactions of the accounts furthest above
+// their allowance first, until all of them are equally above it or the pool is back
+// under the limit. The allowance of an account is scaled by its balance.
 func (pool *LegacyPool) truncatePending() {
 	defer func(t0 time.Time) { pendingTruncateTimer.UpdateSince(t0) }(time.Now())
 	pending := uint64(0)
 
-	// Assemble a spam order to penalize large transactors first
+	// Assemble a spam order to penalize the accounts furthest above their allowance first
 	spammers := prque.New[uint64, common.Address](nil)
 	for addr, list := range pool.pending {
 		// Only evict transactions from high rollers
 		length := uint64(list.Len())
 		pending += length
-		if length > pool.config.AccountSlots {
-			spammers.Push(addr, length)
+		if limit := pool.accountLimit(addr, pool.config.AccountSlots); length > limit {
+			spammers.Push(addr, length-limit)
 		}
 	}
 	if pending <= pool.config.GlobalSlots {
@@ -1746,66 +1824,29 @@ func (pool *LegacyPool) truncatePending() {
 	}
 	pendingBeforeCap := pending
 
-	// Gradually drop transactions from offenders
-	offenders := []common.Address{}
+	// Drop transactions one at a time from the worst offender
 	for pending > pool.config.GlobalSlots && !spammers.Empty() {
-		// Retrieve the next offender
-		offender, _ := spammers.Pop()
-		offenders = append(offenders, offender)
-
-		// Equalize balances until all the same or below threshold
-		if len(offenders) > 1 {
-			// Calculate the equalization threshold for all current offenders
-			threshold := pool.pending[offender].Len()
-
-			// Iteratively reduce all offenders until below limit or threshold reached
-			for pending > pool.config.GlobalSlots && pool.pending[offenders[len(offenders)-2]].Len() > threshold {
-				for i := 0; i < len(offenders)-1; i++ {
-					list := pool.pending[offenders[i]]
-
-					caps := list.Cap(list.Len() - 1)
-					for _, tx := range caps {
-						// Drop the transaction from the global pools too
-						hash := tx.Hash()
-						pool.all.Remove(hash)
-						pool.markTxRemoved(offenders[i], tx, Pending)
-						pendingRemovalMetric(RemovalReasonCapExceeded).Mark(1)
-
-						// Update the account nonce to the dropped transaction
-						pool.pendingNonces.setIfLower(offenders[i], tx.Nonce())
-						log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
-					}
-					pool.priced.Removed(len(caps))
-					pendingGauge.Dec(int64(len(caps)))
+		offender, excess := spammers.Pop()
+		list := pool.pending[offender]
 
-					pending--
-				}
-			}
-		}
-	}
-
-	// If still above threshold, reduce to limit or min allowance
-	if pending > pool.config.GlobalSlots && len(offenders) > 0 {
-		for pending > pool.config.GlobalSlots && uint64(pool.pending[offenders[len(offenders)-1]].Len()) > pool.config.AccountSlots {
-			for _, addr := range offenders {
-				list := pool.pending[addr]
+		caps := list.Cap(list.Len() - 1)
+		for _, tx := range caps {
+			// Drop the transaction from the global pools too
+			hash := tx.Hash()
+			pool.all.Remove(hash)
+			pool.markTxRemoved(offender, tx, Pending, RemovalReasonCapExceeded)
+			pendingRemovalMetric(RemovalReasonCapExceeded).Mark(1)
 
-				caps := list.Cap(list.Len() - 1)
-				for _, tx := range caps {
-					// Drop the transaction from the global pools too
-					hash := tx.Hash()
-					pool.all.Remove(hash)
-					pool.markTxRemoved(addr, tx, Pending)
-					pendingRemovalMetric(RemovalReasonCapExceeded).Mark(1)
+			// Update the account nonce to the dropped transaction
+			pool.pendingNonces.setIfLower(offender, tx.Nonce())
+			log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
+		}
+		pool.priced.Removed(len(caps))
+		pendingGauge.Dec(int64(len(caps)))
+		pending--
 
-					// Update the account nonce to the dropped transaction
-					pool.pendingNonces.setIfLower(addr, tx.Nonce())
-					log.Trace("Removed fairness-exceeding pending transaction", "hash", hash)
-				}
-				pool.priced.Removed(len(caps))
-				pendingGauge.Dec(int64(len(caps)))
-				pending--
-			}
+		if excess > 1 {
+			spammers.Push(offender, excess-1)
 		}
 	}
 	pendingRateLimitMeter.Mark(int64(pendingBeforeCap - pending))
@@ -1879,7 +1920,7 @@ func (pool *LegacyPool) demoteUnexecutables(cancelled chan struct{}, reset *txpo
 		for _, tx := range olds {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
-			pool.markTxRemoved(addr, tx, Pending)
+			pool.markTxRemoved(addr, tx, Pending, RemovalReasonOld)
 			log.Trace("Removed old pending transaction", "hash", hash)
 			pendingRemovalMetric(RemovalReasonOld).Mark(1)
 		}
@@ -1888,7 +1929,7 @@ func (pool *LegacyPool) demoteUnexecutables(cancelled chan struct{}, reset *txpo
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
-			pool.markTxRemoved(addr, tx, Pending)
+			pool.markTxRemoved(addr, tx, Pending, RemovalReasonCostly)
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 			pendingRemovalMetric(RemovalReasonCostly).Mark(1)
 		}
@@ -1917,7 +1958,7 @@ func (pool *LegacyPool) demoteUnexecutables(cancelled chan struct{}, reset *txpo
 		for _, tx := range recheckDrops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
-			pool.markTxRemoved(addr, tx, Pending)
+			pool.markTxRemoved(addr, tx, Pending, RemovalReasonRecheck)
 			log.Trace("Removed pending transaction that failed recheck", "hash", hash)
 		}
 		pendingRecheckDropMeter.Mark(int64(len(recheckDrops)))
@@ -2033,6 +2074,7 @@ func (as *accountSet) merge(other *accountSet) {
 // LegacyPool.mu mutex.
 type lookup struct {
 	slots int
+	bytes uint64
 	lock  sync.RWMutex
 	txs   map[common.Hash]*types.Transaction
 
@@ -2085,6 +2127,14 @@ func (t *lookup) Slots() int {
 	return t.slots
 }
 
+// Bytes returns the current size in bytes of the transactions in the lookup.
+func (t *lookup) Bytes() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.bytes
+}
+
 // Add adds a transaction to the lookup.
 func (t *lookup) Add(tx *types.Transaction) {
 	t.lock.Lock()
@@ -2092,6 +2142,8 @@ func (t *lookup) Add(tx *types.Transaction) {
 
 	t.slots += numSlots(tx)
 	slotsGauge.Update(int64(t.slots))
+	t.bytes += tx.Size()
+	bytesGauge.Update(int64(t.bytes)) //nolint:gosec // G115 // the pool size never overflows an int64
 
 	t.txs[tx.Hash()] = tx
 	t.addAuthorities(tx)
@@ -2110,6 +2162,8 @@ func (t *lookup) Remove(hash common.Hash) {
 	t.removeAuthorities(tx)
 	t.slots -= numSlots(tx)
 	slotsGauge.Update(int64(t.slots))
+	t.bytes -= tx.Size()
+	bytesGauge.Update(int64(t.bytes)) //nolint:gosec // G115 // the pool size never overflows an int64
 
 	delete(t.txs, hash)
 }
@@ -2120,6 +2174,7 @@ func (t *lookup) Clear() {
 	defer t.lock.Unlock()
 
 	t.slots = 0
+	t.bytes = 0
 	t.txs = make(map[common.Hash]*types.Transaction)
 	t.auths = make(map[common.Address][]common.Hash)
 }
@@ -2257,7 +2312,7 @@ func (pool *LegacyPool) markTxReplaced(addr common.Address, tx *types.Transactio
 }
 
 // markTxRemoved calls the OnTxRemoved callback if it has been supplied.
-func (pool *LegacyPool) markTxRemoved(addr common.Address, tx *types.Transaction, p PoolType) {
+func (pool *LegacyPool) markTxRemoved(addr common.Address, tx *types.Transaction, p PoolType, reason txpool.RemovalReason) {
 	if p == Pending {
 		defer func(t0 time.Time) { pendingRemoveCBTimer.UpdateSince(t0) }(time.Now())
 
@@ -2266,7 +2321,16 @@ func (pool *LegacyPool) markTxRemoved(addr common.Address, tx *types.Transaction
 		})
 	}
 	if pool.OnTxRemoved != nil {
-		pool.OnTxRemoved(tx, p)
+		pool.OnTxRemoved(tx, p, reason)
+	}
+}
+
+// markTxSuperseded marks the old tx as removed since it is replaced by tx, and
+// calls the OnTxReplaced callback if it has been supplied.
+func (pool *LegacyPool) markTxSuperseded(addr common.Address, old, tx *types.Transaction, p PoolType) {
+	pool.markTxRemoved(addr, old, p, RemovalReasonReplaced)
+	if pool.OnTxReplaced != nil {
+		pool.OnTxReplaced(old, tx)
 	}
 }
 
@@ -2301,6 +2365,8 @@ func pendingRemovalMetric(reason txpool.RemovalReason) *metrics.Meter {
 		return pendingRemovedTruncatedLast
 	case RemovalReasonUnderpricedFull:
 		return pendingRemovedUnderpricedFull
+	case RemovalReasonUnderpricedBytes:
+		return pendingRemovedUnderpricedBytes
 	case RemovalReasonOld:
 		return pendingRemovedOld
 	case RemovalReasonCostly:
@@ -2329,6 +2395,10 @@ func queueRemovalMetric(reason txpool.RemovalReason) *metrics.Meter {
 		return queuedRemovedTruncatedLast
 	case RemovalReasonUnderpricedFull:
 		return queuedRemovedUnderpricedFull
+	case RemovalReasonUnderpricedBytes:
+		return queuedRemovedUnderpricedBytes
+	case RemovalReasonQueuedAge:
+		return queuedRemovedAge
 	case RemovalReasonOld:
 		return queuedRemovedOld
 	case RemovalReasonCostly:
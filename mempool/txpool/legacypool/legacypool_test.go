@@ -269,6 +269,14 @@ func validatePoolInternals(pool *LegacyPool) error {
 	if total := pool.all.Count(); total != pending+queued {
 		return fmt.Errorf("total transaction count %d != %d pending + %d queued", total, pending, queued)
 	}
+	// Ensure the size of the pool is consistent with its transactions
+	var size uint64
+	for _, tx := range pool.all.txs {
+		size += tx.Size()
+	}
+	if size != pool.all.bytes {
+		return fmt.Errorf("total transaction size %d != %d tracked", size, pool.all.bytes)
+	}
 	pool.priced.Reheap()
 	priced, remote := pool.priced.urgent.Len()+pool.priced.floating.Len(), pool.all.Count()
 	if priced != remote {
@@ -1336,6 +1344,60 @@ func TestPendingLimiting(t *testing.T) {
 	}
 }
 
+// Tests that queued transactions are evicted once older than the queued tx
+// lifetime, even if their account keeps sending new ones.
+func TestQueuedTxAgeLimiting(t *testing.T) {
+	// Reduce the eviction interval to a testable amount
+	defer func(old time.Duration) { evictionInterval = old }(evictionInterval)
+	evictionInterval = time.Millisecond * 100
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.QueuedTxLifetime = time.Second
+
+	pool := New(config, log.NewNopLogger(), blockchain)
+	var (
+		removedMu sync.Mutex
+		removed   = make(map[common.Hash]txpool.RemovalReason)
+	)
+	pool.OnTxRemoved = func(tx *types.Transaction, _ PoolType, reason txpool.RemovalReason) {
+		removedMu.Lock()
+		defer removedMu.Unlock()
+		removed[tx.Hash()] = reason
+	}
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	remote, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(remote.PublicKey), big.NewInt(1000000000))
+
+	// Queue a gapped transaction, then a newer one half a lifetime later
+	old := pricedTransaction(1, 100000, big.NewInt(1), remote)
+	require.NoError(t, pool.addRemoteSync(old))
+	time.Sleep(6 * evictionInterval)
+	fresh := pricedTransaction(2, 100000, big.NewInt(1), remote)
+	require.NoError(t, pool.addRemoteSync(fresh))
+	time.Sleep(6 * evictionInterval)
+
+	// Only the old transaction should have been evicted
+	require.Nil(t, pool.Get(old.Hash()))
+	require.NotNil(t, pool.Get(fresh.Hash()))
+	removedMu.Lock()
+	require.Equal(t, RemovalReasonQueuedAge, removed[old.Hash()])
+	removedMu.Unlock()
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// Once its lifetime passes too, the newer transaction should be evicted
+	time.Sleep(2 * config.QueuedTxLifetime)
+	pending, queued := pool.Stats()
+	require.Zero(t, pending)
+	require.Zero(t, queued)
+}
+
 // Tests that if the transaction count belonging to multiple accounts go above
 // some hard threshold, the higher transactions are dropped to prevent DOS
 // attacks.
@@ -1515,6 +1577,54 @@ func TestPendingMinimumAllowance(t *testing.T) {
 	}
 }
 
+// Tests that the pending allowance of an account is scaled by its balance when
+// the pending pool is over its limit.
+func TestPendingBalanceScaledAllowance(t *testing.T) {
+	t.Parallel()
+
+	// Create the pool to test the limit enforcement with
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	config := testTxPoolConfig
+	config.GlobalSlots = 1
+	config.AccountSlotsBalance = uint256.NewInt(10000000)
+
+	pool := New(config, log.NewNopLogger(), blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	// Create test accounts with increasing balances and the allowance they're
+	// expected to be granted
+	balances := []int64{10000000, 20000000, 1000000000}
+	allowances := []int{int(config.AccountSlots), 2 * int(config.AccountSlots), maxAccountSlotsScale * int(config.AccountSlots)}
+
+	keys := make([]*ecdsa.PrivateKey, len(balances))
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+		testAddBalance(pool, crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(balances[i]))
+	}
+	// Generate and queue a batch of transactions
+	txs := types.Transactions{}
+	for _, key := range keys {
+		for j := 0; j < maxAccountSlotsScale*int(config.AccountSlots); j++ {
+			txs = append(txs, transaction(uint64(j), 100000, key)) //nolint:gosec // G115 // test nonces are small
+		}
+	}
+	// Import the batch and verify that the scaled limits have been enforced
+	pool.addRemotesSync(txs)
+
+	for i, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		if have := pool.pending[addr].Len(); have != allowances[i] {
+			t.Errorf("addr %x: total pending transactions mismatch: have %d, want %d", addr, have, allowances[i])
+		}
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that setting the transaction pool gas price to a higher value correctly
 // discards everything cheaper than that and moves any gapped transactions back
 // from the pending pool to the queue.
@@ -1855,6 +1965,59 @@ func TestUnderpricing(t *testing.T) {
 	}
 }
 
+// Tests that when the pool is over its byte budget, the transactions with the
+// lowest effective tip are evicted to make room for better paying ones.
+func TestUnderpricingBytes(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	// Make room for three transactions only
+	config := testTxPoolConfig
+	config.GlobalBytes = 3*pricedTransaction(0, 100000, big.NewInt(1), keys[0]).Size() + 10
+
+	pool := New(config, log.NewNopLogger(), blockchain)
+	removed := make(map[common.Hash]txpool.RemovalReason)
+	pool.OnTxRemoved = func(tx *types.Transaction, _ PoolType, reason txpool.RemovalReason) {
+		removed[tx.Hash()] = reason
+	}
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	for _, key := range keys {
+		testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(10000000))
+	}
+	cheap := pricedTransaction(0, 100000, big.NewInt(1), keys[0])
+	pool.addRemotesSync(types.Transactions{
+		cheap,
+		pricedTransaction(0, 100000, big.NewInt(2), keys[1]),
+		pricedTransaction(0, 100000, big.NewInt(3), keys[2]),
+	})
+	pending, _ := pool.Stats()
+	require.Equal(t, 3, pending)
+
+	// Ensure that adding an underpriced transaction over the budget fails
+	err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(1), keys[3]))
+	require.ErrorIs(t, err, txpool.ErrUnderpriced)
+
+	// Ensure that adding a better paying transaction evicts the cheapest one
+	require.NoError(t, pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(4), keys[3])))
+	require.Nil(t, pool.Get(cheap.Hash()))
+	require.Equal(t, RemovalReasonUnderpricedBytes, removed[cheap.Hash()])
+	require.LessOrEqual(t, pool.all.Bytes(), config.GlobalBytes)
+
+	pending, _ = pool.Stats()
+	require.Equal(t, 3, pending)
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that more expensive transactions push out cheap ones from the pool, but
 // without producing instability by creating gaps that start jumping transactions
 // back and forth between queued/pending.
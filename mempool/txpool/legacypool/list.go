@@ -679,11 +679,11 @@ func (l *pricedList) underpricedFor(h *priceHeap, tx *types.Transaction) bool {
 }
 
 // Discard finds a number of most underpriced transactions, removes them from the
-// priced list and returns them for further removal from the entire pool.
-// If noPending is set to true, we will only consider the floating list
-func (l *pricedList) Discard(slots int) (types.Transactions, bool) {
-	drop := make(types.Transactions, 0, slots) // Remote underpriced transactions to drop
-	for slots > 0 {
+// priced list and returns them for further removal from the entire pool. It
+// frees at least the given number of slots and bytes.
+func (l *pricedList) Discard(slots int, size uint64) (types.Transactions, bool) {
+	drop := make(types.Transactions, 0, max(slots, 1)) // Remote underpriced transactions to drop
+	for slots > 0 || size > 0 {
 		if len(l.urgent.list)*floatingRatio > len(l.floating.list)*urgentRatio {
 			// Discard stale transactions if found during cleanup
 			tx := heap.Pop(&l.urgent).(*types.Transaction)
@@ -707,10 +707,11 @@ func (l *pricedList) Discard(slots int) (types.Transactions, bool) {
 			// Non stale transaction found, discard it
 			drop = append(drop, tx)
 			slots -= numSlots(tx)
+			size -= min(size, tx.Size())
 		}
 	}
 	// If we still can't make enough room for the new transaction
-	if slots > 0 {
+	if slots > 0 || size > 0 {
 		for _, tx := range drop {
 			heap.Push(&l.urgent, tx)
 		}
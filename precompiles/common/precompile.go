@@ -84,6 +84,9 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 
 	initialGas := ctx.GasMeter().GasConsumed()
 
+	endTrace := traceNativeAction(ctx, evm, contract)
+	defer func() { endTrace(err) }()
+
 	defer HandleGasError(ctx, contract, initialGas, &err)()
 
 	// set the default SDK gas configuration to track gas usage
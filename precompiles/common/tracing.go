@@ -0,0 +1,152 @@
+package common
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// abiMethodProvider is implemented by the precompiles embedding their ABI.
+type abiMethodProvider interface {
+	MethodById(sigdata []byte) (*abi.Method, error)
+}
+
+// RecordMsg records the Cosmos message executed by a precompile, when its
+// call is traced with the precompiles.
+func RecordMsg(ctx sdk.Context, msg sdk.Msg) {
+	if recorder := evmtypes.PrecompileRecorderFromContext(ctx); recorder != nil {
+		recorder.RecordMsg(msg)
+	}
+}
+
+// traceNativeAction records the start of the precompile call when it's traced
+// with the precompiles, and returns the function recording its end, which is a
+// no-op otherwise.
+func traceNativeAction(ctx sdk.Context, evm *vm.EVM, contract *vm.Contract) func(err error) {
+	recorder := evmtypes.PrecompileRecorderFromContext(ctx)
+	if recorder == nil {
+		return func(error) {}
+	}
+
+	call := &evmtypes.PrecompileCall{
+		Address: contract.Address(),
+		Input:   common.CopyBytes(contract.Input),
+	}
+	decodeMethod(evm, call)
+	recorder.Enter(call)
+	prevEventsLen := len(ctx.EventManager().Events())
+
+	return func(err error) {
+		defer recorder.Exit()
+
+		if err != nil {
+			call.Error = err.Error()
+			return
+		}
+		events := ctx.EventManager().Events()
+		if prevEventsLen > len(events) {
+			return
+		}
+		call.Events, call.BalanceChanges = traceEvents(events[prevEventsLen:])
+	}
+}
+
+// decodeMethod decodes the method and arguments of the precompile call with
+// the ABI of the precompile.
+func decodeMethod(evm *vm.EVM, call *evmtypes.PrecompileCall) {
+	precompile, ok := evm.Precompile(call.Address)
+	if !ok {
+		return
+	}
+	provider, ok := precompile.(abiMethodProvider)
+	if !ok || len(call.Input) < 4 {
+		return
+	}
+	method, err := provider.MethodById(call.Input[:4])
+	if err != nil {
+		return
+	}
+	call.Method = method.Name
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, call.Input[4:]); err == nil {
+		call.Args = args
+	}
+}
+
+// traceEvents returns the events and the bank balance changes of the coin
+// spent and received events, by account and denom.
+func traceEvents(events sdk.Events) ([]evmtypes.PrecompileEvent, []evmtypes.PrecompileBalanceChange) {
+	type balanceKey struct {
+		address common.Address
+		denom   string
+	}
+	deltas := make(map[balanceKey]*big.Int)
+	addDelta := func(event sdk.Event, addrKey string, sign int) {
+		addr, err := ParseAddress(event, addrKey)
+		if err != nil {
+			return
+		}
+		attr, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !ok {
+			return
+		}
+		coins, err := sdk.ParseCoinsNormalized(attr.Value)
+		if err != nil {
+			return
+		}
+		for _, coin := range coins {
+			key := balanceKey{address: common.BytesToAddress(addr), denom: coin.Denom}
+			if deltas[key] == nil {
+				deltas[key] = new(big.Int)
+			}
+			amount := coin.Amount.BigInt()
+			if sign < 0 {
+				amount.Neg(amount)
+			}
+			deltas[key].Add(deltas[key], amount)
+		}
+	}
+
+	traced := make([]evmtypes.PrecompileEvent, 0, len(events))
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		traced = append(traced, evmtypes.PrecompileEvent{Type: event.Type, Attributes: attrs})
+
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addDelta(event, banktypes.AttributeKeySpender, -1)
+		case banktypes.EventTypeCoinReceived:
+			addDelta(event, banktypes.AttributeKeyReceiver, 1)
+		}
+	}
+
+	changes := make([]evmtypes.PrecompileBalanceChange, 0, len(deltas))
+	for key, delta := range deltas {
+		if delta.Sign() == 0 {
+			continue
+		}
+		changes = append(changes, evmtypes.PrecompileBalanceChange{
+			Address: key.address,
+			Denom:   key.denom,
+			Delta:   delta.String(),
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Address != changes[j].Address {
+			return changes[i].Address.Cmp(changes[j].Address) < 0
+		}
+		return changes[i].Denom < changes[j].Denom
+	})
+	return traced, changes
+}
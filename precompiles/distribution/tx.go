@@ -102,6 +102,7 @@ func (p Precompile) SetWithdrawAddress(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.distributionMsgServer.SetWithdrawAddress(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -131,6 +132,7 @@ func (p *Precompile) WithdrawDelegatorReward(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	res, err := p.distributionMsgServer.WithdrawDelegatorReward(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -161,6 +163,7 @@ func (p *Precompile) WithdrawValidatorCommission(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), validatorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	res, err := p.distributionMsgServer.WithdrawValidatorCommission(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -191,6 +194,7 @@ func (p *Precompile) FundCommunityPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	_, err = p.distributionMsgServer.FundCommunityPool(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -222,6 +226,7 @@ func (p *Precompile) DepositValidatorRewardsPool(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	_, err = p.distributionMsgServer.DepositValidatorRewardsPool(ctx, msg)
 	if err != nil {
 		return nil, err
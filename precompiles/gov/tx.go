@@ -42,6 +42,7 @@ func (p *Precompile) SubmitProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	res, err := p.govMsgServer.SubmitProposal(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -72,6 +73,7 @@ func (p *Precompile) Deposit(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), depositorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.govMsgServer.Deposit(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -101,6 +103,7 @@ func (p *Precompile) CancelProposal(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), proposerHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.govMsgServer.CancelProposal(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -130,6 +133,7 @@ func (p Precompile) Vote(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.govMsgServer.Vote(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -159,6 +163,7 @@ func (p Precompile) VoteWeighted(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), voterHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.govMsgServer.VoteWeighted(ctx, msg); err != nil {
 		return nil, err
 	}
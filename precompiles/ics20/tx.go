@@ -218,6 +218,7 @@ func (p *Precompile) Transfer(
 	if err != nil {
 		return nil, err
 	}
+	cmn.RecordMsg(ctx, msg)
 
 	if err = EmitIBCTransferEvent(
 		ctx,
@@ -51,6 +51,7 @@ func (p Precompile) Unjail(
 		ValidatorAddr: valAddr,
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err := p.slashingMsgServer.Unjail(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -75,6 +75,7 @@ func (p Precompile) CreateValidator(
 	}
 
 	// Execute the transaction using the message server
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.stakingMsgServer.CreateValidator(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -127,6 +128,7 @@ func (p Precompile) EditValidator(
 	}
 
 	// Execute the transaction using the message server
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.stakingMsgServer.EditValidator(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -173,6 +175,7 @@ func (p *Precompile) Delegate(
 	}
 
 	// Execute the transaction using the message server
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.stakingMsgServer.Delegate(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -220,6 +223,7 @@ func (p Precompile) Undelegate(
 	}
 
 	// Execute the transaction using the message server
+	cmn.RecordMsg(ctx, msg)
 	res, err := p.stakingMsgServer.Undelegate(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -269,6 +273,7 @@ func (p Precompile) Redelegate(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	res, err := p.stakingMsgServer.BeginRedelegate(ctx, msg)
 	if err != nil {
 		return nil, err
@@ -317,6 +322,7 @@ func (p Precompile) CancelUnbondingDelegation(
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
 	}
 
+	cmn.RecordMsg(ctx, msg)
 	if _, err = p.stakingMsgServer.CancelUnbondingDelegation(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,89 @@
+package access
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwtSecretLength is the length of the HS256 secret, as the one of the
+	// geth authenticated rpc
+	jwtSecretLength = 32
+
+	// jwtExpiryTimeout is the max difference between the issued-at claim of
+	// a token and the current time
+	jwtExpiryTimeout = 60 * time.Second
+)
+
+// ObtainJWTSecret reads the hex encoded JWT secret of the file, or generates
+// and writes a new one if the file doesn't exist.
+func ObtainJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		secret := common.FromHex(strings.TrimSpace(string(data)))
+		if len(secret) != jwtSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret in %s, expected %d hex encoded bytes", path, jwtSecretLength)
+		}
+		return secret, nil
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read the JWT secret: %w", err)
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate the JWT secret: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write the JWT secret: %w", err)
+	}
+	return secret, nil
+}
+
+// NewJWTHandler wraps a handler to only serve the requests with a HS256 JWT
+// bearer token signed with the secret, whose issued-at claim is within 60
+// seconds of the current time, as the geth authenticated rpc.
+func NewJWTHandler(secret []byte, next http.Handler) http.Handler {
+	keyFunc := func(*jwt.Token) (any, error) {
+		return secret, nil
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		strToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || strToken == "" {
+			http.Error(w, "missing token", http.StatusUnauthorized)
+			return
+		}
+
+		// the claims are checked below to allow for a clock drift
+		var claims jwt.RegisteredClaims
+		token, err := jwt.ParseWithClaims(strToken, &claims, keyFunc,
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithoutClaimsValidation())
+
+		switch {
+		case err != nil:
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case !token.Valid:
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+		case !claims.VerifyExpiresAt(time.Now(), false):
+			http.Error(w, "token is expired", http.StatusUnauthorized)
+		case claims.IssuedAt == nil:
+			http.Error(w, "missing issued-at", http.StatusUnauthorized)
+		case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+			http.Error(w, "stale token", http.StatusUnauthorized)
+		case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+			http.Error(w, "future token", http.StatusUnauthorized)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
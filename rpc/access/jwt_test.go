@@ -0,0 +1,76 @@
+package access_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/access"
+)
+
+func TestObtainJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+
+	secret, err := access.ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	read, err := access.ObtainJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, read)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = access.ObtainJWTSecret(path)
+	require.Error(t, err)
+}
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, 32)
+	secret[0] = 1
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	h := access.NewJWTHandler(secret, next)
+
+	sign := func(method jwt.SigningMethod, key any, claims jwt.Claims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return "Bearer " + token
+	}
+	issuedAt := func(t time.Time) jwt.Claims {
+		return jwt.RegisteredClaims{IssuedAt: jwt.NewNumericDate(t)}
+	}
+
+	testCases := map[string]struct {
+		auth      string
+		expStatus int
+	}{
+		"valid token":     {auth: sign(jwt.SigningMethodHS256, secret, issuedAt(time.Now())), expStatus: http.StatusOK},
+		"missing token":   {expStatus: http.StatusUnauthorized},
+		"wrong secret":    {auth: sign(jwt.SigningMethodHS256, []byte("other"), issuedAt(time.Now())), expStatus: http.StatusUnauthorized},
+		"wrong algorithm": {auth: sign(jwt.SigningMethodHS512, secret, issuedAt(time.Now())), expStatus: http.StatusUnauthorized},
+		"missing iat":     {auth: sign(jwt.SigningMethodHS256, secret, jwt.RegisteredClaims{}), expStatus: http.StatusUnauthorized},
+		"stale token":     {auth: sign(jwt.SigningMethodHS256, secret, issuedAt(time.Now().Add(-2*time.Minute))), expStatus: http.StatusUnauthorized},
+		"future token":    {auth: sign(jwt.SigningMethodHS256, secret, issuedAt(time.Now().Add(2*time.Minute))), expStatus: http.StatusUnauthorized},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				req.Header.Set("Authorization", tc.auth)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tc.expStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Package access restricts the JSON-RPC methods served by a listener and
+// authenticates its clients.
+package access
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/evm/rpc/jsonrpc"
+)
+
+// MethodFilter allows the JSON-RPC methods matching the allow list, or all of
+// them if it's empty, except the ones matching the deny list. The patterns
+// are either a method name, as `eth_call`, or all the methods of a namespace,
+// as `debug_*`.
+type MethodFilter struct {
+	allow []string
+	deny  []string
+}
+
+// NewMethodFilter creates a method filter with the allow and deny patterns.
+func NewMethodFilter(allow, deny []string) (*MethodFilter, error) {
+	for _, pattern := range append(append([]string{}, allow...), deny...) {
+		if err := ValidateMethodPattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+	return &MethodFilter{allow: allow, deny: deny}, nil
+}
+
+// ValidateMethodPattern returns an error if the pattern is neither a method
+// name nor the wildcard of a namespace.
+func ValidateMethodPattern(pattern string) error {
+	namespace, name, ok := strings.Cut(pattern, "_")
+	if !ok || namespace == "" || name == "" || strings.Contains(namespace, "*") ||
+		(strings.Contains(name, "*") && name != "*") {
+		return fmt.Errorf("invalid method pattern %q, expected namespace_method or namespace_*", pattern)
+	}
+	return nil
+}
+
+// IsEmpty returns true if the filter allows all the methods.
+func (f *MethodFilter) IsEmpty() bool {
+	return len(f.allow) == 0 && len(f.deny) == 0
+}
+
+// Allowed returns true if the method is allowed by the filter.
+func (f *MethodFilter) Allowed(method string) bool {
+	if matchAny(f.deny, method) {
+		return false
+	}
+	return len(f.allow) == 0 || matchAny(f.allow, method)
+}
+
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+			continue
+		}
+		if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// Handler wraps a JSON-RPC handler to only serve the allowed methods, the
+// other ones are answered with a -32601 error as if they didn't exist.
+func (f *MethodFilter) Handler(next http.Handler) http.Handler {
+	if f.IsEmpty() {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, err := jsonrpc.ReadRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		allowed, denied := req.Split(f.Allowed)
+		if denied.Len() == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if !req.IsBatch() {
+			jsonrpc.WriteJSON(w, http.StatusOK, denied.ErrorResponse(MethodNotFound(denied.Methods()[0])))
+			return
+		}
+		responses := denied.ErrorResponses(MethodNotFound)
+		if allowed.Len() == 0 {
+			jsonrpc.WriteJSON(w, http.StatusOK, responses)
+			return
+		}
+
+		// serve the allowed requests of the batch and add the errors of the
+		// denied ones to their responses
+		body := allowed.Body()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		buf := jsonrpc.NewResponseBuffer()
+		next.ServeHTTP(buf, r)
+
+		var served []json.RawMessage
+		if buf.Status() != http.StatusOK || json.Unmarshal(buf.Bytes(), &served) != nil {
+			buf.CopyTo(w)
+			return
+		}
+		merged := make([]any, 0, len(served)+len(responses))
+		for _, res := range served {
+			merged = append(merged, res)
+		}
+		jsonrpc.WriteJSON(w, http.StatusOK, append(merged, responses...))
+	})
+}
+
+// MethodNotFound returns the code and message of the error of the requests
+// of a method that isn't allowed.
+func MethodNotFound(method string) (int, string) {
+	return jsonrpc.ErrCodeMethodNotFound, fmt.Sprintf("the method %s does not exist/is not available", method)
+}
@@ -0,0 +1,115 @@
+package access_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/access"
+)
+
+func TestMethodFilterAllowed(t *testing.T) {
+	testCases := map[string]struct {
+		allow, deny []string
+		expAllowed  []string
+		expDenied   []string
+	}{
+		"empty": {
+			expAllowed: []string{"eth_call", "debug_traceTransaction"},
+		},
+		"allow namespaces": {
+			allow:      []string{"eth_*", "net_version"},
+			expAllowed: []string{"eth_call", "net_version"},
+			expDenied:  []string{"net_listening", "debug_traceTransaction", "ethx_call"},
+		},
+		"deny over allow": {
+			allow:      []string{"debug_*"},
+			deny:       []string{"debug_setHead"},
+			expAllowed: []string{"debug_traceTransaction"},
+			expDenied:  []string{"debug_setHead", "eth_call"},
+		},
+		"deny only": {
+			deny:       []string{"personal_*"},
+			expAllowed: []string{"eth_call"},
+			expDenied:  []string{"personal_sign"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f, err := access.NewMethodFilter(tc.allow, tc.deny)
+			require.NoError(t, err)
+			for _, method := range tc.expAllowed {
+				require.True(t, f.Allowed(method), method)
+			}
+			for _, method := range tc.expDenied {
+				require.False(t, f.Allowed(method), method)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "eth", "_call", "eth_", "*_call", "eth_get*", "*"} {
+		_, err := access.NewMethodFilter(nil, []string{invalid})
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestMethodFilterHandler(t *testing.T) {
+	f, err := access.NewMethodFilter([]string{"eth_*"}, nil)
+	require.NoError(t, err)
+
+	// echo the methods of the served requests
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var reqs []struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"served"}`))
+			return
+		}
+		res := make([]string, 0, len(reqs))
+		for _, req := range reqs {
+			res = append(res, `{"jsonrpc":"2.0","id":`+string(req.ID)+`,"result":"`+req.Method+`"}`)
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(res, ",") + "]"))
+	})
+	h := f.Handler(next)
+
+	testCases := map[string]struct {
+		body   string
+		expRes string
+	}{
+		"allowed": {
+			body:   `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`,
+			expRes: `{"jsonrpc":"2.0","id":1,"result":"served"}`,
+		},
+		"denied": {
+			body:   `{"jsonrpc":"2.0","id":1,"method":"debug_setHead"}`,
+			expRes: `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method debug_setHead does not exist/is not available"}}`,
+		},
+		"batch with denied requests": {
+			body: `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"personal_sign"},{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber"}]`,
+			expRes: `[{"jsonrpc":"2.0","id":1,"result":"eth_chainId"},{"jsonrpc":"2.0","id":3,"result":"eth_blockNumber"},` +
+				`{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"the method personal_sign does not exist/is not available"}}]`,
+		},
+		"batch of denied requests": {
+			body:   `[{"jsonrpc":"2.0","id":1,"method":"personal_sign"}]`,
+			expRes: `[{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method personal_sign does not exist/is not available"}}]`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body)))
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.JSONEq(t, tc.expRes, rec.Body.String())
+		})
+	}
+}
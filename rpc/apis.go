@@ -6,12 +6,15 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/cosmos"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/ots"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/txpool"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/web3"
 	"github.com/cosmos/evm/rpc/stream"
@@ -35,6 +38,8 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
 
 	apiVersion = "1.0"
 )
@@ -68,7 +73,7 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   eth.NewPublicAPI(ctx.Logger, backend),
+					Service:   eth.NewPublicAPI(ctx.Logger, backend, stream),
 					Public:    true,
 				},
 				{
@@ -164,6 +169,51 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx.Logger, backend),
+					Public:    true,
+				},
+			}
+		},
+		CosmosNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewAPI(ctx.Logger, backend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			backend backend.BackendI,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx.Logger, backend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
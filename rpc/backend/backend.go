@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -19,6 +20,7 @@ import (
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
+	"github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
@@ -54,9 +56,11 @@ type EVMBackend interface {
 	ListAccounts() ([]common.Address, error)
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
-	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
-	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
-	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
+	RPCGasCap() uint64                  // global gas cap for eth_call over rpc: DoS protection
+	RPCEVMTimeout() time.Duration       // global timeout for eth_call over rpc: DoS protection
+	RPCTxSyncTimeout() time.Duration    // default wait for the inclusion in eth_sendRawTransactionSync
+	RPCTxSyncMaxTimeout() time.Duration // max wait for the inclusion in eth_sendRawTransactionSync
+	RPCTxFeeCap() float64               // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() *big.Int
 
 	// Sign Tx
@@ -112,19 +116,26 @@ type EVMBackend interface {
 	GetTransactionLogs(ctx context.Context, hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(ctx context.Context, hash common.Hash, idx hexutil.Uint) (*types.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(ctx context.Context, blockNum types.BlockNumber, idx hexutil.Uint) (*types.RPCTransaction, error)
+	GetTransactionBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (*types.RPCTransaction, error)
+	GetTransactionsByAddress(ctx context.Context, address common.Address, args types.AddressTransactionsArgs) (*types.AddressTransactionsResult, error)
+	GetAssetTransfers(ctx context.Context, args types.AssetTransfersArgs) (*types.AssetTransfersResult, error)
 	CreateAccessList(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, overrides *json.RawMessage) (*types.AccessListResult, error)
 
 	// Send Transaction
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionConditional(ctx context.Context, data hexutil.Bytes, conditions mempool.TxConditions) (common.Hash, error)
+	SendBundle(ctx context.Context, args types.SendBundleArgs) (*types.SendBundleResult, error)
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(ctx context.Context, opts types.SimOpts, blockNrOrHash *types.BlockNumberOrHash) ([]*types.SimBlockResult, error)
 	GasPrice(ctx context.Context) (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	LogIndexedBlocks(ctx context.Context, addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
@@ -132,11 +143,41 @@ type EVMBackend interface {
 	ContentFrom(ctx context.Context, address common.Address) (map[string]map[string]*types.RPCTransaction, error)
 	Inspect(ctx context.Context) (map[string]map[string]map[string]string, error)
 	Status(ctx context.Context) (map[string]hexutil.Uint, error)
+	TxStatus(ctx context.Context, hash common.Hash) (*types.TxStatusResult, error)
+	GetPendingTransactions(ctx context.Context, hashes []common.Hash) ([]*types.RPCTransaction, error)
 
 	// Tracing
 	TraceTransaction(ctx context.Context, hash common.Hash, config *types.TraceConfig) (interface{}, error)
 	TraceBlock(ctx context.Context, height types.BlockNumber, config *types.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
 	TraceCall(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash types.BlockNumberOrHash, config *types.TraceConfig) (interface{}, error)
+	TraceBlockFlat(ctx context.Context, blockNum types.BlockNumber) ([]*types.ParityTrace, error)
+	TraceTransactionFlat(ctx context.Context, hash common.Hash) ([]*types.ParityTrace, error)
+	TraceReplayBlockTransactions(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, traceTypes []string) ([]*types.TraceReplayResult, error)
+	TraceFilter(ctx context.Context, args types.TraceFilterArgs) ([]*types.ParityTrace, error)
+
+	// State dumping
+	StorageRangeAt(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (*types.StorageRangeResult, error)
+	AccountRange(ctx context.Context, blockNrOrHash types.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage bool) (*state.Dump, error)
+	DumpBlock(ctx context.Context, blockNum types.BlockNumber) (*state.Dump, error)
+
+	// Otterscan
+	GetInternalOperations(ctx context.Context, hash common.Hash) ([]*types.OtsInternalOperation, error)
+	TraceTransactionEntries(ctx context.Context, hash common.Hash) ([]*types.OtsTraceEntry, error)
+	GetTransactionError(ctx context.Context, hash common.Hash) (hexutil.Bytes, error)
+	SearchTransactions(ctx context.Context, address common.Address, blockNum, pageSize uint64, before bool) (*types.OtsSearchResult, error)
+	GetContractCreator(ctx context.Context, address common.Address) (*types.OtsContractCreator, error)
+	GetTransactionHashBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (*common.Hash, error)
+	GetBlockDetails(ctx context.Context, blockNum types.BlockNumber) (*types.OtsBlockDetails, error)
+	GetBlockDetailsByHash(ctx context.Context, hash common.Hash) (*types.OtsBlockDetails, error)
+	GetBlockTransactions(ctx context.Context, blockNum types.BlockNumber, pageNumber, pageSize uint64) (*types.OtsBlockTransactions, error)
+
+	// Cosmos
+	GetCosmosTxHash(ctx context.Context, hash common.Hash) (types.CosmosTxHash, error)
+	GetEthTxHashes(ctx context.Context, hash types.CosmosTxHash) ([]common.Hash, error)
+	GetCosmosTxEvents(ctx context.Context, hash types.CosmosTxHash) (*types.CosmosTxEvents, error)
+	GetCosmosBlockEvents(ctx context.Context, blockNum types.BlockNumber) (*types.CosmosBlockEvents, error)
+	GetCosmosBalances(ctx context.Context, address common.Address, blockNrOrHash types.BlockNumberOrHash) ([]*types.CosmosBalance, error)
+	GetCosmosBlockMessages(ctx context.Context, blockNum types.BlockNumber, address common.Address) ([]*types.CosmosMessage, error)
 }
 
 // TrackingMempool is a set of methods that a mempool may implement in order to
@@ -149,6 +190,48 @@ type TrackingMempool interface {
 	TrackTx(hash common.Hash) error
 }
 
+// LocalMempool is a set of methods that a mempool may implement in order to
+// tell apart the evm transactions submitted over RPC, e.g. to only journal
+// those.
+type LocalMempool interface {
+	// MarkLocal marks a tx as submitted over RPC. This is called before the tx
+	// is inserted or broadcast, whether the app-side mempool is used or not.
+	MarkLocal(hash common.Hash)
+	// UnmarkLocal drops the mark of a tx that failed to be submitted.
+	UnmarkLocal(hash common.Hash)
+}
+
+// ConditionalMempool is a set of methods that a mempool may implement in order
+// to accept evm transactions with preconditions.
+type ConditionalMempool interface {
+	// InsertConditional inserts a tx that is only kept in the mempool while
+	// its conditions hold.
+	InsertConditional(ctx context.Context, tx sdk.Tx, conditions *mempool.TxConditions) error
+}
+
+// TxStatusMempool is a set of methods that a mempool may implement in order
+// to report the status of evm transactions in their lifecycle.
+type TxStatusMempool interface {
+	// TxStatus returns the status of the tx, including why it exited the
+	// mempool if it did recently.
+	TxStatus(hash common.Hash) mempool.TxStatus
+}
+
+// EvictionMempool is a set of methods that a mempool may implement in order to
+// report the evm transactions it evicted.
+type EvictionMempool interface {
+	// EvictedTxs returns the txs that recently exited the mempool without
+	// being included in a block, oldest first.
+	EvictedTxs() []mempool.EvictedTx
+}
+
+// BundleMempool is a set of methods that a mempool may implement in order to
+// accept bundles of evm transactions that are included atomically.
+type BundleMempool interface {
+	// InsertBundle inserts a bundle of txs and returns its hash.
+	InsertBundle(ctx context.Context, bundle *mempool.Bundle) (common.Hash, error)
+}
+
 var (
 	_ BackendI = (*Backend)(nil)
 
@@ -197,8 +280,14 @@ type Backend struct {
 	AllowUnprotectedTxs bool
 	UseAppMempool       bool
 	Indexer             servertypes.EVMTxIndexer
+	TraceIndexer        servertypes.TraceIndexer
 	ProcessBlocker      ProcessBlocker
 	Mempool             Mempool
+
+	// cache keeps the responses of the committed blocks, nil if disabled
+	cache *responseCache
+	// gasPriceOracle suggests the tips from the recent blocks
+	gasPriceOracle *gasPriceOracle
 }
 
 // Opt is a function type that configures the backend.
@@ -215,6 +304,11 @@ func WithAppMempool(value bool) Opt {
 	return func(b *Backend) { b.UseAppMempool = value }
 }
 
+// WithTraceIndexer sets the trace index used to serve trace_filter.
+func WithTraceIndexer(indexer servertypes.TraceIndexer) Opt {
+	return func(b *Backend) { b.TraceIndexer = indexer }
+}
+
 // WithLogger sets the logger for the backend.
 func WithLogger(logger log.Logger) Opt {
 	return func(b *Backend) { b.Logger = logger.With("module", "backend") }
@@ -249,6 +343,8 @@ func NewBackend(
 		Indexer:             indexer,
 		Mempool:             mempool,
 		Logger:              log.NewNopLogger(),
+		cache:               newResponseCache(appConf.JSONRPC.ResponseCacheSize * 1024 * 1024),
+		gasPriceOracle:      newGasPriceOracle(appConf.JSONRPC),
 	}
 
 	b.ProcessBlocker = b.ProcessBlock
@@ -277,3 +373,16 @@ func (b *Backend) TrackTxIfSupported(txHash common.Hash) {
 		b.Logger.Error("error tracking inserted inserted into mempool", "hash", txHash, "err", err)
 	}
 }
+
+// MarkLocalIfSupported calls MarkLocal on the backends mempool if it is a
+// supported method, and returns a function unmarking the tx if its
+// submission fails.
+func (b *Backend) MarkLocalIfSupported(txHash common.Hash) (unmark func()) {
+	lm, ok := b.Mempool.(LocalMempool)
+	if !ok {
+		return func() {}
+	}
+
+	lm.MarkLocal(txHash)
+	return func() { lm.UnmarkLocal(txHash) }
+}
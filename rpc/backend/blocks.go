@@ -57,6 +57,12 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 	ctx, span := tracer.Start(ctx, "GetBlockByNumber", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64()), attribute.Bool("fullTx", fullTx)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if blockNum > 0 {
+		if cached := b.cache.getBlock(blockNum.Int64(), fullTx); cached != nil {
+			return cached, nil
+		}
+	}
+
 	resBlock, err := b.CometBlockByNumber(ctx, blockNum)
 	if err != nil {
 		return nil, nil
@@ -79,6 +85,7 @@ func (b *Backend) GetBlockByNumber(ctx context.Context, blockNum types.BlockNumb
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, common.BytesToHash(resBlock.Block.Hash()), fullTx, result)
 	return result, nil
 }
 
@@ -88,6 +95,12 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 	ctx, span := tracer.Start(ctx, "GetBlockByHash", trace.WithAttributes(attribute.String("hash", hash.Hex()), attribute.Bool("fullTx", fullTx)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height, ok := b.cache.blockHeight(hash); ok {
+		if cached := b.cache.getBlock(height, fullTx); cached != nil {
+			return cached, nil
+		}
+	}
+
 	resBlock, err := b.CometBlockByHash(ctx, hash)
 	if err != nil {
 		return nil, err
@@ -110,6 +123,7 @@ func (b *Backend) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx b
 		return nil, err
 	}
 
+	b.cache.addBlock(resBlock.Block.Height, hash, fullTx, result)
 	return result, nil
 }
 
@@ -202,6 +216,12 @@ func (b *Backend) GetBlockReceipts(
 	ctx, span := tracer.Start(ctx, "GetBlockReceipts", trace.WithAttributes(attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash))))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height, ok := b.cache.resolveHeight(blockNrOrHash); ok {
+		if cached := b.cache.getBlockReceipts(height); cached != nil {
+			return cached, nil
+		}
+	}
+
 	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get block number from hash: %w", err)
@@ -247,5 +267,8 @@ func (b *Backend) GetBlockReceipts(
 			return nil, fmt.Errorf("failed to marshal receipt")
 		}
 	}
+
+	b.cache.addBlockHeight(common.BytesToHash(resBlock.Block.Hash()), resBlock.Block.Height)
+	b.cache.addBlockReceipts(resBlock.Block.Height, result)
 	return result, nil
 }
@@ -0,0 +1,298 @@
+package backend
+
+import (
+	"container/list"
+	"maps"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// cacheKind is the kind of the responses of a cache entry.
+type cacheKind uint8
+
+const (
+	cacheKindBlockHeight cacheKind = iota
+	cacheKindBlock
+	cacheKindFullBlock
+	cacheKindBlockReceipts
+	cacheKindReceipt
+	cacheKindLogs
+	numCacheKinds
+)
+
+const (
+	// cacheEntryOverhead is the approximate size of the bookkeeping of an
+	// entry
+	cacheEntryOverhead = 128
+	// mapEntryOverhead is the approximate size of a map entry, besides its
+	// key and value
+	mapEntryOverhead = 16
+	// rpcTransactionSize is the approximate size of a RPCTransaction,
+	// besides its input and lists
+	rpcTransactionSize = 600
+	// logSize is the approximate size of a log, besides its data and topics
+	logSize = 200
+)
+
+var cacheKindNames = [numCacheKinds]string{"blockheights", "blocks", "fullblocks", "blockreceipts", "receipts", "logs"}
+
+var (
+	cacheHitCounters  [numCacheKinds]*metrics.Counter
+	cacheMissCounters [numCacheKinds]*metrics.Counter
+	cacheSizeGauge    = metrics.NewRegisteredGauge("rpc/cache/size", nil)
+	cacheEvictMeter   = metrics.NewRegisteredMeter("rpc/cache/evicted", nil)
+)
+
+func init() {
+	for kind, name := range cacheKindNames {
+		cacheHitCounters[kind] = metrics.NewRegisteredCounter("rpc/cache/"+name+"/hit", nil)
+		cacheMissCounters[kind] = metrics.NewRegisteredCounter("rpc/cache/"+name+"/miss", nil)
+	}
+}
+
+type cacheKey struct {
+	kind   cacheKind
+	height int64
+	hash   common.Hash
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value any
+	size  int
+}
+
+// responseCache caches the responses built from the committed blocks, which
+// never change with the instant finality of CometBFT. It's bounded by the
+// approximate size of its entries, the least recently used ones are evicted
+// first. A nil cache caches nothing.
+//
+// The cached maps are copied when returned, since the callers may add or
+// replace some of their fields.
+type responseCache struct {
+	mu      sync.Mutex
+	maxSize int
+	size    int
+	entries map[cacheKey]*list.Element
+	lru     *list.List
+}
+
+// newResponseCache creates a response cache of the given size in bytes, or
+// nil if the size isn't positive.
+func newResponseCache(maxSize int) *responseCache {
+	if maxSize <= 0 {
+		return nil
+	}
+	return &responseCache{
+		maxSize: maxSize,
+		entries: make(map[cacheKey]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *responseCache) get(key cacheKey) (any, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		cacheMissCounters[key.kind].Inc(1)
+		return nil, false
+	}
+	cacheHitCounters[key.kind].Inc(1)
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).value, true
+}
+
+func (c *responseCache) add(key cacheKey, value any, size int) {
+	if c == nil {
+		return
+	}
+	size += cacheEntryOverhead
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.size -= elem.Value.(*cacheEntry).size
+		c.lru.Remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, value: value, size: size})
+	c.size += size
+
+	for c.size > c.maxSize {
+		elem := c.lru.Back()
+		entry := elem.Value.(*cacheEntry)
+		c.lru.Remove(elem)
+		delete(c.entries, entry.key)
+		c.size -= entry.size
+		cacheEvictMeter.Mark(1)
+	}
+	cacheSizeGauge.Update(int64(c.size))
+}
+
+// blockHeight returns the height of the cached block of the hash.
+func (c *responseCache) blockHeight(hash common.Hash) (int64, bool) {
+	value, ok := c.get(cacheKey{kind: cacheKindBlockHeight, hash: hash})
+	if !ok {
+		return 0, false
+	}
+	return value.(int64), true
+}
+
+// resolveHeight returns the height of the block number or hash, if it's a
+// specific block whose height is known.
+func (c *responseCache) resolveHeight(blockNrOrHash rpctypes.BlockNumberOrHash) (int64, bool) {
+	if blockNrOrHash.BlockHash != nil {
+		return c.blockHeight(*blockNrOrHash.BlockHash)
+	}
+	if blockNrOrHash.BlockNumber != nil && *blockNrOrHash.BlockNumber > 0 {
+		return int64(*blockNrOrHash.BlockNumber), true
+	}
+	return 0, false
+}
+
+// addBlockHeight caches the height of the block of the hash.
+func (c *responseCache) addBlockHeight(hash common.Hash, height int64) {
+	c.add(cacheKey{kind: cacheKindBlockHeight, hash: hash}, height, 0)
+}
+
+func blockCacheKind(fullTx bool) cacheKind {
+	if fullTx {
+		return cacheKindFullBlock
+	}
+	return cacheKindBlock
+}
+
+// getBlock returns a copy of the cached rpc block of the height.
+func (c *responseCache) getBlock(height int64, fullTx bool) map[string]interface{} {
+	value, ok := c.get(cacheKey{kind: blockCacheKind(fullTx), height: height})
+	if !ok {
+		return nil
+	}
+	return maps.Clone(value.(map[string]interface{}))
+}
+
+// addBlock caches the rpc block of the height, with its hash.
+func (c *responseCache) addBlock(height int64, hash common.Hash, fullTx bool, block map[string]interface{}) {
+	c.addBlockHeight(hash, height)
+	c.add(cacheKey{kind: blockCacheKind(fullTx), height: height}, maps.Clone(block), estimateSize(block))
+}
+
+// getBlockReceipts returns a copy of the cached rpc receipts of the block of
+// the height.
+func (c *responseCache) getBlockReceipts(height int64) []map[string]interface{} {
+	value, ok := c.get(cacheKey{kind: cacheKindBlockReceipts, height: height})
+	if !ok {
+		return nil
+	}
+	return cloneMaps(value.([]map[string]interface{}))
+}
+
+// addBlockReceipts caches the rpc receipts of the block of the height.
+func (c *responseCache) addBlockReceipts(height int64, receipts []map[string]interface{}) {
+	c.add(cacheKey{kind: cacheKindBlockReceipts, height: height}, cloneMaps(receipts), estimateSize(receipts))
+}
+
+// getReceipt returns a copy of the cached rpc receipt of the tx hash.
+func (c *responseCache) getReceipt(txHash common.Hash) map[string]interface{} {
+	value, ok := c.get(cacheKey{kind: cacheKindReceipt, hash: txHash})
+	if !ok {
+		return nil
+	}
+	return maps.Clone(value.(map[string]interface{}))
+}
+
+// addReceipt caches the rpc receipt of the tx hash.
+func (c *responseCache) addReceipt(txHash common.Hash, receipt map[string]interface{}) {
+	c.add(cacheKey{kind: cacheKindReceipt, hash: txHash}, maps.Clone(receipt), estimateSize(receipt))
+}
+
+// getLogs returns the cached logs of the block of the height.
+func (c *responseCache) getLogs(height int64) ([][]*ethtypes.Log, bool) {
+	value, ok := c.get(cacheKey{kind: cacheKindLogs, height: height})
+	if !ok {
+		return nil, false
+	}
+	return append([][]*ethtypes.Log(nil), value.([][]*ethtypes.Log)...), true
+}
+
+// addLogs caches the logs of the block of the height.
+func (c *responseCache) addLogs(height int64, logs [][]*ethtypes.Log) {
+	c.add(cacheKey{kind: cacheKindLogs, height: height}, append([][]*ethtypes.Log(nil), logs...), estimateSize(logs))
+}
+
+func cloneMaps(ms []map[string]interface{}) []map[string]interface{} {
+	cloned := make([]map[string]interface{}, len(ms))
+	for i, m := range ms {
+		cloned[i] = maps.Clone(m)
+	}
+	return cloned
+}
+
+// estimateSize returns the approximate memory size of a response.
+func estimateSize(v any) int {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		size := 0
+		for key, value := range v {
+			size += mapEntryOverhead + len(key) + estimateSize(value)
+		}
+		return size
+	case []map[string]interface{}:
+		size := 0
+		for _, m := range v {
+			size += estimateSize(m)
+		}
+		return size
+	case []interface{}:
+		size := 0
+		for _, e := range v {
+			size += 16 + estimateSize(e)
+		}
+		return size
+	case *rpctypes.RPCTransaction:
+		size := rpcTransactionSize + len(v.Input) + len(v.BlobVersionedHashes)*common.HashLength
+		if v.Accesses != nil {
+			for _, tuple := range *v.Accesses {
+				size += common.AddressLength + len(tuple.StorageKeys)*common.HashLength
+			}
+		}
+		return size
+	case [][]*ethtypes.Log:
+		size := 0
+		for _, logs := range v {
+			size += 24 + estimateSize(logs)
+		}
+		return size
+	case []*ethtypes.Log:
+		size := 0
+		for _, log := range v {
+			size += logSize + len(log.Data) + len(log.Topics)*common.HashLength
+		}
+		return size
+	case ethtypes.Bloom:
+		return ethtypes.BloomByteLength
+	case hexutil.Bytes:
+		return len(v)
+	case []byte:
+		return len(v)
+	case string:
+		return len(v)
+	default:
+		return 32
+	}
+}
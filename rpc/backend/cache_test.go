@@ -0,0 +1,65 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+func TestResponseCache(t *testing.T) {
+	var disabled *responseCache
+	require.Nil(t, newResponseCache(0))
+	disabled.addBlock(1, common.Hash{1}, false, map[string]interface{}{"number": "0x1"})
+	require.Nil(t, disabled.getBlock(1, false))
+
+	block := map[string]interface{}{"number": "0x1", "transactions": []interface{}{common.Hash{2}}}
+	entrySize := estimateSize(block) + cacheEntryOverhead
+	heightSize := estimateSize(int64(0)) + cacheEntryOverhead
+	// room for 2 blocks with their hashes
+	c := newResponseCache(2 * (entrySize + heightSize))
+
+	c.addBlock(1, common.Hash{1}, false, block)
+	cached := c.getBlock(1, false)
+	require.Equal(t, block, cached)
+	require.Nil(t, c.getBlock(1, true), "the full block is cached apart")
+
+	// the returned blocks are copies
+	cached["transactions"] = []interface{}{}
+	block["number"] = "0x2"
+	require.Equal(t, "0x1", c.getBlock(1, false)["number"])
+	require.Len(t, c.getBlock(1, false)["transactions"], 1)
+
+	height, ok := c.blockHeight(common.Hash{1})
+	require.True(t, ok)
+	require.Equal(t, int64(1), height)
+	height, ok = c.resolveHeight(rpctypes.BlockNumberOrHash{BlockHash: &common.Hash{1}})
+	require.True(t, ok)
+	require.Equal(t, int64(1), height)
+	latest := rpctypes.EthLatestBlockNumber
+	_, ok = c.resolveHeight(rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+	require.False(t, ok)
+
+	// the least recently used block is evicted
+	c.addBlock(2, common.Hash{2}, false, map[string]interface{}{"number": "0x1", "transactions": []interface{}{common.Hash{3}}})
+	require.NotNil(t, c.getBlock(1, false))
+	c.addBlock(3, common.Hash{3}, false, map[string]interface{}{"number": "0x1", "transactions": []interface{}{common.Hash{4}}})
+	require.NotNil(t, c.getBlock(1, false))
+	require.Nil(t, c.getBlock(2, false))
+	require.NotNil(t, c.getBlock(3, false))
+	require.LessOrEqual(t, c.size, c.maxSize)
+
+	// the entries larger than the cache aren't added
+	c.addLogs(4, [][]*ethtypes.Log{{{Data: make([]byte, c.maxSize)}}})
+	_, ok = c.getLogs(4)
+	require.False(t, ok)
+
+	receipts := []map[string]interface{}{{"status": "0x1"}}
+	c.addBlockReceipts(3, receipts)
+	cachedReceipts := c.getBlockReceipts(3)
+	cachedReceipts[0]["logs"] = nil
+	require.Equal(t, receipts, c.getBlockReceipts(3))
+}
@@ -110,45 +110,13 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	ctx, span := tracer.Start(ctx, "SendRawTransaction")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.Logger.Error("transaction decoding failed", "error", err.Error())
+	tx, cosmosTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
 	span.SetAttributes(attribute.String("tx_hash", tx.Hash().Hex()))
-
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() {
-		if !tx.Protected() {
-			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-		}
-		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
-			return common.Hash{}, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
-		}
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
 	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
-	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
-		b.Logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to convert ethereum transaction: %w", err)
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.Logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to validate transaction: %w", err)
-	}
-
-	baseDenom := evmtypes.GetEVMCoinDenom()
-
-	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
-	if err != nil {
-		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to build cosmos tx: %w", err)
-	}
 
 	// Encode transaction by default Tx encoder
 	txBytes, err := b.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
@@ -158,6 +126,7 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	}
 
 	txHash := tx.Hash()
+	unmarkLocal := b.MarkLocalIfSupported(txHash)
 
 	// publish tx directly to app-side mempool, avoiding broadcasting to
 	// consensus layer.
@@ -169,6 +138,7 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 		// return to clients.
 		err := b.Mempool.Insert(ctx, cosmosTx)
 		if err != nil {
+			unmarkLocal()
 			// no need for special error handling like in the broadcast tx case
 			// since this is coming directly from the evm mempool insert.
 			return common.Hash{}, err
@@ -186,12 +156,129 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	}
 
 	if err != nil {
+		unmarkLocal()
 		return b.handleSendTxError(ctx, tx, ethSigner, err)
 	}
 
 	return txHash, nil
 }
 
+// SendRawTransactionConditional sends a raw Ethereum transaction that is only
+// kept in the mempool while its conditions hold. It requires the app-side
+// mempool, since the conditions are enforced on its rechecks.
+func (b *Backend) SendRawTransactionConditional(ctx context.Context, data hexutil.Bytes, conditions mempool.TxConditions) (result common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "SendRawTransactionConditional")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	cm, ok := b.Mempool.(ConditionalMempool)
+	if !b.UseAppMempool || !ok {
+		return common.Hash{}, errors.New("conditional transactions require the app-side mempool")
+	}
+
+	tx, cosmosTx, err := b.decodeRawTransaction(data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	txHash := tx.Hash()
+	span.SetAttributes(attribute.String("tx_hash", txHash.Hex()))
+
+	unmarkLocal := b.MarkLocalIfSupported(txHash)
+	if err := cm.InsertConditional(ctx, cosmosTx, &conditions); err != nil {
+		unmarkLocal()
+		return common.Hash{}, err
+	}
+
+	b.TrackTxIfSupported(txHash)
+	return txHash, nil
+}
+
+// SendBundle sends a bundle of raw Ethereum transactions that are included
+// contiguously in one of the blocks it targets, or not at all. It requires the
+// app-side mempool, and the bundles are only included in the blocks proposed
+// by this node.
+func (b *Backend) SendBundle(ctx context.Context, args rpctypes.SendBundleArgs) (result *rpctypes.SendBundleResult, err error) {
+	ctx, span := tracer.Start(ctx, "SendBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	bm, ok := b.Mempool.(BundleMempool)
+	if !b.UseAppMempool || !ok {
+		return nil, errors.New("bundles require the app-side mempool")
+	}
+
+	bundle := &mempool.Bundle{
+		Txs:              make([]*ethtypes.Transaction, len(args.Txs)),
+		BlockNumber:      uint64(args.BlockNumber),
+		RevertProtection: args.RevertProtection,
+	}
+	if args.MaxBlockNumber != nil {
+		bundle.MaxBlockNumber = uint64(*args.MaxBlockNumber)
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = uint64(*args.MinTimestamp)
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = uint64(*args.MaxTimestamp)
+	}
+	for i, data := range args.Txs {
+		tx, _, err := b.decodeRawTransaction(data)
+		if err != nil {
+			return nil, fmt.Errorf("bundle tx %d: %w", i, err)
+		}
+		bundle.Txs[i] = tx
+	}
+
+	hash, err := bm.InsertBundle(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("bundle_hash", hash.Hex()))
+	return &rpctypes.SendBundleResult{BundleHash: hash}, nil
+}
+
+// decodeRawTransaction decodes and validates a raw Ethereum transaction, and
+// wraps it in a cosmos tx.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*ethtypes.Transaction, sdk.Tx, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.Logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() {
+		if !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return nil, nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+			return nil, nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+		}
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
+	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
+		b.Logger.Error("transaction converting failed", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to convert ethereum transaction: %w", err)
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to validate transaction: %w", err)
+	}
+
+	baseDenom := evmtypes.GetEVMCoinDenom()
+
+	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
+	if err != nil {
+		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to build cosmos tx: %w", err)
+	}
+	return tx, cosmosTx, nil
+}
+
 // handleSendTxError temporary workaround for check-tx backward compatibility
 func (b *Backend) handleSendTxError(ctx context.Context, tx *ethtypes.Transaction, signer ethtypes.Signer, err error) (common.Hash, error) {
 	txHash := tx.Hash()
@@ -490,6 +577,72 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// SimulateV1 executes a series of simulated blocks on top of the given block,
+// as described by the eth_simulateV1 specification.
+func (b *Backend) SimulateV1(
+	ctx context.Context,
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (result []*rpctypes.SimBlockResult, err error) {
+	ctx, span := tracer.Start(ctx, "SimulateV1", trace.WithAttributes(attribute.Int("blocks", len(opts.BlockStateCalls))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	blockNr := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		blockNr, err = b.BlockNumberFromComet(ctx, *blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CometHeaderByNumber(ctx, blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockHash:       common.BytesToHash(header.Header.Hash()).Hex(),
+	}
+
+	ctx = rpctypes.ContextWithHeight(ctx, header.Header.Height)
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.QueryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, &rpctypes.SimError{Code: int(res.ErrorCode), Message: res.Error}
+	}
+
+	if err := json.Unmarshal(res.Data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GasPrice returns the current gas price based on Cosmos EVM' gas price oracle.
 func (b *Backend) GasPrice(ctx context.Context) (result *hexutil.Big, err error) {
 	ctx, span := tracer.Start(ctx, "GasPrice")
@@ -0,0 +1,307 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetCosmosTxHash returns the hash of the Cosmos tx that contains the given
+// eth tx.
+func (b *Backend) GetCosmosTxHash(ctx context.Context, hash common.Hash) (result rpctypes.CosmosTxHash, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosTxHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.GetTxByEthHash(ctx, hash)
+	if errors.Is(err, servertypes.ErrTxNotFound) {
+		b.Logger.Debug("tx not found", "hash", hash)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", res.Height)
+	}
+	if int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("tx index %d out of bound of block %d", res.TxIndex, res.Height)
+	}
+
+	return block.Block.Txs[res.TxIndex].Hash(), nil
+}
+
+// GetEthTxHashes returns the hashes of the eth txs contained in the given
+// Cosmos tx.
+func (b *Backend) GetEthTxHashes(ctx context.Context, hash rpctypes.CosmosTxHash) (result []common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "GetEthTxHashes", trace.WithAttributes(attribute.String("hash", hexutil.Encode(hash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.RPCClient.Tx(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.ClientCtx.TxConfig.TxDecoder()(res.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	result = []common.Hash{}
+	for _, msg := range tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			result = append(result, ethMsg.Hash())
+		}
+	}
+	return result, nil
+}
+
+// GetCosmosTxEvents returns the result and the ABCI events of the given Cosmos
+// tx.
+func (b *Backend) GetCosmosTxEvents(ctx context.Context, hash rpctypes.CosmosTxHash) (result *rpctypes.CosmosTxEvents, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosTxEvents", trace.WithAttributes(attribute.String("hash", hexutil.Encode(hash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	res, err := b.RPCClient.Tx(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return newCosmosTxEvents(res.Hash, res.Height, res.Index, &res.TxResult), nil
+}
+
+// GetCosmosBlockEvents returns the ABCI events emitted in the given block.
+func (b *Backend) GetCosmosBlockEvents(ctx context.Context, blockNum rpctypes.BlockNumber) (result *rpctypes.CosmosBlockEvents, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosBlockEvents", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, blockRes, err := b.cometBlockAndResults(ctx, blockNum)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	result = &rpctypes.CosmosBlockEvents{
+		Height:              hexutil.Uint64(block.Block.Height), //nolint:gosec // G115 // block height is never negative
+		Txs:                 make([]*rpctypes.CosmosTxEvents, 0, len(block.Block.Txs)),
+		FinalizeBlockEvents: blockRes.FinalizeBlockEvents,
+	}
+	if result.FinalizeBlockEvents == nil {
+		result.FinalizeBlockEvents = []abci.Event{}
+	}
+	for i, tx := range block.Block.Txs {
+		result.Txs = append(result.Txs, newCosmosTxEvents(tx.Hash(), block.Block.Height, uint32(i), blockRes.TxsResults[i])) //nolint:gosec // G115 // tx index won't exceed uint32
+	}
+	return result, nil
+}
+
+// GetCosmosBalances returns the bank balances of all the denoms held by the
+// given address, annotated with the ERC20 address of the registered token
+// pairs.
+func (b *Backend) GetCosmosBalances(ctx context.Context, address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (result []*rpctypes.CosmosBalance, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosBalances", trace.WithAttributes(attribute.String("address", address.String()), attribute.String("blockNorHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	ctx = rpctypes.ContextWithHeight(ctx, blockNum.Int64())
+
+	var balances sdk.Coins
+	req := &banktypes.QueryAllBalancesRequest{Address: sdk.AccAddress(address.Bytes()).String(), Pagination: &query.PageRequest{}}
+	for {
+		res, err := b.QueryClient.Bank.AllBalances(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+
+	erc20Addresses, err := b.erc20AddressesByDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = make([]*rpctypes.CosmosBalance, 0, len(balances))
+	for _, coin := range balances {
+		balance := &rpctypes.CosmosBalance{
+			Denom:  coin.Denom,
+			Amount: (*hexutil.Big)(coin.Amount.BigInt()),
+		}
+		if erc20Address, ok := erc20Addresses[coin.Denom]; ok {
+			balance.Erc20Address = &erc20Address
+		}
+		result = append(result, balance)
+	}
+	return result, nil
+}
+
+// GetCosmosBlockMessages returns the messages of the Cosmos txs in the given
+// block that touched the given address, either as a signer, as a field of the
+// message or as the sender or recipient of an eth tx.
+func (b *Backend) GetCosmosBlockMessages(ctx context.Context, blockNum rpctypes.BlockNumber, address common.Address) (result []*rpctypes.CosmosMessage, err error) {
+	ctx, span := tracer.Start(ctx, "GetCosmosBlockMessages", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64()), attribute.String("address", address.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	result = []*rpctypes.CosmosMessage{}
+	for i, txBz := range block.Block.Txs {
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.Logger.Debug("failed to decode transaction in block", "height", block.Block.Height, "error", err.Error())
+			continue
+		}
+		for j, msg := range tx.GetMsgs() {
+			bz, err := b.ClientCtx.Codec.MarshalInterfaceJSON(msg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode message %d of tx %d: %w", j, i, err)
+			}
+			if !b.messageTouches(msg, bz, address) {
+				continue
+			}
+
+			result = append(result, &rpctypes.CosmosMessage{
+				TxHash:   txBz.Hash(),
+				TxIndex:  hexutil.Uint(i),
+				MsgIndex: hexutil.Uint(j),
+				Type:     sdk.MsgTypeURL(msg),
+				Message:  bz,
+			})
+		}
+	}
+	return result, nil
+}
+
+// messageTouches returns true if the address is a signer or an address field of
+// the given message, whose JSON encoding is bz, or the sender or recipient of
+// an eth tx.
+func (b *Backend) messageTouches(msg sdk.Msg, bz []byte, address common.Address) bool {
+	if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+		to := ethMsg.AsTransaction().To()
+		return bytes.Equal(ethMsg.From, address.Bytes()) || (to != nil && *to == address)
+	}
+	if signers, _, err := b.ClientCtx.Codec.GetMsgV1Signers(msg); err == nil {
+		for _, signer := range signers {
+			if bytes.Equal(signer, address.Bytes()) {
+				return true
+			}
+		}
+	}
+	var fields interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return false
+	}
+	return hasAddressField(fields, address)
+}
+
+// hasAddressField returns true if a string field of the decoded JSON value is
+// the given address, either hex encoded or bech32 encoded with any prefix.
+func hasAddressField(v interface{}, address common.Address) bool {
+	switch v := v.(type) {
+	case string:
+		if common.IsHexAddress(v) {
+			return common.HexToAddress(v) == address
+		}
+		_, bz, err := bech32.DecodeAndConvert(v)
+		return err == nil && bytes.Equal(bz, address.Bytes())
+	case []interface{}:
+		for _, elem := range v {
+			if hasAddressField(elem, address) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, elem := range v {
+			if hasAddressField(elem, address) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cometBlockAndResults returns the block and the block results of the given
+// block number, the block is nil if it doesn't exist.
+func (b *Backend) cometBlockAndResults(ctx context.Context, blockNum rpctypes.BlockNumber) (*cmtrpctypes.ResultBlock, *cmtrpctypes.ResultBlockResults, error) {
+	block, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil || block == nil {
+		return nil, nil, err
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &block.Block.Height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("block result not found for height %d: %w", block.Block.Height, err)
+	}
+	if len(blockRes.TxsResults) != len(block.Block.Txs) {
+		return nil, nil, fmt.Errorf("block %d has %d txs but %d tx results", block.Block.Height, len(block.Block.Txs), len(blockRes.TxsResults))
+	}
+	return block, blockRes, nil
+}
+
+// erc20AddressesByDenom returns the ERC20 addresses of all the registered
+// token pairs keyed by denom.
+func (b *Backend) erc20AddressesByDenom(ctx context.Context) (map[string]common.Address, error) {
+	addresses := make(map[string]common.Address)
+	req := &erc20types.QueryTokenPairsRequest{Pagination: &query.PageRequest{}}
+	for {
+		res, err := b.QueryClient.Erc20.TokenPairs(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range res.TokenPairs {
+			addresses[pair.Denom] = common.HexToAddress(pair.Erc20Address)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return addresses, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+func newCosmosTxEvents(hash []byte, height int64, index uint32, res *abci.ExecTxResult) *rpctypes.CosmosTxEvents {
+	events := res.Events
+	if events == nil {
+		events = []abci.Event{}
+	}
+	return &rpctypes.CosmosTxEvents{
+		Hash:      hash,
+		Height:    hexutil.Uint64(height), //nolint:gosec // G115 // block height is never negative
+		Index:     hexutil.Uint(index),
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Log:       res.Log,
+		GasWanted: hexutil.Uint64(res.GasWanted), //nolint:gosec // G115 // gas is never negative
+		GasUsed:   hexutil.Uint64(res.GasUsed),   //nolint:gosec // G115 // gas is never negative
+		Events:    events,
+	}
+}
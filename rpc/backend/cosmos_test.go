@@ -0,0 +1,153 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// failingTxIndexer fails to look up any eth tx by hash.
+type failingTxIndexer struct {
+	servertypes.EVMTxIndexer
+	err error
+}
+
+func (idxer failingTxIndexer) GetByTxHash(common.Hash) (*servertypes.TxResult, error) {
+	return nil, idxer.err
+}
+
+func TestGetCosmosTxHash(t *testing.T) {
+	testCases := []struct {
+		name    string
+		indexer servertypes.EVMTxIndexer
+		expErr  string
+	}{
+		{
+			name:    "tx not indexed",
+			indexer: indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{}),
+		},
+		{
+			name:    "indexer failure",
+			indexer: failingTxIndexer{err: errors.New("db closed")},
+			expErr:  "db closed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &Backend{Indexer: tc.indexer, Logger: log.NewNopLogger()}
+			hash, err := b.GetCosmosTxHash(context.Background(), common.Hash{0x1})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Nil(t, hash)
+		})
+	}
+}
+
+func TestGetCosmosBlockMessages(t *testing.T) {
+	backend := setupMockBackend(t)
+	registry := backend.ClientCtx.Codec.InterfaceRegistry()
+	evmtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+
+	var (
+		alice = utiltx.GenerateAddress()
+		bob   = utiltx.GenerateAddress()
+		carol = utiltx.GenerateAddress()
+		dave  = utiltx.GenerateAddress()
+	)
+	bech32 := func(addr common.Address) string {
+		return sdk.AccAddress(addr.Bytes()).String()
+	}
+	ethMsg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  new(big.Int).SetUint64(constants.ExampleChainID.EVMChainID),
+		To:       &bob,
+		Amount:   big.NewInt(1),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	ethMsg.From = carol.Bytes()
+	msgs := []sdk.Msg{
+		// alice signs and bob is an address field
+		banktypes.NewMsgSend(sdk.AccAddress(alice.Bytes()), sdk.AccAddress(bob.Bytes()), sdk.NewCoins(sdk.NewInt64Coin("atest", 1))),
+		// carol sends an eth tx to bob
+		ethMsg,
+		// alice only appears within a denom, which isn't an address field
+		&banktypes.MsgSend{
+			FromAddress: bech32(carol),
+			ToAddress:   bech32(dave),
+			Amount:      sdk.Coins{{Denom: "erc20/" + alice.Hex(), Amount: sdkmath.NewInt(1)}},
+		},
+		// alice is the validator, with the validator bech32 prefix
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: bech32(dave),
+			ValidatorAddress: sdk.ValAddress(alice.Bytes()).String(),
+			Amount:           sdk.NewInt64Coin("atest", 1),
+		},
+	}
+	var txs tmtypes.Txs
+	for _, msg := range msgs {
+		builder := backend.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		bz, err := backend.ClientCtx.TxConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+	height := int64(5)
+	mockClient := backend.ClientCtx.Client.(*mocks.Client)
+	mockClient.On("Block", mock.Anything, &height).Return(&tmrpctypes.ResultBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: txs}},
+	}, nil).Maybe()
+
+	testCases := []struct {
+		name     string
+		address  common.Address
+		expTxIdx []uint
+	}{
+		{"signer and validator field", alice, []uint{0, 3}},
+		{"recipient field and eth recipient", bob, []uint{0, 1}},
+		{"eth sender and signer", carol, []uint{1, 2}},
+		{"recipient field and signer", dave, []uint{2, 3}},
+		{"untouched address", utiltx.GenerateAddress(), nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := backend.GetCosmosBlockMessages(context.Background(), rpctypes.BlockNumber(height), tc.address)
+			require.NoError(t, err)
+			var txIdx []uint
+			for _, msg := range res {
+				require.Equal(t, txs[msg.TxIndex].Hash(), []byte(msg.TxHash))
+				txIdx = append(txIdx, uint(msg.TxIndex))
+			}
+			require.Equal(t, tc.expTxIdx, txIdx)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -9,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	servertypes "github.com/cosmos/evm/server/types"
 	evmtrace "github.com/cosmos/evm/trace"
 )
 
@@ -17,6 +19,10 @@ func (b *Backend) GetLogs(ctx context.Context, hash common.Hash) (result [][]*et
 	ctx, span := tracer.Start(ctx, "GetLogs", trace.WithAttributes(attribute.String("hash", hash.Hex())))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height, ok := b.cache.blockHeight(hash); ok {
+		return b.GetLogsByHeight(ctx, &height)
+	}
+
 	resBlock, err := b.CometBlockByHash(ctx, hash)
 	if err != nil {
 		return nil, err
@@ -24,6 +30,7 @@ func (b *Backend) GetLogs(ctx context.Context, hash common.Hash) (result [][]*et
 	if resBlock == nil {
 		return nil, errors.Errorf("block not found for hash %s", hash)
 	}
+	b.cache.addBlockHeight(hash, resBlock.Block.Height)
 	return b.GetLogsByHeight(ctx, &resBlock.Block.Height)
 }
 
@@ -36,13 +43,60 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 	ctx, span := tracer.Start(ctx, "GetLogsByHeight", trace.WithAttributes(attribute.Int64("height", heightAttr)))
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	if height != nil {
+		if cached, ok := b.cache.getLogs(*height); ok {
+			return cached, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.RPCClient.BlockResults(ctx, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	result, err = GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+	b.cache.addLogs(blockRes.Height, result)
+	return result, nil
+}
+
+// LogIndexedBlocks returns the blocks within the inclusive range that may
+// contain logs emitted by one of the addresses with one of the first topics,
+// using the log index of the indexer. The blocks whose logs are not indexed,
+// such as the ones indexed while the log index was disabled or not indexed
+// yet, are all returned as candidates. The second return value is false if
+// the log index is disabled or more blocks than the block range cap are not
+// indexed, in which case all the blocks have to be scanned.
+func (b *Backend) LogIndexedBlocks(
+	ctx context.Context,
+	addresses []common.Address,
+	topics0 []common.Hash,
+	from, to int64,
+) (result []int64, ok bool, err error) {
+	_, span := tracer.Start(ctx, "LogIndexedBlocks", trace.WithAttributes(attribute.Int64("from", from), attribute.Int64("to", to)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, isLogIndexer := b.Indexer.(servertypes.EVMLogIndexer)
+	if !isLogIndexer || !idxer.LogIndexEnabled() || (len(addresses) == 0 && len(topics0) == 0) {
+		return nil, false, nil
+	}
+	// the blocks not indexed are scanned, which must stay within the block
+	// range cap
+	unindexed, ok, err := idxer.UnindexedLogBlocks(from, to, int(b.RPCBlockRangeCap()))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	result, err = idxer.GetLogBlocks(addresses, topics0, from, to)
+	if err != nil {
+		return nil, false, err
+	}
+	result = append(result, unindexed...)
+	slices.Sort(result)
+	return result, true, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -0,0 +1,76 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLogIndexedBlocks(t *testing.T) {
+	token := common.BytesToAddress([]byte{0x1})
+	txResult := func(address common.Address) *abcitypes.ExecTxResult {
+		msgRes, err := codectypes.NewAnyWithValue(&evmtypes.MsgEthereumTxResponse{Logs: []*evmtypes.Log{{Address: address.Hex()}}})
+		require.NoError(t, err)
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{msgRes}})
+		require.NoError(t, err)
+		return &abcitypes.ExecTxResult{Data: data}
+	}
+
+	// the log index is disabled while the blocks 3 and 4 are indexed
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithLogIndex(true))
+	noLogIdxer := indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{})
+	for height := int64(1); height <= 6; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		results := []*abcitypes.ExecTxResult{txResult(common.BytesToAddress([]byte{0x2}))}
+		if height == 2 || height == 5 {
+			results = []*abcitypes.ExecTxResult{txResult(token)}
+		}
+		if height == 3 || height == 4 {
+			require.NoError(t, noLogIdxer.IndexBlock(block, results))
+		} else {
+			require.NoError(t, idxer.IndexBlock(block, results))
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		rangeCap  int32
+		from, to  int64
+		expBlocks []int64
+		expOk     bool
+	}{
+		{"the blocks of the gap are candidates", 2, 1, 6, []int64{2, 3, 4, 5}, true},
+		{"the blocks not indexed yet are candidates", 3, 5, 8, []int64{5, 7, 8}, true},
+		{"fully indexed range", 0, 5, 6, []int64{5}, true},
+		{"the gap exceeds the block range cap", 1, 1, 6, nil, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := setupMockBackend(t)
+			backend.Indexer = idxer
+			backend.Cfg.JSONRPC.BlockRangeCap = tc.rangeCap
+
+			heights, ok, err := backend.LogIndexedBlocks(context.Background(), []common.Address{token}, nil, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expOk, ok)
+			require.Equal(t, tc.expBlocks, heights)
+		})
+	}
+}
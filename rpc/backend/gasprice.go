@@ -0,0 +1,279 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	evmtrace "github.com/cosmos/evm/trace"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+// gpoSampleNumber is the number of the lowest effective tips sampled from
+// each block, as the geth oracle
+const gpoSampleNumber = 3
+
+// gasPriceOracle suggests the tip of the transactions from the effective tips
+// of the recent blocks, as the geth gas price oracle. The suggestion is cached
+// until the next block.
+type gasPriceOracle struct {
+	blocks      int64
+	percentile  int
+	maxPrice    *big.Int
+	ignorePrice *big.Int
+
+	mu       sync.Mutex
+	lastHead int64
+	lastTip  *big.Int
+	// blockTips are the sampled tips of the recent blocks, by height
+	blockTips map[int64][]*big.Int
+}
+
+// newGasPriceOracle creates a gas price oracle with the configuration, the
+// unset or invalid values are replaced with the defaults.
+func newGasPriceOracle(cfg config.JSONRPCConfig) *gasPriceOracle {
+	blocks := cfg.GasPriceOracleBlocks
+	if blocks <= 0 {
+		blocks = config.DefaultGasPriceOracleBlocks
+	}
+	percentile := cfg.GasPriceOraclePercentile
+	if percentile <= 0 || percentile > 100 {
+		percentile = config.DefaultGasPriceOraclePercentile
+	}
+	maxPrice := cfg.GasPriceOracleMaxPrice
+	if maxPrice <= 0 {
+		maxPrice = config.DefaultGasPriceOracleMaxPrice
+	}
+	ignorePrice := cfg.GasPriceOracleIgnorePrice
+	if ignorePrice < 0 {
+		ignorePrice = config.DefaultGasPriceOracleIgnorePrice
+	}
+	return &gasPriceOracle{
+		blocks:      int64(blocks),
+		percentile:  percentile,
+		maxPrice:    big.NewInt(maxPrice),
+		ignorePrice: big.NewInt(ignorePrice),
+		lastHead:    -1,
+		blockTips:   make(map[int64][]*big.Int),
+	}
+}
+
+// suggest returns the tip suggested at the head, at the configured percentile
+// of the tips sampled from the recent blocks, or the fallback tip without any
+// sample. It's raised to the pending tip, if any, and capped to the max price.
+// The suggestion is cached until the next head and the samples of a block are
+// cached while it's in the sampled range, a block without samples returned as
+// nil is sampled again.
+func (o *gasPriceOracle) suggest(
+	head int64,
+	sample func(height int64) []*big.Int,
+	fallback func() (*big.Int, error),
+	pending func() *big.Int,
+) (*big.Int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.lastHead == head && o.lastTip != nil {
+		return new(big.Int).Set(o.lastTip), nil
+	}
+
+	var samples []*big.Int
+	for height := head; height > 0 && height > head-o.blocks; height-- {
+		tips, ok := o.blockTips[height]
+		if !ok {
+			tips = sample(height)
+			if tips != nil {
+				o.blockTips[height] = tips
+			}
+		}
+		samples = append(samples, tips...)
+	}
+
+	var result *big.Int
+	if len(samples) == 0 {
+		tip, err := fallback()
+		if err != nil {
+			return nil, err
+		}
+		result = new(big.Int).Set(tip)
+	} else {
+		slices.SortFunc(samples, func(x, y *big.Int) int { return x.Cmp(y) })
+		result = new(big.Int).Set(samples[(len(samples)-1)*o.percentile/100])
+	}
+
+	if pendingTip := pending(); pendingTip != nil && pendingTip.Cmp(result) > 0 {
+		result = new(big.Int).Set(pendingTip)
+	}
+	if result.Cmp(o.maxPrice) > 0 {
+		result = new(big.Int).Set(o.maxPrice)
+	}
+
+	o.lastHead, o.lastTip = head, new(big.Int).Set(result)
+	for height := range o.blockTips {
+		if height <= head-o.blocks {
+			delete(o.blockTips, height)
+		}
+	}
+	return result, nil
+}
+
+// SuggestGasTipCap returns the suggested tip cap, at the configured
+// percentile of the lowest effective tips of the recent blocks. It's raised to
+// the tip needed to be part of the next block when the pending transactions
+// of the mempool exceed its gas limit, and capped to the configured max price.
+// Without any recent transaction, it defaults to the max base fee increase of
+// the next block, to help the clients mitigate the base fee changes.
+func (b *Backend) SuggestGasTipCap(ctx context.Context, baseFee *big.Int) (result *big.Int, err error) {
+	ctx, span := tracer.Start(ctx, "SuggestGasTipCap")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if baseFee == nil {
+		// london hardfork not enabled or feemarket not enabled
+		return big.NewInt(0), nil
+	}
+	if b.gasPriceOracle == nil {
+		return b.maxBaseFeeDelta(ctx, baseFee)
+	}
+
+	blockNumber, err := b.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head := int64(blockNumber) //nolint:gosec // G115 // won't exceed int64
+	return b.gasPriceOracle.suggest(
+		head,
+		func(height int64) []*big.Int { return b.sampleBlockTips(ctx, height) },
+		func() (*big.Int, error) { return b.maxBaseFeeDelta(ctx, baseFee) },
+		func() *big.Int { return b.pendingTipCap(ctx, baseFee, head) },
+	)
+}
+
+// sampleBlockTips returns the lowest effective tips of the block, above the
+// ignore price. A block that can't be fetched has no samples.
+func (b *Backend) sampleBlockTips(ctx context.Context, height int64) []*big.Int {
+	ctx, span := tracer.Start(ctx, "sampleBlockTips", trace.WithAttributes(attribute.Int64("height", height)))
+	defer span.End()
+
+	resBlock, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(height))
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.Logger.Debug("failed to fetch block for the gas price oracle", "height", height, "error", err)
+		return nil
+	}
+	blockRes, err := b.CometBlockResultByNumber(ctx, &height)
+	if err != nil || blockRes == nil {
+		b.Logger.Debug("failed to fetch block result for the gas price oracle", "height", height, "error", err)
+		return nil
+	}
+	baseFee, err := b.BaseFee(ctx, blockRes)
+	if err != nil {
+		b.Logger.Debug("failed to fetch base fee for the gas price oracle", "height", height, "error", err.Error())
+		return nil
+	}
+
+	msgs := b.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
+	txs := make([]*ethtypes.Transaction, 0, len(msgs))
+	for _, msg := range msgs {
+		txs = append(txs, msg.AsTransaction())
+	}
+	return lowestTips(txs, baseFee, b.gasPriceOracle.ignorePrice)
+}
+
+// lowestTips returns the lowest effective tips of the transactions, above the
+// ignore price.
+func lowestTips(txs []*ethtypes.Transaction, baseFee, ignorePrice *big.Int) []*big.Int {
+	tips := []*big.Int{}
+	for _, tx := range txs {
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil || tip.Cmp(ignorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+	slices.SortFunc(tips, func(x, y *big.Int) int { return x.Cmp(y) })
+	if len(tips) > gpoSampleNumber {
+		tips = tips[:gpoSampleNumber]
+	}
+	return tips
+}
+
+// pendingTipCap returns the effective tip of the first pending transaction of
+// the mempool that doesn't fit in the next block, ordered by effective tip, or
+// nil if they all fit.
+func (b *Backend) pendingTipCap(ctx context.Context, baseFee *big.Int, head int64) *big.Int {
+	if b.Mempool == nil || b.Mempool.GetTxPool() == nil {
+		return nil
+	}
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(ctx, b.ClientCtx, head)
+	if err != nil || gasLimit <= 0 {
+		return nil
+	}
+	fee := uint256.MustFromBig(baseFee)
+	pending := b.Mempool.GetTxPool().Pending(ctx, txpool.PendingFilter{BaseFee: fee, OnlyPlainTxs: true})
+	return overflowTip(pending, fee, uint64(gasLimit)) // #nosec G115 -- checked positive
+}
+
+// overflowTip returns the effective tip of the first pending transaction that
+// doesn't fit in the gas limit, ordered by effective tip, or nil if they all
+// fit.
+func overflowTip(pending map[common.Address][]*txpool.LazyTransaction, baseFee *uint256.Int, gasLimit uint64) *big.Int {
+	type pendingTx struct {
+		tip *uint256.Int
+		gas uint64
+	}
+	var txs []pendingTx
+	for _, lazies := range pending {
+		for _, lazy := range lazies {
+			if lazy.GasFeeCap.Lt(baseFee) {
+				continue
+			}
+			tip := new(uint256.Int).Sub(lazy.GasFeeCap, baseFee)
+			if lazy.GasTipCap.Lt(tip) {
+				tip = lazy.GasTipCap
+			}
+			txs = append(txs, pendingTx{tip: tip, gas: lazy.Gas})
+		}
+	}
+	slices.SortStableFunc(txs, func(x, y pendingTx) int { return y.tip.Cmp(x.tip) })
+
+	var gasUsed uint64
+	for _, tx := range txs {
+		gasUsed += tx.gas
+		if gasUsed > gasLimit {
+			return tx.tip.ToBig()
+		}
+	}
+	return nil
+}
+
+// maxBaseFeeDelta returns the maximum base fee increase of the next block.
+func (b *Backend) maxBaseFeeDelta(ctx context.Context, baseFee *big.Int) (*big.Int, error) {
+	params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
+	// ```
+	// GasTarget = GasLimit / ElasticityMultiplier
+	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
+	// ```
+	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
+	// ```
+	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
+	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
+	// ```t
+	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
+	if maxDelta < 0 {
+		// impossible if the parameter validation passed.
+		maxDelta = 0
+	}
+	return big.NewInt(maxDelta), nil
+}
@@ -0,0 +1,283 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+)
+
+func bigInts(values ...int64) []*big.Int {
+	ints := make([]*big.Int, 0, len(values))
+	for _, v := range values {
+		ints = append(ints, big.NewInt(v))
+	}
+	return ints
+}
+
+func TestGasPriceOracleSuggest(t *testing.T) {
+	testCases := []struct {
+		name       string
+		blocks     int
+		percentile int
+		maxPrice   int64
+		samples    map[int64][]*big.Int
+		fallback   *big.Int
+		pending    *big.Int
+		expected   *big.Int
+		expErr     bool
+	}{
+		{
+			name:       "percentile over the tips of all the sampled blocks",
+			blocks:     3,
+			percentile: 60,
+			samples:    map[int64][]*big.Int{3: bigInts(1, 2, 3), 2: bigInts(6), 1: bigInts(5, 4)},
+			expected:   big.NewInt(4),
+		},
+		{
+			name:       "blocks out of the range are not sampled",
+			blocks:     2,
+			percentile: 60,
+			samples:    map[int64][]*big.Int{3: bigInts(1, 2, 3), 2: bigInts(4), 1: bigInts(5, 6)},
+			expected:   big.NewInt(2),
+		},
+		{
+			name:       "highest percentile",
+			blocks:     3,
+			percentile: 100,
+			samples:    map[int64][]*big.Int{3: bigInts(1, 2, 3), 1: bigInts(7)},
+			expected:   big.NewInt(7),
+		},
+		{
+			name:       "max base fee delta without any sample",
+			blocks:     3,
+			percentile: 60,
+			samples:    map[int64][]*big.Int{3: {}, 2: {}},
+			fallback:   big.NewInt(9),
+			expected:   big.NewInt(9),
+		},
+		{
+			name:       "fallback error",
+			blocks:     3,
+			percentile: 60,
+			expErr:     true,
+		},
+		{
+			name:       "raised to the pending tip",
+			blocks:     3,
+			percentile: 60,
+			samples:    map[int64][]*big.Int{3: bigInts(1, 2, 3)},
+			pending:    big.NewInt(5),
+			expected:   big.NewInt(5),
+		},
+		{
+			name:       "lower pending tip is ignored",
+			blocks:     3,
+			percentile: 60,
+			samples:    map[int64][]*big.Int{3: bigInts(1, 2, 3)},
+			pending:    big.NewInt(1),
+			expected:   big.NewInt(2),
+		},
+		{
+			name:       "pending tip raises the fallback",
+			blocks:     3,
+			percentile: 60,
+			fallback:   big.NewInt(1),
+			pending:    big.NewInt(3),
+			expected:   big.NewInt(3),
+		},
+		{
+			name:       "capped to the max price",
+			blocks:     3,
+			percentile: 60,
+			maxPrice:   50,
+			samples:    map[int64][]*big.Int{3: bigInts(100)},
+			expected:   big.NewInt(50),
+		},
+		{
+			name:       "pending tip capped to the max price",
+			blocks:     3,
+			percentile: 60,
+			maxPrice:   50,
+			samples:    map[int64][]*big.Int{3: bigInts(1)},
+			pending:    big.NewInt(100),
+			expected:   big.NewInt(50),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := newGasPriceOracle(config.JSONRPCConfig{
+				GasPriceOracleBlocks:     tc.blocks,
+				GasPriceOraclePercentile: tc.percentile,
+				GasPriceOracleMaxPrice:   tc.maxPrice,
+			})
+			tip, err := o.suggest(
+				3,
+				func(height int64) []*big.Int { return tc.samples[height] },
+				func() (*big.Int, error) {
+					if tc.fallback == nil {
+						return nil, errors.New("fallback failed")
+					}
+					return tc.fallback, nil
+				},
+				func() *big.Int { return tc.pending },
+			)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tip)
+		})
+	}
+}
+
+func TestGasPriceOracleCache(t *testing.T) {
+	o := newGasPriceOracle(config.JSONRPCConfig{GasPriceOracleBlocks: 2, GasPriceOraclePercentile: 100})
+
+	samples := map[int64][]*big.Int{2: bigInts(2), 3: bigInts(3), 5: bigInts(5)}
+	var sampled []int64
+	sample := func(height int64) []*big.Int {
+		sampled = append(sampled, height)
+		return samples[height]
+	}
+	fallback := func() (*big.Int, error) { return big.NewInt(0), nil }
+	var pending *big.Int
+	pendingTip := func() *big.Int { return pending }
+
+	tip, err := o.suggest(3, sample, fallback, pendingTip)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3), tip)
+	require.Equal(t, []int64{3, 2}, sampled)
+
+	// the suggestion is cached until the next head
+	sampled = nil
+	pending = big.NewInt(10)
+	tip, err = o.suggest(3, sample, fallback, pendingTip)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3), tip)
+	require.Empty(t, sampled)
+
+	// only the new block is sampled at the next head, and a block that can't
+	// be sampled is not cached
+	pending = nil
+	tip, err = o.suggest(4, sample, fallback, pendingTip)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(3), tip)
+	require.Equal(t, []int64{4}, sampled)
+	require.NotContains(t, o.blockTips, int64(2))
+
+	sampled = nil
+	tip, err = o.suggest(5, sample, fallback, pendingTip)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), tip)
+	require.Equal(t, []int64{5, 4}, sampled)
+}
+
+func TestLowestTips(t *testing.T) {
+	baseFee := big.NewInt(10)
+	dynamicFeeTx := func(feeCap, tipCap int64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{GasFeeCap: big.NewInt(feeCap), GasTipCap: big.NewInt(tipCap)})
+	}
+	legacyTx := func(gasPrice int64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(gasPrice)})
+	}
+
+	testCases := []struct {
+		name        string
+		txs         []*ethtypes.Transaction
+		ignorePrice int64
+		expected    []*big.Int
+	}{
+		{"no txs", nil, 0, []*big.Int{}},
+		{
+			"effective tips",
+			[]*ethtypes.Transaction{dynamicFeeTx(20, 5), dynamicFeeTx(12, 5), legacyTx(13)},
+			0,
+			bigInts(2, 3, 5),
+		},
+		{
+			"lowest tips only",
+			[]*ethtypes.Transaction{legacyTx(30), dynamicFeeTx(20, 5), dynamicFeeTx(12, 5), legacyTx(13)},
+			0,
+			bigInts(2, 3, 5),
+		},
+		{
+			"tips below the ignore price",
+			[]*ethtypes.Transaction{dynamicFeeTx(11, 1), dynamicFeeTx(20, 5), legacyTx(13)},
+			2,
+			bigInts(3, 5),
+		},
+		{
+			"fee cap below the base fee",
+			[]*ethtypes.Transaction{dynamicFeeTx(9, 1), legacyTx(13)},
+			0,
+			bigInts(3),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, lowestTips(tc.txs, baseFee, big.NewInt(tc.ignorePrice)))
+		})
+	}
+}
+
+func TestOverflowTip(t *testing.T) {
+	var (
+		alice   = common.BytesToAddress([]byte{0x1})
+		bob     = common.BytesToAddress([]byte{0x2})
+		baseFee = uint256.NewInt(10)
+	)
+	lazyTx := func(feeCap, tipCap, gas uint64) *txpool.LazyTransaction {
+		return &txpool.LazyTransaction{GasFeeCap: uint256.NewInt(feeCap), GasTipCap: uint256.NewInt(tipCap), Gas: gas}
+	}
+	pending := map[common.Address][]*txpool.LazyTransaction{
+		alice: {lazyTx(20, 5, 100), lazyTx(13, 5, 100)},
+		bob:   {lazyTx(30, 7, 100), lazyTx(9, 1, 100)},
+	}
+
+	testCases := []struct {
+		name     string
+		gasLimit uint64
+		expected *big.Int
+	}{
+		{"all fit", 300, nil},
+		{"lowest tip overflows", 250, big.NewInt(3)},
+		{"overflow by effective tip", 150, big.NewInt(5)},
+		{"highest tip overflows", 50, big.NewInt(7)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, overflowTip(pending, baseFee, tc.gasLimit))
+		})
+	}
+}
+
+func TestSuggestGasTipCapMaxBaseFeeDelta(t *testing.T) {
+	feeMarketClient := mocks.NewFeeMarketQueryClient(t)
+	feeMarketClient.On("Params", mock.Anything, mock.Anything).
+		Return(&feemarkettypes.QueryParamsResponse{Params: feemarkettypes.DefaultParams()}, nil)
+	b := &Backend{QueryClient: &rpctypes.QueryClient{FeeMarket: feeMarketClient}}
+
+	// without the oracle, the tip is the max base fee increase of the next block
+	tip, err := b.SuggestGasTipCap(context.Background(), big.NewInt(800))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), tip)
+
+	tip, err = b.SuggestGasTipCap(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0), tip)
+}
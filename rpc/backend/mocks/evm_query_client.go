@@ -395,6 +395,80 @@ func (_c *EVMQueryClient_Config_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// Contracts provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) Contracts(ctx context.Context, in *types.QueryContractsRequest, opts ...grpc.CallOption) (*types.QueryContractsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Contracts")
+	}
+
+	var r0 *types.QueryContractsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractsRequest, ...grpc.CallOption) (*types.QueryContractsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractsRequest, ...grpc.CallOption) *types.QueryContractsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryContractsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryContractsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_Contracts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Contracts'
+type EVMQueryClient_Contracts_Call struct {
+	*mock.Call
+}
+
+// Contracts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryContractsRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) Contracts(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_Contracts_Call {
+	return &EVMQueryClient_Contracts_Call{Call: _e.mock.On("Contracts",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_Contracts_Call) Run(run func(ctx context.Context, in *types.QueryContractsRequest, opts ...grpc.CallOption)) *EVMQueryClient_Contracts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryContractsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_Contracts_Call) Return(_a0 *types.QueryContractsResponse, _a1 error) *EVMQueryClient_Contracts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_Contracts_Call) RunAndReturn(run func(context.Context, *types.QueryContractsRequest, ...grpc.CallOption) (*types.QueryContractsResponse, error)) *EVMQueryClient_Contracts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CosmosAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosmosAccountRequest, opts ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -765,6 +839,80 @@ func (_c *EVMQueryClient_Params_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// SimulateV1 provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) SimulateV1(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption) (*types.SimulateV1Response, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateV1")
+	}
+
+	var r0 *types.SimulateV1Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) *types.SimulateV1Response); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SimulateV1Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_SimulateV1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulateV1'
+type EVMQueryClient_SimulateV1_Call struct {
+	*mock.Call
+}
+
+// SimulateV1 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.SimulateV1Request
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) SimulateV1(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_SimulateV1_Call {
+	return &EVMQueryClient_SimulateV1_Call{Call: _e.mock.On("SimulateV1",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Run(run func(ctx context.Context, in *types.SimulateV1Request, opts ...grpc.CallOption)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.SimulateV1Request), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) Return(_a0 *types.SimulateV1Response, _a1 error) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_SimulateV1_Call) RunAndReturn(run func(context.Context, *types.SimulateV1Request, ...grpc.CallOption) (*types.SimulateV1Response, error)) *EVMQueryClient_SimulateV1_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -839,6 +987,80 @@ func (_c *EVMQueryClient_Storage_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// StorageRange provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) StorageRange(ctx context.Context, in *types.QueryStorageRangeRequest, opts ...grpc.CallOption) (*types.QueryStorageRangeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StorageRange")
+	}
+
+	var r0 *types.QueryStorageRangeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) (*types.QueryStorageRangeResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) *types.QueryStorageRangeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryStorageRangeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_StorageRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StorageRange'
+type EVMQueryClient_StorageRange_Call struct {
+	*mock.Call
+}
+
+// StorageRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryStorageRangeRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) StorageRange(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_StorageRange_Call {
+	return &EVMQueryClient_StorageRange_Call{Call: _e.mock.On("StorageRange",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) Run(run func(ctx context.Context, in *types.QueryStorageRangeRequest, opts ...grpc.CallOption)) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryStorageRangeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) Return(_a0 *types.QueryStorageRangeResponse, _a1 error) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_StorageRange_Call) RunAndReturn(run func(context.Context, *types.QueryStorageRangeRequest, ...grpc.CallOption) (*types.QueryStorageRangeResponse, error)) *EVMQueryClient_StorageRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TraceBlock provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) TraceBlock(ctx context.Context, in *types.QueryTraceBlockRequest, opts ...grpc.CallOption) (*types.QueryTraceBlockResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1061,6 +1283,43 @@ func (_c *EVMQueryClient_TraceTx_Call) RunAndReturn(run func(context.Context, *t
 	return _c
 }
 
+// TxStatus provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) TxStatus(ctx context.Context, in *types.QueryTxStatusRequest, opts ...grpc.CallOption) (*types.QueryTxStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TxStatus")
+	}
+
+	var r0 *types.QueryTxStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTxStatusRequest, ...grpc.CallOption) (*types.QueryTxStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTxStatusRequest, ...grpc.CallOption) *types.QueryTxStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTxStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTxStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ValidatorAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) ValidatorAccount(ctx context.Context, in *types.QueryValidatorAccountRequest, opts ...grpc.CallOption) (*types.QueryValidatorAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -32,6 +32,10 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
+// txSyncWriteMargin is the time left to eth_sendRawTransactionSync to write
+// its response before the http timeout.
+const txSyncWriteMargin = time.Second
+
 // Accounts returns the list of accounts available to this node.
 func (b *Backend) Accounts() ([]common.Address, error) {
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
@@ -319,6 +323,29 @@ func (b *Backend) RPCEVMTimeout() time.Duration {
 	return b.Cfg.JSONRPC.EVMTimeout
 }
 
+// RPCTxSyncTimeout is the time eth_sendRawTransactionSync waits for the tx
+// inclusion when the request has no timeout.
+func (b *Backend) RPCTxSyncTimeout() time.Duration {
+	if b.Cfg.JSONRPC.TxSyncTimeout == 0 {
+		return config.DefaultTxSyncTimeout
+	}
+	return b.Cfg.JSONRPC.TxSyncTimeout
+}
+
+// RPCTxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for
+// the tx inclusion. It's capped below the http timeout, since the http server
+// cuts the connection instead of writing the timeout error past it.
+func (b *Backend) RPCTxSyncMaxTimeout() time.Duration {
+	maxTimeout := b.Cfg.JSONRPC.TxSyncMaxTimeout
+	if maxTimeout == 0 {
+		maxTimeout = config.DefaultTxSyncMaxTimeout
+	}
+	if httpTimeout := b.Cfg.JSONRPC.HTTPTimeout; httpTimeout > 0 && maxTimeout > httpTimeout-txSyncWriteMargin {
+		maxTimeout = max(httpTimeout-txSyncWriteMargin, httpTimeout/2)
+	}
+	return maxTimeout
+}
+
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
@@ -0,0 +1,35 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func TestRPCTxSyncMaxTimeout(t *testing.T) {
+	testCases := []struct {
+		name        string
+		maxTimeout  time.Duration
+		httpTimeout time.Duration
+		expected    time.Duration
+	}{
+		{"default below http timeout", 0, 0, config.DefaultTxSyncMaxTimeout},
+		{"default capped by the default http timeout", 0, config.DefaultHTTPTimeout, config.DefaultHTTPTimeout - txSyncWriteMargin},
+		{"below http timeout", 10 * time.Second, 30 * time.Second, 10 * time.Second},
+		{"equal to http timeout", 30 * time.Second, 30 * time.Second, 29 * time.Second},
+		{"above http timeout", time.Minute, 30 * time.Second, 29 * time.Second},
+		{"short http timeout", time.Minute, time.Second, 500 * time.Millisecond},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &Backend{Cfg: config.Config{JSONRPC: config.JSONRPCConfig{
+				TxSyncMaxTimeout: tc.maxTimeout,
+				HTTPTimeout:      tc.httpTimeout,
+			}}}
+			require.Equal(t, tc.expected, b.RPCTxSyncMaxTimeout())
+		})
+	}
+}
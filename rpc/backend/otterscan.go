@@ -0,0 +1,539 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const callTracer = "callTracer"
+
+// errAddressIndexUnsupported is returned when the tx indexer doesn't maintain
+// the address index.
+var errAddressIndexUnsupported = errors.New("address index is not enabled, enable the custom eth tx indexer and its address index")
+
+// errInternalCreatorUnindexed is returned for the contracts whose creation is
+// not in the address index when the trace index, which holds the contracts
+// deployed by other contracts, is disabled.
+var errInternalCreatorUnindexed = errors.New("contract creator is not indexed, the contracts deployed by other contracts require the trace indexer")
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self-destructs that happened within the internal calls of the given eth tx.
+func (b *Backend) GetInternalOperations(ctx context.Context, hash common.Hash) (result []*rpctypes.OtsInternalOperation, err error) {
+	ctx, span := tracer.Start(ctx, "GetInternalOperations", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := b.traceCallFrame(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	result = []*rpctypes.OtsInternalOperation{}
+	var walk func(f *rpctypes.CallFrame, depth int)
+	walk = func(f *rpctypes.CallFrame, depth int) {
+		if f.Error != "" {
+			// the state changes of reverted frames are discarded
+			return
+		}
+		if depth > 0 {
+			op := &rpctypes.OtsInternalOperation{From: f.From, Value: f.Value}
+			if f.To != nil {
+				op.To = *f.To
+			}
+			if op.Value == nil {
+				op.Value = (*hexutil.Big)(new(big.Int))
+			}
+			switch f.Type {
+			case "CREATE":
+				op.Type = rpctypes.OtsOpCreate
+				result = append(result, op)
+			case "CREATE2":
+				op.Type = rpctypes.OtsOpCreate2
+				result = append(result, op)
+			case "SELFDESTRUCT":
+				op.Type = rpctypes.OtsOpSelfDestruct
+				result = append(result, op)
+			case "CALL", "CALLCODE":
+				if op.Value.ToInt().Sign() > 0 {
+					op.Type = rpctypes.OtsOpTransfer
+					result = append(result, op)
+				}
+			}
+		}
+		for i := range f.Calls {
+			walk(&f.Calls[i], depth+1)
+		}
+	}
+	walk(frame, 0)
+
+	return result, nil
+}
+
+// TraceTransactionEntries returns the call frames of the given eth tx
+// flattened in execution order.
+func (b *Backend) TraceTransactionEntries(ctx context.Context, hash common.Hash) (result []*rpctypes.OtsTraceEntry, err error) {
+	ctx, span := tracer.Start(ctx, "TraceTransactionEntries", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := b.traceCallFrame(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	result = []*rpctypes.OtsTraceEntry{}
+	var walk func(f *rpctypes.CallFrame, depth int)
+	walk = func(f *rpctypes.CallFrame, depth int) {
+		entry := &rpctypes.OtsTraceEntry{
+			Type:   f.Type,
+			Depth:  depth,
+			From:   f.From,
+			Value:  f.Value,
+			Input:  f.Input,
+			Output: f.Output,
+		}
+		if f.To != nil {
+			entry.To = *f.To
+		}
+		result = append(result, entry)
+		for i := range f.Calls {
+			walk(&f.Calls[i], depth+1)
+		}
+	}
+	walk(frame, 0)
+
+	return result, nil
+}
+
+// GetTransactionError returns the raw revert data of the given eth tx, it's
+// empty if the tx succeeded.
+func (b *Backend) GetTransactionError(ctx context.Context, hash common.Hash) (result hexutil.Bytes, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionError", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	frame, err := b.traceCallFrame(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	if frame.Output == nil {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// SearchTransactions returns a page of the eth txs the address appears in,
+// either before or after the given block. A zero block number searches from
+// the most recent or from the earliest block respectively. The txs are always
+// returned in descending order. The appearances in internal calls are only
+// found when the trace index is enabled.
+func (b *Backend) SearchTransactions(
+	ctx context.Context,
+	address common.Address,
+	blockNum uint64,
+	pageSize uint64,
+	before bool,
+) (result *rpctypes.OtsSearchResult, err error) {
+	ctx, span := tracer.Start(ctx, "SearchTransactions", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.Int64("blockNum", int64(blockNum)), //nolint:gosec // G115 // block number won't exceed int64
+		attribute.Bool("before", before),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok || !idxer.AddressIndexEnabled() {
+		return nil, errAddressIndexUnsupported
+	}
+	if pageSize == 0 {
+		return nil, errors.New("page size must be greater than zero")
+	}
+	if blockNum > math.MaxInt64 {
+		return nil, fmt.Errorf("block number %d is out of range", blockNum)
+	}
+	limit := int(pageSize) //nolint:gosec // G115 // page size is bounded by the caller
+
+	fromBlock, toBlock := int64(blockNum)+1, int64(math.MaxInt64)
+	if before {
+		fromBlock, toBlock = 0, int64(blockNum)-1
+		if blockNum == 0 {
+			toBlock = math.MaxInt64
+		}
+	}
+	positions, more, err := idxer.GetByAddress(address, fromBlock, toBlock, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	if b.TraceIndexer != nil {
+		internal, internalMore, err := b.TraceIndexer.GetByAddress(address, fromBlock, toBlock, before, limit)
+		if err != nil {
+			return nil, err
+		}
+		positions, more = mergeAddressTxs(positions, more, internal, internalMore, before, limit)
+	}
+	if !before {
+		slices.Reverse(positions)
+	}
+
+	result = &rpctypes.OtsSearchResult{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(positions)),
+		Receipts: make([]map[string]interface{}, 0, len(positions)),
+	}
+	if before {
+		result.FirstPage = blockNum == 0
+		result.LastPage = !more
+	} else {
+		result.FirstPage = !more
+		result.LastPage = blockNum == 0
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, pos := range positions {
+		tx, err := b.GetTransactionByBlockNumberAndIndex(ctx, rpctypes.BlockNumber(pos.Height), hexutil.Uint(pos.EthTxIndex)) //nolint:gosec // G115 // eth tx index is never negative
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("tx %d of block %d not found", pos.EthTxIndex, pos.Height)
+		}
+		receipt, err := b.GetTransactionReceipt(ctx, tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt of tx %s not found", tx.Hash.Hex())
+		}
+
+		timestamp, ok := timestamps[pos.Height]
+		if !ok {
+			header, err := b.CometHeaderByNumber(ctx, rpctypes.BlockNumber(pos.Height))
+			if err != nil {
+				return nil, err
+			}
+			timestamp = hexutil.Uint64(header.Header.Time.UTC().Unix()) //nolint:gosec // G115 // timestamp won't exceed uint64
+			timestamps[pos.Height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, receipt)
+	}
+
+	return result, nil
+}
+
+// GetContractCreator returns the eth tx and the account that created the given
+// contract, returns nil if the address is not a contract. The creators of the
+// contracts deployed by other contracts are found in the trace index, an error
+// is returned if the creation is not indexed.
+func (b *Backend) GetContractCreator(ctx context.Context, address common.Address) (result *rpctypes.OtsContractCreator, err error) {
+	ctx, span := tracer.Start(ctx, "GetContractCreator", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok || !idxer.AddressIndexEnabled() {
+		return nil, errAddressIndexUnsupported
+	}
+
+	latest := rpctypes.EthLatestBlockNumber
+	code, err := b.GetCode(ctx, address, rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	creator, err := idxer.GetContractCreator(address)
+	if err != nil {
+		return nil, err
+	}
+	if creator != nil {
+		return &rpctypes.OtsContractCreator{Hash: creator.TxHash, Creator: creator.Creator}, nil
+	}
+
+	if b.TraceIndexer == nil {
+		return nil, errInternalCreatorUnindexed
+	}
+	traceCreator, err := b.TraceIndexer.GetContractCreator(address)
+	if err != nil {
+		return nil, err
+	}
+	if traceCreator == nil {
+		return nil, fmt.Errorf("creator of contract %s is not indexed", address.Hex())
+	}
+	tx, err := b.GetTransactionByBlockNumberAndIndex(ctx, rpctypes.BlockNumber(traceCreator.Height), hexutil.Uint(traceCreator.EthTxIndex)) //nolint:gosec // G115 // eth tx index is never negative
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("tx %d of block %d not found", traceCreator.EthTxIndex, traceCreator.Height)
+	}
+	return &rpctypes.OtsContractCreator{Hash: tx.Hash, Creator: traceCreator.Creator}, nil
+}
+
+// mergeAddressTxs merges two pages of address txs returned by GetByAddress
+// with the same range, order and limit into a page with the same paging. A
+// page with more txs is complete up to its last block, which holds at least
+// limit txs, so the merged page is never cut beyond it.
+func mergeAddressTxs(
+	a []servertypes.AddressTx, aMore bool,
+	b []servertypes.AddressTx, bMore bool,
+	reverse bool,
+	limit int,
+) ([]servertypes.AddressTx, bool) {
+	precedes := func(x, y servertypes.AddressTx) bool {
+		if x.Height != y.Height {
+			return (x.Height < y.Height) != reverse
+		}
+		return (x.EthTxIndex < y.EthTxIndex) != reverse
+	}
+
+	merged := make([]servertypes.AddressTx, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var tx servertypes.AddressTx
+		switch {
+		case j == len(b) || (i < len(a) && precedes(a[i], b[j])):
+			tx = a[i]
+			i++
+		case i == len(a) || precedes(b[j], a[i]):
+			tx = b[j]
+			j++
+		default:
+			tx = a[i]
+			tx.Roles |= b[j].Roles
+			i++
+			j++
+		}
+		if limit > 0 && len(merged) >= limit && merged[len(merged)-1].Height != tx.Height {
+			return merged, true
+		}
+		merged = append(merged, tx)
+	}
+	return merged, aMore || bMore
+}
+
+// GetTransactionHashBySenderAndNonce returns the hash of the eth tx sent by
+// the given address with the given nonce, returns nil if it's not found.
+func (b *Backend) GetTransactionHashBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (result *common.Hash, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionHashBySenderAndNonce", trace.WithAttributes(
+		attribute.String("sender", sender.Hex()),
+		attribute.Int64("nonce", int64(nonce)), //nolint:gosec // G115 // nonce won't exceed int64
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok || !idxer.AddressIndexEnabled() {
+		return nil, errAddressIndexUnsupported
+	}
+
+	if hash, err := idxer.GetBySenderAndNonce(sender, nonce); err != nil || hash != nil {
+		return hash, err
+	}
+
+	// the sender and nonce index covers the address indexed blocks, only the
+	// txs indexed before it are searched by sender
+	first, err := idxer.FirstAddressIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	if first <= 1 {
+		return nil, nil
+	}
+	positions, _, err := idxer.GetByAddress(sender, 0, first-1, false, 0)
+	if err != nil {
+		return nil, err
+	}
+	sent := slices.DeleteFunc(positions, func(pos servertypes.AddressTx) bool {
+		return pos.Roles&servertypes.AddressRoleFrom == 0
+	})
+
+	// the nonces of the sent txs are increasing, so binary search for it
+	var searchErr error
+	txs := make(map[int]*rpctypes.RPCTransaction)
+	fetch := func(i int) *rpctypes.RPCTransaction {
+		if tx, ok := txs[i]; ok {
+			return tx
+		}
+		pos := sent[i]
+		tx, err := b.GetTransactionByBlockNumberAndIndex(ctx, rpctypes.BlockNumber(pos.Height), hexutil.Uint(pos.EthTxIndex)) //nolint:gosec // G115 // eth tx index is never negative
+		if err == nil && tx == nil {
+			err = fmt.Errorf("tx %d of block %d not found", pos.EthTxIndex, pos.Height)
+		}
+		if err != nil && searchErr == nil {
+			searchErr = err
+		}
+		txs[i] = tx
+		return tx
+	}
+	i := sort.Search(len(sent), func(i int) bool {
+		tx := fetch(i)
+		return tx == nil || uint64(tx.Nonce) >= nonce
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if i == len(sent) {
+		return nil, nil
+	}
+	if tx := fetch(i); tx != nil && uint64(tx.Nonce) == nonce {
+		return &tx.Hash, nil
+	}
+	return nil, nil
+}
+
+// GetBlockDetails returns the given block without its transactions, together
+// with the total fees paid by them.
+func (b *Backend) GetBlockDetails(ctx context.Context, blockNum rpctypes.BlockNumber) (result *rpctypes.OtsBlockDetails, err error) {
+	ctx, span := tracer.Start(ctx, "GetBlockDetails", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.GetBlockByNumber(ctx, blockNum, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return b.otsBlockDetails(ctx, block)
+}
+
+// GetBlockDetailsByHash returns the given block without its transactions,
+// together with the total fees paid by them.
+func (b *Backend) GetBlockDetailsByHash(ctx context.Context, hash common.Hash) (result *rpctypes.OtsBlockDetails, err error) {
+	ctx, span := tracer.Start(ctx, "GetBlockDetailsByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.GetBlockByHash(ctx, hash, false)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return b.otsBlockDetails(ctx, block)
+}
+
+// GetBlockTransactions returns a page of the eth txs of the given block with
+// their receipts. Pages are counted from the end of the block, the tx inputs
+// are truncated to the method selector and the receipt logs are omitted.
+func (b *Backend) GetBlockTransactions(
+	ctx context.Context,
+	blockNum rpctypes.BlockNumber,
+	pageNumber, pageSize uint64,
+) (result *rpctypes.OtsBlockTransactions, err error) {
+	ctx, span := tracer.Start(ctx, "GetBlockTransactions", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.GetBlockByNumber(ctx, blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	txs, _ := block["transactions"].([]interface{})
+
+	receipts, err := b.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(txs) {
+		return nil, fmt.Errorf("block has %d txs but %d receipts", len(txs), len(receipts))
+	}
+
+	// pages are counted from the most recent tx of the block
+	start, end := pageBounds(len(txs), pageNumber, pageSize)
+
+	pageTxs := make([]interface{}, 0, end-start)
+	for _, tx := range txs[start:end] {
+		if rpcTx, ok := tx.(*rpctypes.RPCTransaction); ok {
+			truncated := *rpcTx
+			if len(truncated.Input) > 4 {
+				truncated.Input = truncated.Input[:4]
+			}
+			tx = &truncated
+		}
+		pageTxs = append(pageTxs, tx)
+	}
+	pageReceipts := receipts[start:end]
+	for _, receipt := range pageReceipts {
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+	}
+
+	block["transactionCount"] = hexutil.Uint64(len(txs))
+	block["transactions"] = pageTxs
+
+	return &rpctypes.OtsBlockTransactions{FullBlock: block, Receipts: pageReceipts}, nil
+}
+
+// traceCallFrame traces the given eth tx with the callTracer.
+func (b *Backend) traceCallFrame(ctx context.Context, hash common.Hash) (*rpctypes.CallFrame, error) {
+	res, err := b.TraceTransaction(ctx, hash, &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: callTracer},
+		TracerConfig: json.RawMessage(`{}`),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var frame rpctypes.CallFrame
+	if err := rpctypes.DecodeTracerResult(res, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// otsBlockDetails strips the transactions of the block and computes the total
+// fees paid by them.
+func (b *Backend) otsBlockDetails(ctx context.Context, block map[string]interface{}) (*rpctypes.OtsBlockDetails, error) {
+	number, ok := block["number"].(*hexutil.Big)
+	if !ok || number == nil {
+		return nil, errors.New("invalid block number")
+	}
+	blockNum := rpctypes.BlockNumber(number.ToInt().Int64())
+
+	receipts, err := b.GetBlockReceipts(ctx, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	if err != nil {
+		return nil, err
+	}
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		gasUsed, _ := receipt["gasUsed"].(hexutil.Uint64)
+		gasPrice, _ := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if gasPrice == nil {
+			continue
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()))
+	}
+
+	txs, _ := block["transactions"].([]interface{})
+	block["transactionCount"] = hexutil.Uint64(len(txs))
+	block["logsBloom"] = nil
+	delete(block, "transactions")
+
+	return &rpctypes.OtsBlockDetails{
+		Block:     block,
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// pageBounds returns the bounds of the page within the given number of items,
+// with pages counted from the last item. The page is empty when out of range.
+func pageBounds(count int, pageNumber, pageSize uint64) (start, end int) {
+	// the page is checked to be in range before multiplying, which could overflow
+	if count == 0 || (pageSize > 0 && pageNumber > uint64(count-1)/pageSize) {
+		return 0, 0
+	}
+	end = count - int(pageNumber*pageSize)        //nolint:gosec // G115 // the skipped items are fewer than the count
+	start = end - int(min(pageSize, uint64(end))) //nolint:gosec // G115 // page size is capped to the item count
+	return start, end
+}
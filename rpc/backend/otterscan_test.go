@@ -0,0 +1,167 @@
+package backend
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestMergeAddressTxs(t *testing.T) {
+	const (
+		from = servertypes.AddressRoleFrom
+		to   = servertypes.AddressRoleTo
+	)
+	tx := func(height int64, index int32, roles uint8) servertypes.AddressTx {
+		return servertypes.AddressTx{Height: height, EthTxIndex: index, Roles: roles}
+	}
+
+	testCases := []struct {
+		name         string
+		a            []servertypes.AddressTx
+		aMore        bool
+		b            []servertypes.AddressTx
+		bMore        bool
+		reverse      bool
+		limit        int
+		expected     []servertypes.AddressTx
+		expectedMore bool
+	}{
+		{
+			"empty pages",
+			nil, false, nil, false, false, 2,
+			[]servertypes.AddressTx{}, false,
+		},
+		{
+			"interleaved in ascending order",
+			[]servertypes.AddressTx{tx(1, 0, from), tx(3, 0, from)}, false,
+			[]servertypes.AddressTx{tx(2, 1, to)}, false,
+			false, 0,
+			[]servertypes.AddressTx{tx(1, 0, from), tx(2, 1, to), tx(3, 0, from)}, false,
+		},
+		{
+			"interleaved in descending order",
+			[]servertypes.AddressTx{tx(3, 0, from), tx(1, 0, from)}, false,
+			[]servertypes.AddressTx{tx(3, 1, to), tx(2, 1, to)}, false,
+			true, 0,
+			[]servertypes.AddressTx{tx(3, 1, to), tx(3, 0, from), tx(2, 1, to), tx(1, 0, from)}, false,
+		},
+		{
+			"same tx merges the roles",
+			[]servertypes.AddressTx{tx(1, 0, from)}, false,
+			[]servertypes.AddressTx{tx(1, 0, to)}, false,
+			false, 1,
+			[]servertypes.AddressTx{tx(1, 0, from|to)}, false,
+		},
+		{
+			"cut after limit at a block boundary",
+			[]servertypes.AddressTx{tx(1, 0, from), tx(4, 0, from)}, false,
+			[]servertypes.AddressTx{tx(2, 0, to), tx(2, 1, to), tx(3, 0, to)}, false,
+			false, 2,
+			[]servertypes.AddressTx{tx(1, 0, from), tx(2, 0, to), tx(2, 1, to)}, true,
+		},
+		{
+			"more txs beyond a page",
+			[]servertypes.AddressTx{tx(1, 0, from), tx(2, 0, from)}, true,
+			[]servertypes.AddressTx{tx(1, 1, to)}, false,
+			false, 2,
+			[]servertypes.AddressTx{tx(1, 0, from), tx(1, 1, to)}, true,
+		},
+		{
+			"last page",
+			[]servertypes.AddressTx{tx(2, 0, from)}, false,
+			[]servertypes.AddressTx{tx(1, 0, to)}, false,
+			true, 2,
+			[]servertypes.AddressTx{tx(2, 0, from), tx(1, 0, to)}, false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, more := mergeAddressTxs(tc.a, tc.aMore, tc.b, tc.bMore, tc.reverse, tc.limit)
+			require.Equal(t, tc.expected, merged)
+			require.Equal(t, tc.expectedMore, more)
+		})
+	}
+}
+
+func TestPageBounds(t *testing.T) {
+	testCases := []struct {
+		name       string
+		count      int
+		pageNumber uint64
+		pageSize   uint64
+		expStart   int
+		expEnd     int
+	}{
+		{"no items", 0, 0, 10, 0, 0},
+		{"first page is the last items", 25, 0, 10, 15, 25},
+		{"last partial page", 25, 2, 10, 0, 5},
+		{"page out of range", 25, 3, 10, 0, 0},
+		{"empty page size", 25, 1, 0, 25, 25},
+		{"page number overflowing", 25, math.MaxUint64, 2, 0, 0},
+		{"page size overflowing", 25, 2, math.MaxUint64, 0, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := pageBounds(tc.count, tc.pageNumber, tc.pageSize)
+			require.Equal(t, tc.expStart, start)
+			require.Equal(t, tc.expEnd, end)
+		})
+	}
+}
+
+// rangeRecordingIndexer records the block ranges searched by address.
+type rangeRecordingIndexer struct {
+	*indexer.KVIndexer
+	ranges [][2]int64
+}
+
+func (idxer *rangeRecordingIndexer) GetByAddress(
+	address common.Address,
+	fromBlock, toBlock int64,
+	reverse bool,
+	limit int,
+) ([]servertypes.AddressTx, bool, error) {
+	idxer.ranges = append(idxer.ranges, [2]int64{fromBlock, toBlock})
+	return idxer.KVIndexer.GetByAddress(address, fromBlock, toBlock, reverse, limit)
+}
+
+func TestGetTransactionHashBySenderAndNonceFallback(t *testing.T) {
+	sender := common.BytesToAddress([]byte{0x1})
+	testCases := []struct {
+		name      string
+		heights   []int64
+		expRanges [][2]int64
+	}{
+		{"no indexed block", nil, nil},
+		{"indexed from the first block", []int64{1, 2}, nil},
+		{"only the blocks before the index are searched", []int64{5, 6}, [][2]int64{{0, 4}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			for _, height := range tc.heights {
+				require.NoError(t, db.Set(indexer.AddressBlockKey(height), []byte{}))
+			}
+			idxer := &rangeRecordingIndexer{
+				KVIndexer: indexer.NewKVIndexer(db, log.NewNopLogger(), client.Context{}, indexer.WithAddressIndex(true)),
+			}
+			b := &Backend{Indexer: idxer}
+
+			hash, err := b.GetTransactionHashBySenderAndNonce(context.Background(), sender, 3)
+			require.NoError(t, err)
+			require.Nil(t, hash)
+			require.Equal(t, tc.expRanges, idxer.ranges)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// StorageRangeAt returns a range of the storage slots of the contract, as of
+// the state before the tx at txIndex of the given block executed. Only the
+// states at the block boundaries are available, i.e. txIndex must be 0 or at
+// least the number of eth txs of the block.
+func (b *Backend) StorageRangeAt(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (result *rpctypes.StorageRangeResult, err error) {
+	ctx, span := tracer.Start(ctx, "StorageRangeAt", trace.WithAttributes(
+		attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash)),
+		attribute.Int("txIndex", txIndex),
+		attribute.String("address", address.Hex()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	height, err := b.getHeightByBlockNum(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	txCount := b.GetBlockTransactionCountByNumber(ctx, rpctypes.BlockNumber(height))
+	if txCount == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	switch {
+	case txIndex < 0:
+		return nil, fmt.Errorf("invalid tx index %d", txIndex)
+	case txIndex >= int(*txCount):
+		// the state after all the txs of the block
+	case txIndex == 0:
+		// the state before the block, i.e. after its parent
+		if height <= 1 {
+			return nil, errors.New("state before the first block is not available")
+		}
+		height--
+	default:
+		return nil, fmt.Errorf("intermediate state of block %d is not available, tx index must be 0 or %d", height, *txCount)
+	}
+
+	if maxResult <= 0 || maxResult > rpctypes.StorageRangeMaxResults {
+		maxResult = rpctypes.StorageRangeMaxResults
+	}
+	res, err := b.QueryClient.StorageRange(rpctypes.ContextWithHeight(ctx, height), &evmtypes.QueryStorageRangeRequest{
+		Address:    address.Hex(),
+		Pagination: &query.PageRequest{Key: keyStart, Limit: uint64(maxResult)}, //nolint:gosec // G115 // max result is positive
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.StorageRangeResult{Storage: make(map[common.Hash]rpctypes.StorageEntry, len(res.Storage))}
+	for _, slot := range res.Storage {
+		key := common.HexToHash(slot.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{Key: &key, Value: common.HexToHash(slot.Value)}
+	}
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// AccountRange returns a range of the accounts at the given block, ordered by
+// address. The accounts are enumerated from the auth module, so externally
+// owned accounts are included next to the contracts.
+func (b *Backend) AccountRange(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage bool,
+) (result *state.Dump, err error) {
+	ctx, span := tracer.Start(ctx, "AccountRange", trace.WithAttributes(attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	height, err := b.getHeightByBlockNum(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if maxResults <= 0 || maxResults > rpctypes.AccountRangeMaxResults {
+		maxResults = rpctypes.AccountRangeMaxResults
+	}
+
+	result, err = b.newStateDump(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	result.Next, err = b.dumpAccounts(rpctypes.ContextWithHeight(ctx, height), result, start, maxResults, nocode, nostorage)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DumpBlock returns the accounts at the given block, together with their code
+// and storage. At most DumpBlockMaxResults accounts are returned, the next key
+// of the dump is set if there are more and can be passed to AccountRange.
+func (b *Backend) DumpBlock(ctx context.Context, blockNum rpctypes.BlockNumber) (result *state.Dump, err error) {
+	ctx, span := tracer.Start(ctx, "DumpBlock", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	height, err := b.getHeightByBlockNum(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	result, err = b.newStateDump(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.dumpAllAccounts(rpctypes.ContextWithHeight(ctx, height), result, rpctypes.DumpBlockMaxResults); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// newStateDump returns an empty dump rooted at the state root of the block.
+func (b *Backend) newStateDump(ctx context.Context, height int64) (*state.Dump, error) {
+	header, err := b.RPCClient.Header(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil || header.Header == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return &state.Dump{
+		Root:     common.BytesToHash(header.Header.AppHash).Hex(),
+		Accounts: make(map[string]state.DumpAccount),
+	}, nil
+}
+
+// dumpAllAccounts adds the accounts to the dump page by page, with their code
+// and storage, until maxResults accounts are dumped. The next key of the dump
+// is set if accounts are left. The context must carry the queried height.
+func (b *Backend) dumpAllAccounts(ctx context.Context, dump *state.Dump, maxResults int) error {
+	var (
+		next []byte
+		err  error
+	)
+	for len(dump.Accounts) < maxResults {
+		limit := min(rpctypes.AccountRangeMaxResults, maxResults-len(dump.Accounts))
+		next, err = b.dumpAccounts(ctx, dump, next, limit, false, false)
+		if err != nil {
+			return err
+		}
+		if len(next) == 0 {
+			return nil
+		}
+	}
+	dump.Next = next
+	return nil
+}
+
+// dumpAccounts adds a page of the auth accounts to the dump and returns the
+// key of the next page, empty if it was the last one. Accounts whose address
+// does not fit an EVM address are skipped. The context must carry the queried
+// height.
+func (b *Backend) dumpAccounts(
+	ctx context.Context,
+	dump *state.Dump,
+	start []byte,
+	limit int,
+	nocode, nostorage bool,
+) ([]byte, error) {
+	res, err := b.QueryClient.Auth.Accounts(ctx, &authtypes.QueryAccountsRequest{
+		Pagination: &query.PageRequest{Key: start, Limit: uint64(limit)}, //nolint:gosec // G115 // limit is positive
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, anyAcc := range res.Accounts {
+		var acc sdk.AccountI
+		if err := b.ClientCtx.InterfaceRegistry.UnpackAny(anyAcc, &acc); err != nil {
+			return nil, err
+		}
+		if len(acc.GetAddress()) != common.AddressLength {
+			continue
+		}
+		address := common.BytesToAddress(acc.GetAddress())
+		accRes, err := b.QueryClient.Account(ctx, &evmtypes.QueryAccountRequest{Address: address.Hex()})
+		if err != nil {
+			return nil, err
+		}
+		codeHash := common.HexToHash(accRes.CodeHash)
+		account := state.DumpAccount{
+			Balance:  accRes.Balance,
+			Nonce:    accRes.Nonce,
+			Root:     ethtypes.EmptyRootHash.Bytes(),
+			CodeHash: codeHash.Bytes(),
+			Address:  &address,
+		}
+		isContract := codeHash != ethtypes.EmptyCodeHash
+		if isContract && !nocode {
+			codeRes, err := b.QueryClient.Code(ctx, &evmtypes.QueryCodeRequest{Address: address.Hex()})
+			if err != nil {
+				return nil, err
+			}
+			account.Code = codeRes.Code
+		}
+		if isContract && !nostorage {
+			if account.Storage, err = b.dumpStorage(ctx, address.Hex()); err != nil {
+				return nil, err
+			}
+		}
+		dump.Accounts[address.Hex()] = account
+	}
+
+	if res.Pagination == nil {
+		return nil, nil
+	}
+	return res.Pagination.NextKey, nil
+}
+
+// dumpStorage returns all the storage slots of the contract. The context must
+// carry the queried height.
+func (b *Backend) dumpStorage(ctx context.Context, address string) (map[common.Hash]string, error) {
+	storage := make(map[common.Hash]string)
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address:    address,
+		Pagination: &query.PageRequest{Limit: rpctypes.StorageRangeMaxResults},
+	}
+	for {
+		res, err := b.QueryClient.StorageRange(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, slot := range res.Storage {
+			storage[common.HexToHash(slot.Key)] = slot.Value
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return storage, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
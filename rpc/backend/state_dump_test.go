@@ -0,0 +1,139 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/rpc/backend/mocks"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// accountsQueryClient serves the given auth accounts, paginated by address.
+type accountsQueryClient struct {
+	authtypes.QueryClient
+
+	accounts []sdk.AccountI
+}
+
+func (c *accountsQueryClient) Accounts(_ context.Context, req *authtypes.QueryAccountsRequest, _ ...grpc.CallOption) (*authtypes.QueryAccountsResponse, error) {
+	start := sort.Search(len(c.accounts), func(i int) bool {
+		return bytes.Compare(c.accounts[i].GetAddress(), req.Pagination.Key) >= 0
+	})
+	end := min(start+int(req.Pagination.Limit), len(c.accounts)) //nolint:gosec // G115 // test limits are small
+
+	res := &authtypes.QueryAccountsResponse{Pagination: &query.PageResponse{}}
+	for _, acc := range c.accounts[start:end] {
+		anyAcc, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return nil, err
+		}
+		res.Accounts = append(res.Accounts, anyAcc)
+	}
+	if end < len(c.accounts) {
+		res.Pagination.NextKey = c.accounts[end].GetAddress()
+	}
+	return res, nil
+}
+
+func TestDumpAccounts(t *testing.T) {
+	var (
+		alice    = common.BytesToAddress([]byte{0x1})
+		contract = common.BytesToAddress([]byte{0x2})
+		bob      = common.BytesToAddress([]byte{0x4})
+		code     = []byte{0x60, 0x00}
+		codeHash = common.BytesToHash([]byte{0xc0, 0xde})
+		slot     = common.BytesToHash([]byte{0x1})
+	)
+
+	accounts := []sdk.AccountI{
+		authtypes.NewBaseAccountWithAddress(alice.Bytes()),
+		authtypes.NewBaseAccountWithAddress(contract.Bytes()),
+		// accounts outside of the evm address space are skipped
+		authtypes.NewBaseAccountWithAddress(append(make([]byte, 31), 0x3)),
+		authtypes.NewBaseAccountWithAddress(bob.Bytes()),
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].GetAddress(), accounts[j].GetAddress()) < 0
+	})
+
+	evmClient := mocks.NewEVMQueryClient(t)
+	evmClient.EXPECT().Account(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, req *evmtypes.QueryAccountRequest, _ ...grpc.CallOption) (*evmtypes.QueryAccountResponse, error) {
+			res := &evmtypes.QueryAccountResponse{Balance: "100", CodeHash: ethtypes.EmptyCodeHash.Hex(), Nonce: 1}
+			if common.HexToAddress(req.Address) == contract {
+				res.CodeHash = codeHash.Hex()
+			}
+			return res, nil
+		}).Maybe()
+	evmClient.EXPECT().Code(mock.Anything, &evmtypes.QueryCodeRequest{Address: contract.Hex()}).
+		Return(&evmtypes.QueryCodeResponse{Code: code}, nil).Maybe()
+	evmClient.EXPECT().StorageRange(mock.Anything, mock.Anything).
+		Return(&evmtypes.QueryStorageRangeResponse{Storage: evmtypes.Storage{{Key: slot.Hex(), Value: slot.Hex()}}}, nil).Maybe()
+
+	encodingConfig := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	b := &Backend{
+		ClientCtx: client.Context{}.WithInterfaceRegistry(encodingConfig.InterfaceRegistry),
+		QueryClient: &rpctypes.QueryClient{
+			QueryClient: evmClient,
+			Auth:        &accountsQueryClient{accounts: accounts},
+		},
+	}
+
+	t.Run("page of accounts", func(t *testing.T) {
+		dump := &state.Dump{Accounts: make(map[string]state.DumpAccount)}
+		next, err := b.dumpAccounts(context.Background(), dump, nil, 3, false, false)
+		require.NoError(t, err)
+		require.Equal(t, bob.Bytes(), next)
+
+		require.Len(t, dump.Accounts, 2)
+		eoa := dump.Accounts[alice.Hex()]
+		require.Equal(t, "100", eoa.Balance)
+		require.Equal(t, uint64(1), eoa.Nonce)
+		require.Equal(t, ethtypes.EmptyCodeHash.Bytes(), []byte(eoa.CodeHash))
+		require.Empty(t, eoa.Code)
+		require.Empty(t, eoa.Storage)
+
+		contractAcc := dump.Accounts[contract.Hex()]
+		require.Equal(t, codeHash.Bytes(), []byte(contractAcc.CodeHash))
+		require.Equal(t, code, []byte(contractAcc.Code))
+		require.Equal(t, map[common.Hash]string{slot: slot.Hex()}, contractAcc.Storage)
+
+		next, err = b.dumpAccounts(context.Background(), dump, next, 3, true, true)
+		require.NoError(t, err)
+		require.Empty(t, next)
+		require.Len(t, dump.Accounts, 3)
+		require.Contains(t, dump.Accounts, bob.Hex())
+	})
+
+	t.Run("all accounts", func(t *testing.T) {
+		dump := &state.Dump{Accounts: make(map[string]state.DumpAccount)}
+		require.NoError(t, b.dumpAllAccounts(context.Background(), dump, rpctypes.DumpBlockMaxResults))
+		require.Len(t, dump.Accounts, 3)
+		require.Empty(t, dump.Next)
+	})
+
+	t.Run("capped accounts", func(t *testing.T) {
+		dump := &state.Dump{Accounts: make(map[string]state.DumpAccount)}
+		require.NoError(t, b.dumpAllAccounts(context.Background(), dump, 2))
+		require.Len(t, dump.Accounts, 2)
+		require.Equal(t, bob.Bytes(), dump.Next)
+	})
+}
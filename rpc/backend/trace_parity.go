@@ -0,0 +1,398 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	flatCallTracer = "flatCallTracer"
+	prestateTracer = "prestateTracer"
+	muxTracer      = "muxTracer"
+
+	flatCallTracerConfig = `{"convertParityErrors":true}`
+	prestateTracerConfig = `{"diffMode":true}`
+)
+
+// TraceBlockFlat returns the Parity style flat call traces of all the eth txs
+// of the given block.
+func (b *Backend) TraceBlockFlat(ctx context.Context, blockNum rpctypes.BlockNumber) (result []*rpctypes.ParityTrace, err error) {
+	ctx, span := tracer.Start(ctx, "TraceBlockFlat", trace.WithAttributes(attribute.Int64("blockNum", blockNum.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	block, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum.Int64())
+	}
+
+	config := &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: flatCallTracer},
+		TracerConfig: json.RawMessage(flatCallTracerConfig),
+	}
+	results, err := b.TraceBlock(ctx, rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.flattenBlockTraces(results, block)
+}
+
+// flattenBlockTraces decodes the flat call traces of the eth txs of the block.
+// A tx that fails to be traced is skipped with a warning instead of failing
+// the whole block, which would otherwise never be indexed.
+func (b *Backend) flattenBlockTraces(results []*evmtypes.TxTraceResult, block *tmrpctypes.ResultBlock) ([]*rpctypes.ParityTrace, error) {
+	traces := make([]*rpctypes.ParityTrace, 0, len(results))
+	for i, res := range results {
+		if res == nil {
+			continue
+		}
+		if res.Error != "" {
+			b.Logger.Warn("failed to trace tx, skipping it", "height", block.Block.Height, "index", i, "error", res.Error)
+			continue
+		}
+		var txTraces []*rpctypes.ParityTrace
+		if err := rpctypes.DecodeTracerResult(res.Result, &txTraces); err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	setTraceBlock(traces, block)
+
+	return traces, nil
+}
+
+// TraceTransactionFlat returns the Parity style flat call traces of the given
+// eth tx.
+func (b *Backend) TraceTransactionFlat(ctx context.Context, hash common.Hash) (result []*rpctypes.ParityTrace, err error) {
+	ctx, span := tracer.Start(ctx, "TraceTransactionFlat", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	transaction, err := b.GetTxByEthHash(ctx, hash)
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	config := &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: flatCallTracer},
+		TracerConfig: json.RawMessage(flatCallTracerConfig),
+	}
+	res, err := b.TraceTransaction(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []*rpctypes.ParityTrace
+	if err := rpctypes.DecodeTracerResult(res, &traces); err != nil {
+		return nil, err
+	}
+
+	block, err := b.CometBlockByNumber(ctx, rpctypes.BlockNumber(transaction.Height))
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", transaction.Height)
+	}
+	setTraceBlock(traces, block)
+	for _, t := range traces {
+		t.TransactionHash = &hash
+		t.TransactionPosition = uint64(transaction.EthTxIndex) //nolint:gosec // G115 // eth tx index is never negative
+	}
+
+	return traces, nil
+}
+
+// TraceReplayBlockTransactions replays all the eth txs of the given block and
+// returns the requested trace types for each of them.
+func (b *Backend) TraceReplayBlockTransactions(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	traceTypes []string,
+) (result []*rpctypes.TraceReplayResult, err error) {
+	ctx, span := tracer.Start(ctx, "TraceReplayBlockTransactions", trace.WithAttributes(attribute.String("blockNrOrHash", unwrapBlockNOrHash(blockNrOrHash))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var withTrace, withStateDiff bool
+	for _, typ := range traceTypes {
+		switch typ {
+		case rpctypes.TraceTypeTrace:
+			withTrace = true
+		case rpctypes.TraceTypeStateDiff:
+			withStateDiff = true
+		case rpctypes.TraceTypeVMTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", typ)
+		}
+	}
+
+	blockNum, err := b.BlockNumberFromComet(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := b.CometBlockByNumber(ctx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNum.Int64())
+	}
+
+	tracerConfig := map[string]json.RawMessage{flatCallTracer: json.RawMessage(flatCallTracerConfig)}
+	if withStateDiff {
+		tracerConfig[prestateTracer] = json.RawMessage(prestateTracerConfig)
+	}
+	bz, err := json.Marshal(tracerConfig)
+	if err != nil {
+		return nil, err
+	}
+	config := &rpctypes.TraceConfig{
+		TraceConfig:  evmtypes.TraceConfig{Tracer: muxTracer},
+		TracerConfig: bz,
+	}
+	results, err := b.TraceBlock(ctx, rpctypes.BlockNumber(block.Block.Height), config, block)
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*rpctypes.TraceReplayResult, 0, len(results))
+	for i, res := range results {
+		if res == nil {
+			continue
+		}
+		if res.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %d of block %d: %s", i, block.Block.Height, res.Error)
+		}
+
+		var muxResult struct {
+			Traces    []*rpctypes.ParityTrace `json:"flatCallTracer"`
+			StateDiff *rpctypes.PrestateDiff  `json:"prestateTracer"`
+		}
+		if err := rpctypes.DecodeTracerResult(res.Result, &muxResult); err != nil {
+			return nil, err
+		}
+		setTraceBlock(muxResult.Traces, block)
+
+		replay := &rpctypes.TraceReplayResult{}
+		if len(muxResult.Traces) > 0 {
+			top := muxResult.Traces[0]
+			replay.TransactionHash = top.TransactionHash
+			if top.Result != nil {
+				switch {
+				case top.Result.Output != nil:
+					replay.Output = *top.Result.Output
+				case top.Result.Code != nil:
+					replay.Output = *top.Result.Code
+				}
+			}
+		}
+		if withTrace {
+			replay.Trace = muxResult.Traces
+		}
+		if withStateDiff {
+			replay.StateDiff = rpctypes.NewStateDiff(muxResult.StateDiff)
+		}
+		replays = append(replays, replay)
+	}
+
+	return replays, nil
+}
+
+// TraceFilter returns the Parity style flat call traces matching the given
+// filter. When the trace index is enabled and the filter constrains the
+// addresses, only the blocks containing candidate txs are replayed within the
+// indexed block range.
+func (b *Backend) TraceFilter(ctx context.Context, args rpctypes.TraceFilterArgs) (result []*rpctypes.ParityTrace, err error) {
+	ctx, span := tracer.Start(ctx, "TraceFilter")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	fromBlock, toBlock := rpctypes.EthLatestBlockNumber, rpctypes.EthLatestBlockNumber
+	if args.FromBlock != nil {
+		fromBlock = *args.FromBlock
+	}
+	if args.ToBlock != nil {
+		toBlock = *args.ToBlock
+	}
+	from, err := b.getHeightByBlockNum(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.getHeightByBlockNum(ctx, toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is after to block %d", from, to)
+	}
+
+	plan, err := b.traceFilterPlan(args, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if rangeCap := int(b.RPCBlockRangeCap()); rangeCap > 0 && len(plan.heights) > rangeCap {
+		return nil, fmt.Errorf("trace_filter would replay %d blocks, exceeding the block range cap of %d", len(plan.heights), rangeCap)
+	}
+
+	var after, skipped uint64
+	if args.After != nil {
+		after = uint64(*args.After)
+	}
+
+	result = []*rpctypes.ParityTrace{}
+	for _, height := range plan.heights {
+		traces, err := b.TraceBlockFlat(ctx, rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range traces {
+			if plan.skip(height, t.TransactionPosition) || !args.Matches(t) {
+				continue
+			}
+			if skipped < after {
+				skipped++
+				continue
+			}
+			result = append(result, t)
+			if args.Count != nil && uint64(len(result)) >= uint64(*args.Count) {
+				return result, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// traceFilterPlan is the set of blocks to replay in order to serve a
+// trace_filter request.
+type traceFilterPlan struct {
+	heights []int64
+	// indexFrom and indexTo delimit the block range served by the trace index
+	indexFrom, indexTo int64
+	// candidates are the eth txs of the indexed range that may match the filter
+	candidates map[servertypes.TracePosition]struct{}
+}
+
+// skip returns true if the eth tx is known not to match the filter.
+func (p *traceFilterPlan) skip(height int64, txIndex uint64) bool {
+	if height < p.indexFrom || height > p.indexTo {
+		return false
+	}
+	_, ok := p.candidates[servertypes.TracePosition{Height: height, EthTxIndex: int32(txIndex)}] //nolint:gosec // G115 // eth tx index won't exceed int32
+	return !ok
+}
+
+func (b *Backend) traceFilterPlan(args rpctypes.TraceFilterArgs, from, to int64) (*traceFilterPlan, error) {
+	plan := &traceFilterPlan{indexFrom: 1, indexTo: 0}
+	if b.TraceIndexer != nil && (len(args.FromAddress) > 0 || len(args.ToAddress) > 0) {
+		first, err := b.TraceIndexer.FirstIndexedBlock()
+		if err != nil {
+			return nil, err
+		}
+		last, err := b.TraceIndexer.LastIndexedBlock()
+		if err != nil {
+			return nil, err
+		}
+		if first != -1 && last != -1 {
+			plan.indexFrom, plan.indexTo = max(from, first), min(to, last)
+		}
+	}
+
+	if plan.indexFrom > plan.indexTo {
+		for height := from; height <= to; height++ {
+			plan.heights = append(plan.heights, height)
+		}
+		return plan, nil
+	}
+
+	fromSet, err := b.traceIndexLookup(args.FromAddress, b.TraceIndexer.GetByFromAddress, plan.indexFrom, plan.indexTo)
+	if err != nil {
+		return nil, err
+	}
+	toSet, err := b.traceIndexLookup(args.ToAddress, b.TraceIndexer.GetByToAddress, plan.indexFrom, plan.indexTo)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case fromSet == nil:
+		plan.candidates = toSet
+	case toSet == nil:
+		plan.candidates = fromSet
+	default:
+		plan.candidates = make(map[servertypes.TracePosition]struct{})
+		for pos := range fromSet {
+			if _, ok := toSet[pos]; ok {
+				plan.candidates[pos] = struct{}{}
+			}
+		}
+	}
+
+	for height := from; height < plan.indexFrom; height++ {
+		plan.heights = append(plan.heights, height)
+	}
+	seen := make(map[int64]struct{})
+	var indexed []int64
+	for pos := range plan.candidates {
+		if _, ok := seen[pos.Height]; !ok {
+			seen[pos.Height] = struct{}{}
+			indexed = append(indexed, pos.Height)
+		}
+	}
+	slices.Sort(indexed)
+	plan.heights = append(plan.heights, indexed...)
+	for height := plan.indexTo + 1; height <= to; height++ {
+		plan.heights = append(plan.heights, height)
+	}
+
+	return plan, nil
+}
+
+// traceIndexLookup returns the union of the eth txs indexed for the given
+// addresses, or nil if no address is given.
+func (b *Backend) traceIndexLookup(
+	addresses []common.Address,
+	lookup func(common.Address, int64, int64) ([]servertypes.TracePosition, error),
+	from, to int64,
+) (map[servertypes.TracePosition]struct{}, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+	set := make(map[servertypes.TracePosition]struct{})
+	for _, addr := range addresses {
+		positions, err := lookup(addr, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, pos := range positions {
+			set[pos] = struct{}{}
+		}
+	}
+	return set, nil
+}
+
+// setTraceBlock sets the block number and hash of the traces, which are not
+// known to the tracer.
+func setTraceBlock(traces []*rpctypes.ParityTrace, block *tmrpctypes.ResultBlock) {
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	for _, t := range traces {
+		t.BlockHash = &blockHash
+		t.BlockNumber = uint64(block.Block.Height) //nolint:gosec // G115 // block height is never negative
+	}
+}
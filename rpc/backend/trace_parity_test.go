@@ -0,0 +1,170 @@
+package backend
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+func TestTraceFilterPlan(t *testing.T) {
+	var (
+		alice = common.BytesToAddress([]byte{0x1})
+		bob   = common.BytesToAddress([]byte{0x2})
+	)
+
+	// the index covers the blocks 5 to 7
+	traceIdxr := indexer.NewTraceIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	require.NoError(t, traceIdxr.IndexBlock(5, []servertypes.TraceTxAddresses{
+		{EthTxIndex: 0, From: []common.Address{alice}, To: []common.Address{bob}},
+		{EthTxIndex: 1, From: []common.Address{bob}, To: []common.Address{alice}},
+	}))
+	require.NoError(t, traceIdxr.IndexBlock(6, nil))
+	require.NoError(t, traceIdxr.IndexBlock(7, []servertypes.TraceTxAddresses{
+		{EthTxIndex: 0, From: []common.Address{alice}, To: []common.Address{alice}},
+	}))
+
+	type position struct {
+		height  int64
+		txIndex uint64
+	}
+	testCases := []struct {
+		name       string
+		traceIdxr  servertypes.TraceIndexer
+		args       rpctypes.TraceFilterArgs
+		from, to   int64
+		expHeights []int64
+		expSkipped []position
+		expKept    []position
+	}{
+		{
+			name:       "no trace index",
+			args:       rpctypes.TraceFilterArgs{FromAddress: []common.Address{alice}},
+			from:       3,
+			to:         9,
+			expHeights: []int64{3, 4, 5, 6, 7, 8, 9},
+			expKept:    []position{{5, 0}, {5, 1}, {7, 0}},
+		},
+		{
+			name:       "empty trace index",
+			traceIdxr:  indexer.NewTraceIndexer(dbm.NewMemDB(), log.NewNopLogger()),
+			args:       rpctypes.TraceFilterArgs{FromAddress: []common.Address{alice}},
+			from:       3,
+			to:         9,
+			expHeights: []int64{3, 4, 5, 6, 7, 8, 9},
+			expKept:    []position{{5, 0}, {5, 1}, {7, 0}},
+		},
+		{
+			name:       "no address constraint",
+			traceIdxr:  traceIdxr,
+			from:       3,
+			to:         9,
+			expHeights: []int64{3, 4, 5, 6, 7, 8, 9},
+			expKept:    []position{{5, 0}, {5, 1}, {7, 0}},
+		},
+		{
+			name:       "sender only replays the indexed candidates",
+			traceIdxr:  traceIdxr,
+			args:       rpctypes.TraceFilterArgs{FromAddress: []common.Address{alice}},
+			from:       3,
+			to:         9,
+			expHeights: []int64{3, 4, 5, 7, 8, 9},
+			expSkipped: []position{{5, 1}, {6, 0}},
+			expKept:    []position{{3, 0}, {5, 0}, {7, 0}, {8, 0}},
+		},
+		{
+			name:       "several senders",
+			traceIdxr:  traceIdxr,
+			args:       rpctypes.TraceFilterArgs{FromAddress: []common.Address{alice, bob}},
+			from:       5,
+			to:         7,
+			expHeights: []int64{5, 7},
+			expKept:    []position{{5, 0}, {5, 1}, {7, 0}},
+		},
+		{
+			name:      "sender and recipient intersect",
+			traceIdxr: traceIdxr,
+			args: rpctypes.TraceFilterArgs{
+				FromAddress: []common.Address{alice},
+				ToAddress:   []common.Address{alice},
+			},
+			from:       3,
+			to:         9,
+			expHeights: []int64{3, 4, 7, 8, 9},
+			expSkipped: []position{{5, 0}, {5, 1}},
+			expKept:    []position{{7, 0}},
+		},
+		{
+			name:       "recipient within the indexed range",
+			traceIdxr:  traceIdxr,
+			args:       rpctypes.TraceFilterArgs{ToAddress: []common.Address{bob}},
+			from:       6,
+			to:         7,
+			expHeights: nil,
+			expSkipped: []position{{7, 0}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := &Backend{TraceIndexer: tc.traceIdxr}
+			plan, err := b.traceFilterPlan(tc.args, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHeights, plan.heights)
+			for _, pos := range tc.expSkipped {
+				require.True(t, plan.skip(pos.height, pos.txIndex), "tx %d of block %d", pos.txIndex, pos.height)
+			}
+			for _, pos := range tc.expKept {
+				require.False(t, plan.skip(pos.height, pos.txIndex), "tx %d of block %d", pos.txIndex, pos.height)
+			}
+		})
+	}
+}
+
+func TestFlattenBlockTraces(t *testing.T) {
+	var (
+		alice = common.BytesToAddress([]byte{0x1})
+		bob   = common.BytesToAddress([]byte{0x2})
+	)
+	txTrace := func(txIndex uint64, from, to common.Address) json.RawMessage {
+		bz, err := json.Marshal([]*rpctypes.ParityTrace{{
+			Action:              rpctypes.ParityTraceAction{From: &from, To: &to},
+			TransactionPosition: txIndex,
+			Type:                rpctypes.ParityTraceTypeCall,
+		}})
+		require.NoError(t, err)
+		return bz
+	}
+	block := &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: common.Hash{0x1}.Bytes()},
+		Block:   &tmtypes.Block{Header: tmtypes.Header{Height: 5}},
+	}
+
+	// the failing tx in the middle of the block doesn't fail the block
+	b := &Backend{Logger: log.NewNopLogger()}
+	traces, err := b.flattenBlockTraces([]*evmtypes.TxTraceResult{
+		{Result: txTrace(0, alice, bob)},
+		{Error: "execution timeout"},
+		nil,
+		{Result: txTrace(2, bob, alice)},
+	}, block)
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	require.Equal(t, uint64(0), traces[0].TransactionPosition)
+	require.Equal(t, uint64(2), traces[1].TransactionPosition)
+	for _, tr := range traces {
+		require.Equal(t, uint64(5), tr.BlockNumber)
+		require.Equal(t, common.Hash{0x1}, *tr.BlockHash)
+	}
+}
@@ -0,0 +1,125 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtrace "github.com/cosmos/evm/trace"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// defaultAssetTransfersMaxCount is the page size of eth_getAssetTransfers
+	// when it's not set.
+	defaultAssetTransfersMaxCount = 1000
+	// maxAssetTransfersMaxCount caps the page size of eth_getAssetTransfers.
+	maxAssetTransfersMaxCount = 1000
+)
+
+// errTransferIndexDisabled is returned when the tx indexer doesn't maintain
+// the transfer index.
+var errTransferIndexDisabled = errors.New("transfer index is not enabled, enable the custom eth tx indexer and its transfer index")
+
+// assetTransferCategories are the categories of the indexed transfers.
+var assetTransferCategories = []string{
+	servertypes.TransferCategoryExternal,
+	servertypes.TransferCategoryERC20,
+	servertypes.TransferCategoryERC721,
+	servertypes.TransferCategoryERC1155,
+	servertypes.TransferCategoryNative,
+}
+
+// GetAssetTransfers returns a page of the token and native coin transfers
+// matching the arguments, from the transfer index of the indexer.
+func (b *Backend) GetAssetTransfers(ctx context.Context, args rpctypes.AssetTransfersArgs) (result *rpctypes.AssetTransfersResult, err error) {
+	ctx, span := tracer.Start(ctx, "GetAssetTransfers", trace.WithAttributes(attribute.String("order", args.Order)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMTransferIndexer)
+	if !ok || !idxer.TransferIndexEnabled() {
+		return nil, errTransferIndexDisabled
+	}
+
+	filter := servertypes.TransferFilter{
+		FromAddress: args.FromAddress,
+		ToAddress:   args.ToAddress,
+		Contracts:   args.ContractAddresses,
+		Categories:  args.Category,
+	}
+	for _, category := range args.Category {
+		if !slices.Contains(assetTransferCategories, category) {
+			return nil, fmt.Errorf("invalid category %q, expect one of %v", category, assetTransferCategories)
+		}
+	}
+	switch args.Order {
+	case "", "asc":
+	case "desc":
+		filter.Reverse = true
+	default:
+		return nil, fmt.Errorf("invalid order %q, expect asc or desc", args.Order)
+	}
+
+	if args.FromBlock != nil {
+		if filter.FromBlock, err = b.getHeightByBlockNum(ctx, *args.FromBlock); err != nil {
+			return nil, err
+		}
+	}
+	toBlock := rpctypes.EthLatestBlockNumber
+	if args.ToBlock != nil {
+		toBlock = *args.ToBlock
+	}
+	if filter.ToBlock, err = b.getHeightByBlockNum(ctx, toBlock); err != nil {
+		return nil, err
+	}
+
+	if args.PageKey != "" {
+		if filter.Start, err = parseTransfersPageKey(args.PageKey); err != nil {
+			return nil, err
+		}
+	}
+	maxCount := uint64(defaultAssetTransfersMaxCount)
+	if args.MaxCount != nil && *args.MaxCount > 0 {
+		maxCount = min(uint64(*args.MaxCount), maxAssetTransfersMaxCount)
+	}
+
+	transfers, next, err := idxer.GetTransfers(filter, int(maxCount)) //nolint:gosec // G115 // max count is capped
+	if err != nil {
+		return nil, err
+	}
+	result = &rpctypes.AssetTransfersResult{
+		Transfers: make([]*rpctypes.AssetTransfer, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		result.Transfers = append(result.Transfers, rpctypes.NewAssetTransfer(transfer))
+	}
+	if next != nil {
+		result.PageKey = transfersPageKey(next)
+	}
+	return result, nil
+}
+
+// transfersPageKey encodes the position of the first transfer of a page.
+func transfersPageKey(pos *servertypes.TransferPosition) string {
+	key := append(sdk.Uint64ToBigEndian(uint64(pos.Height)), sdk.Uint64ToBigEndian(pos.Index)...) //nolint:gosec // G115 // block height is never negative
+	return hexutil.Encode(key)
+}
+
+func parseTransfersPageKey(pageKey string) (*servertypes.TransferPosition, error) {
+	key, err := hexutil.Decode(pageKey)
+	if err != nil || len(key) != 16 {
+		return nil, fmt.Errorf("invalid page key %q", pageKey)
+	}
+	return &servertypes.TransferPosition{
+		Height: int64(sdk.BigEndianToUint64(key[:8])), //#nosec G115 -- int overflow is not a concern here
+		Index:  sdk.BigEndianToUint64(key[8:]),
+	}, nil
+}
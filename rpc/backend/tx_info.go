@@ -33,6 +33,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// defaultAddressTxsPageSize is the page size of eth_getTransactionsByAddress
+	// when it's not set.
+	defaultAddressTxsPageSize = 100
+	// maxAddressTxsPageSize caps the page size of eth_getTransactionsByAddress.
+	maxAddressTxsPageSize = 1000
+)
+
 // GetTransactionByHash returns the Ethereum format transaction identified by Ethereum transaction hash
 func (b *Backend) GetTransactionByHash(ctx context.Context, txHash common.Hash) (result *rpctypes.RPCTransaction, err error) {
 	ctx, span := tracer.Start(ctx, "GetTransactionByHash", trace.WithAttributes(attribute.String("txHash", txHash.Hex())))
@@ -154,6 +162,10 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 	hexTx := hash.Hex()
 	b.Logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if cached := b.cache.getReceipt(hash); cached != nil {
+		return cached, nil
+	}
+
 	// Retry logic for transaction lookup with exponential backoff
 	maxRetries := 10
 	baseDelay := 50 * time.Millisecond
@@ -222,7 +234,12 @@ func (b *Backend) GetTransactionReceipt(ctx context.Context, hash common.Hash) (
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 
-	return rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	result, err = rpctypes.RPCMarshalReceipt(receipts[0], ethTx, from)
+	if err != nil {
+		return nil, err
+	}
+	b.cache.addReceipt(hash, result)
+	return result, nil
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -371,7 +388,7 @@ func (b *Backend) QueryCometTxIndexer(ctx context.Context, query string, txGette
 		return nil, err
 	}
 	if len(resTxs.Txs) == 0 {
-		return nil, errors.New("ethereum tx not found")
+		return nil, fmt.Errorf("ethereum %w", servertypes.ErrTxNotFound)
 	}
 	txResult := resTxs.Txs[0]
 	if !rpctypes.TxSucessOrExpectedFailure(&txResult.TxResult) {
@@ -622,3 +639,135 @@ func (b *Backend) initAccessListTracer(ctx context.Context, args evmtypes.Transa
 	b.Logger.Debug("access list tracer initialized", "tracer", tracer)
 	return tracer, &args, nil
 }
+
+// GetTransactionBySenderAndNonce returns the eth tx sent by the given address
+// with the given nonce, either indexed or in the mempool, returns nil if it's
+// not found.
+func (b *Backend) GetTransactionBySenderAndNonce(ctx context.Context, sender common.Address, nonce uint64) (result *rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionBySenderAndNonce", trace.WithAttributes(
+		attribute.String("sender", sender.Hex()),
+		attribute.Int64("nonce", int64(nonce)), //nolint:gosec // G115 // nonce won't exceed int64
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hash, err := b.GetTransactionHashBySenderAndNonce(ctx, sender, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if hash != nil {
+		return b.GetTransactionByHash(ctx, *hash)
+	}
+
+	// the tx may not be included yet
+	if b.Mempool == nil {
+		return nil, nil
+	}
+	pending, queued := b.Mempool.GetTxPool().ContentFrom(sender)
+	for _, tx := range append(pending, queued...) {
+		if tx.Nonce() != nonce {
+			continue
+		}
+		curHeader, err := b.CurrentHeader(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current header: %w", err)
+		}
+		return rpctypes.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig()), nil
+	}
+	return nil, nil
+}
+
+// GetTransactionsByAddress returns a page of the eth txs the given address
+// appears in as sender, recipient or created contract, within the block range
+// of the arguments.
+func (b *Backend) GetTransactionsByAddress(
+	ctx context.Context,
+	address common.Address,
+	args rpctypes.AddressTransactionsArgs,
+) (result *rpctypes.AddressTransactionsResult, err error) {
+	ctx, span := tracer.Start(ctx, "GetTransactionsByAddress", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.Bool("reverse", args.Reverse),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	idxer, ok := b.Indexer.(servertypes.EVMAddressIndexer)
+	if !ok || !idxer.AddressIndexEnabled() {
+		return nil, errAddressIndexUnsupported
+	}
+
+	var fromBlock int64
+	if args.FromBlock != nil {
+		if fromBlock, err = b.getHeightByBlockNum(ctx, *args.FromBlock); err != nil {
+			return nil, err
+		}
+	}
+	toBlockNum := rpctypes.EthLatestBlockNumber
+	if args.ToBlock != nil {
+		toBlockNum = *args.ToBlock
+	}
+	toBlock, err := b.getHeightByBlockNum(ctx, toBlockNum)
+	if err != nil {
+		return nil, err
+	}
+	if args.PageKey != nil {
+		if uint64(*args.PageKey) > math.MaxInt64 {
+			return nil, fmt.Errorf("page key %d is out of range", uint64(*args.PageKey))
+		}
+		// the page key is the block the next page starts from
+		if key := int64(*args.PageKey); args.Reverse { //nolint:gosec // G115 // checked above
+			toBlock = min(toBlock, key)
+		} else {
+			fromBlock = max(fromBlock, key)
+		}
+	}
+
+	pageSize := uint64(defaultAddressTxsPageSize)
+	if args.PageSize != nil && *args.PageSize > 0 {
+		pageSize = min(uint64(*args.PageSize), maxAddressTxsPageSize)
+	}
+	positions, more, err := idxer.GetByAddress(address, fromBlock, toBlock, args.Reverse, int(pageSize)) //nolint:gosec // G115 // page size is capped
+	if err != nil {
+		return nil, err
+	}
+
+	result = &rpctypes.AddressTransactionsResult{
+		Transactions: make([]*rpctypes.AddressTransaction, 0, len(positions)),
+	}
+	for _, pos := range positions {
+		tx, err := b.GetTransactionByBlockNumberAndIndex(ctx, rpctypes.BlockNumber(pos.Height), hexutil.Uint(pos.EthTxIndex)) //nolint:gosec // G115 // eth tx index is never negative
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("tx %d of block %d not found", pos.EthTxIndex, pos.Height)
+		}
+		result.Transactions = append(result.Transactions, &rpctypes.AddressTransaction{
+			RPCTransaction: tx,
+			Roles:          addressRoles(pos.Roles),
+		})
+	}
+	if more && len(positions) > 0 {
+		next := positions[len(positions)-1].Height + 1
+		if args.Reverse {
+			next = positions[len(positions)-1].Height - 1
+		}
+		pageKey := hexutil.Uint64(next) //nolint:gosec // G115 // the next block of a page is never negative
+		result.PageKey = &pageKey
+	}
+	return result, nil
+}
+
+// addressRoles returns the names of the roles of an address in an eth tx.
+func addressRoles(roles uint8) []string {
+	names := make([]string, 0, 3)
+	if roles&servertypes.AddressRoleFrom != 0 {
+		names = append(names, "from")
+	}
+	if roles&servertypes.AddressRoleTo != 0 {
+		names = append(names, "to")
+	}
+	if roles&servertypes.AddressRoleCreated != 0 {
+		names = append(names, "created")
+	}
+	return names
+}
@@ -11,13 +11,17 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
 const (
 	StatusPending = "pending"
 	StatusQueued  = "queued"
+	StatusEvicted = "evicted"
 )
 
 // The code style for this API is based off of the Go-Ethereum implementation:
@@ -161,6 +165,20 @@ func (b *Backend) Inspect(_ context.Context) (map[string]map[string]map[string]s
 		inspect[StatusQueued][account.Hex()] = dump
 	}
 
+	// Flatten the evicted transactions with the reason they were removed for,
+	// the latest removal of a nonce wins
+	if em, ok := evmMempool.(EvictionMempool); ok {
+		evicted := make(map[string]map[string]string)
+		for _, tx := range em.EvictedTxs() {
+			account := tx.From.Hex()
+			if evicted[account] == nil {
+				evicted[account] = make(map[string]string)
+			}
+			evicted[account][fmt.Sprintf("%d", tx.Nonce)] = fmt.Sprintf("%s: %s", tx.Hash.Hex(), tx.Reason)
+		}
+		inspect[StatusEvicted] = evicted
+	}
+
 	return inspect, nil
 }
 
@@ -181,3 +199,97 @@ func (b *Backend) Status(_ context.Context) (map[string]hexutil.Uint, error) {
 		StatusQueued:  hexutil.Uint(queued),  // #nosec G115 -- overflow not a concern for tx counts, as the mempool will limit far before this number is hit. This is taken directly from Geth.
 	}, nil
 }
+
+// TxStatus returns the stage of its lifecycle the tx is in. The app-side
+// mempool reports why the txs exited it for its retention window, the txs it
+// has no record of are looked up in the tx indexer.
+func (b *Backend) TxStatus(ctx context.Context, hash common.Hash) (result *types.TxStatusResult, err error) {
+	ctx, span := tracer.Start(ctx, "TxStatus", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	status := mempool.TxStatus{State: mempool.TxStateUnknown}
+	if sm, ok := b.Mempool.(TxStatusMempool); ok {
+		status = sm.TxStatus(hash)
+	} else if b.Mempool != nil {
+		switch b.Mempool.GetTxPool().Status(hash) {
+		case txpool.TxStatusPending:
+			status.State = mempool.TxStatePending
+		case txpool.TxStatusQueued:
+			status.State = mempool.TxStateQueued
+		}
+	}
+
+	if status.State == mempool.TxStateUnknown {
+		if res, err := b.GetTxByEthHash(ctx, hash); err == nil && res.Height > 0 {
+			status.State = mempool.TxStateIncluded
+			status.BlockNumber = uint64(res.Height)
+		}
+	}
+
+	result = &types.TxStatusResult{Status: string(status.State), Reason: string(status.Reason)}
+	switch status.State {
+	case mempool.TxStatePending, mempool.TxStateQueued, mempool.TxStateUnderpriced:
+		nonce := hexutil.Uint64(status.Nonce)
+		result.Nonce = &nonce
+	case mempool.TxStateIncluded:
+		blockNumber := hexutil.Uint64(status.BlockNumber)
+		result.BlockNumber = &blockNumber
+	case mempool.TxStateReplaced:
+		result.ReplacedBy = &status.ReplacedBy
+	}
+	if status.ExpectedNonce != nil {
+		result.ExpectedNonce = (*hexutil.Uint64)(status.ExpectedNonce)
+	}
+	if status.BaseFee != nil {
+		result.BaseFee = (*hexutil.Big)(status.BaseFee)
+	}
+	if !status.RemovedAt.IsZero() {
+		removedAt := hexutil.Uint64(status.RemovedAt.Unix()) //nolint:gosec // G115 // unix time is never negative
+		result.RemovedAt = &removedAt
+	}
+	return result, nil
+}
+
+// GetPendingTransactions returns the txs of the given hashes that are still in
+// the mempool, in the same order. The txs that already left the mempool are
+// skipped.
+func (b *Backend) GetPendingTransactions(ctx context.Context, hashes []common.Hash) (result []*types.RPCTransaction, err error) {
+	ctx, span := tracer.Start(ctx, "GetPendingTransactions", trace.WithAttributes(attribute.Int("count", len(hashes))))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	curHeader, err := b.CurrentHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current header: %w", err)
+	}
+
+	result = make([]*types.RPCTransaction, 0, len(hashes))
+	if b.Mempool != nil {
+		txPool := b.Mempool.GetTxPool()
+		for _, hash := range hashes {
+			if tx := txPool.Get(hash); tx != nil {
+				result = append(result, types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig()))
+			}
+		}
+		return result, nil
+	}
+
+	// fallback to the CometBFT mempool
+	txs, err := b.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := make(map[common.Hash]*ethtypes.Transaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				pending[ethMsg.Hash()] = ethMsg.AsTransaction()
+			}
+		}
+	}
+	for _, hash := range hashes {
+		if tx, ok := pending[hash]; ok {
+			result = append(result, types.NewRPCPendingTransaction(tx, curHeader, b.ChainConfig()))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,143 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// statusMempool is an app-side mempool with an empty evm txpool, that reports
+// the given statuses and evicted txs.
+type statusMempool struct {
+	sdkmempool.Mempool
+
+	statuses map[common.Hash]mempool.TxStatus
+	evicted  []mempool.EvictedTx
+}
+
+func (m *statusMempool) GetTxPool() *txpool.TxPool { return &txpool.TxPool{} }
+
+func (m *statusMempool) TxStatus(hash common.Hash) mempool.TxStatus {
+	if status, ok := m.statuses[hash]; ok {
+		return status
+	}
+	return mempool.TxStatus{State: mempool.TxStateUnknown}
+}
+
+func (m *statusMempool) EvictedTxs() []mempool.EvictedTx { return m.evicted }
+
+func TestTxStatus(t *testing.T) {
+	var (
+		pendingHash     = common.HexToHash("0x1")
+		queuedHash      = common.HexToHash("0x2")
+		underpricedHash = common.HexToHash("0x3")
+		replacedHash    = common.HexToHash("0x4")
+		evictedHash     = common.HexToHash("0x5")
+		removedAt       = time.Unix(1700000000, 0)
+	)
+	expectedNonce := uint64(3)
+	b := &Backend{
+		// the status is reported even if the app-side mempool isn't the
+		// exclusive mempool of the node
+		UseAppMempool: false,
+		Mempool: &statusMempool{statuses: map[common.Hash]mempool.TxStatus{
+			pendingHash: {State: mempool.TxStatePending, Nonce: 1},
+			queuedHash:  {State: mempool.TxStateQueued, Nonce: 5, ExpectedNonce: &expectedNonce},
+			underpricedHash: {
+				State:   mempool.TxStateUnderpriced,
+				Nonce:   2,
+				BaseFee: big.NewInt(100),
+			},
+			replacedHash: {State: mempool.TxStateReplaced, ReplacedBy: pendingHash, RemovedAt: removedAt},
+			evictedHash:  {State: mempool.TxStateEvicted, Reason: txpool.RemovalReason("lifetime"), RemovedAt: removedAt},
+		}},
+	}
+
+	uint64Ptr := func(v uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&v) }
+	testCases := []struct {
+		name     string
+		hash     common.Hash
+		expected *rpctypes.TxStatusResult
+	}{
+		{"pending", pendingHash, &rpctypes.TxStatusResult{Status: "pending", Nonce: uint64Ptr(1)}},
+		{
+			"queued with the expected nonce",
+			queuedHash,
+			&rpctypes.TxStatusResult{Status: "queued", Nonce: uint64Ptr(5), ExpectedNonce: uint64Ptr(3)},
+		},
+		{
+			"underpriced with the base fee",
+			underpricedHash,
+			&rpctypes.TxStatusResult{Status: "underpriced", Nonce: uint64Ptr(2), BaseFee: (*hexutil.Big)(big.NewInt(100))},
+		},
+		{
+			"replaced",
+			replacedHash,
+			&rpctypes.TxStatusResult{Status: "replaced", ReplacedBy: &pendingHash, RemovedAt: uint64Ptr(1700000000)},
+		},
+		{
+			"evicted with the removal reason",
+			evictedHash,
+			&rpctypes.TxStatusResult{Status: "evicted", Reason: "lifetime", RemovedAt: uint64Ptr(1700000000)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := b.TxStatus(context.Background(), tc.hash)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestInspectEvicted(t *testing.T) {
+	var (
+		alice = common.HexToAddress("0x1")
+		bob   = common.HexToAddress("0x2")
+		hash1 = common.HexToHash("0x11")
+		hash2 = common.HexToHash("0x12")
+		hash3 = common.HexToHash("0x13")
+	)
+	b := &Backend{
+		UseAppMempool: false,
+		Mempool: &statusMempool{evicted: []mempool.EvictedTx{
+			{Hash: hash1, From: alice, Nonce: 0, Reason: txpool.RemovalReason("lifetime")},
+			{Hash: hash2, From: bob, Nonce: 4, Reason: txpool.RemovalReason("underpriced")},
+			// the latest removal of a nonce wins
+			{Hash: hash3, From: alice, Nonce: 0, Reason: txpool.RemovalReason("replaced")},
+		}},
+	}
+
+	inspect, err := b.Inspect(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, inspect[StatusPending])
+	require.Empty(t, inspect[StatusQueued])
+	require.Equal(t, map[string]map[string]string{
+		alice.Hex(): {"0": hash3.Hex() + ": replaced"},
+		bob.Hex():   {"4": hash2.Hex() + ": underpriced"},
+	}, inspect[StatusEvicted])
+
+	// a mempool that doesn't report its evicted txs has no evicted section
+	b.Mempool = &noEvictionMempool{}
+	inspect, err = b.Inspect(context.Background())
+	require.NoError(t, err)
+	require.NotContains(t, inspect, StatusEvicted)
+}
+
+// noEvictionMempool is an app-side mempool with an empty evm txpool.
+type noEvictionMempool struct {
+	sdkmempool.Mempool
+}
+
+func (m *noEvictionMempool) GetTxPool() *txpool.TxPool { return &txpool.TxPool{} }
@@ -0,0 +1,1444 @@
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package graphql implements the EIP-1767 GraphQL schema of go-ethereum on
+// top of the JSON-RPC backend. The resolvers are adapted from the go-ethereum
+// graphql package, which depends on the geth node internals.
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+var (
+	errBlockInvariant    = errors.New("only one of number or hash must be specified")
+	errInvalidBlockRange = errors.New("invalid from and to block combination: from > to")
+)
+
+// Backend defines the methods required by the GraphQL resolvers.
+type Backend interface {
+	backend.EVMBackend
+	rpcfilters.Backend
+
+	ReceiptsFromCometBlock(
+		ctx context.Context,
+		resBlock *cmtrpctypes.ResultBlock,
+		blockRes *cmtrpctypes.ResultBlockResults,
+		msgs []*evmtypes.MsgEthereumTx,
+	) ([]*ethtypes.Receipt, error)
+}
+
+// Long is a 64 bit integer, accepted as a JSON number or as a decimal or hex
+// string.
+type Long int64
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (b Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query data.
+func (b *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*b = Long(value) //nolint:gosec // G115 // same as geth, values are block numbers and indexes
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*b = Long(value)
+		return err
+	case int32:
+		*b = Long(input)
+	case int64:
+		*b = Long(input)
+	case float64:
+		*b = Long(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// BlockNumberArgs encapsulates arguments to accessors that specify a block number.
+type BlockNumberArgs struct {
+	Block *Long
+}
+
+// NumberOr returns the provided block number argument, or the "current" block
+// number or hash if none was provided.
+func (a BlockNumberArgs) NumberOr(current rpctypes.BlockNumberOrHash) rpctypes.BlockNumberOrHash {
+	if a.Block != nil {
+		blockNum := rpctypes.BlockNumber(*a.Block)
+		return rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+	}
+	return current
+}
+
+// NumberOrLatest returns the provided block number argument, or the "latest"
+// block number if none was provided.
+func (a BlockNumberArgs) NumberOrLatest() rpctypes.BlockNumberOrHash {
+	latest := rpctypes.EthLatestBlockNumber
+	return a.NumberOr(rpctypes.BlockNumberOrHash{BlockNumber: &latest})
+}
+
+// Account represents an Ethereum account at a particular block.
+type Account struct {
+	r             *Resolver
+	address       common.Address
+	blockNrOrHash rpctypes.BlockNumberOrHash
+}
+
+func (a *Account) Address(_ context.Context) (common.Address, error) {
+	return a.address, nil
+}
+
+func (a *Account) Balance(ctx context.Context) (hexutil.Big, error) {
+	balance, err := a.r.backend.GetBalance(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *Account) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	blockNum, err := a.r.backend.BlockNumberFromComet(ctx, a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	nonce, err := a.r.backend.GetTransactionCount(ctx, a.address, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	return *nonce, nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.GetCode(ctx, a.address, a.blockNrOrHash)
+}
+
+func (a *Account) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	value, err := a.r.backend.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// Log represents an individual log message.
+type Log struct {
+	r           *Resolver
+	transaction *Transaction
+	log         *ethtypes.Log
+}
+
+func (l *Log) Transaction(_ context.Context) *Transaction {
+	return l.transaction
+}
+
+func (l *Log) Account(_ context.Context, args BlockNumberArgs) *Account {
+	return &Account{
+		r:             l.r,
+		address:       l.log.Address,
+		blockNrOrHash: args.NumberOrLatest(),
+	}
+}
+
+func (l *Log) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(l.log.Index)
+}
+
+func (l *Log) Topics(_ context.Context) []common.Hash {
+	return l.log.Topics
+}
+
+func (l *Log) Data(_ context.Context) hexutil.Bytes {
+	return l.log.Data
+}
+
+// AccessTuple represents EIP-2930
+type AccessTuple struct {
+	address     common.Address
+	storageKeys []common.Hash
+}
+
+func (at *AccessTuple) Address(_ context.Context) common.Address {
+	return at.address
+}
+
+func (at *AccessTuple) StorageKeys(_ context.Context) []common.Hash {
+	return at.storageKeys
+}
+
+// Withdrawal represents a withdrawal of value from the beacon chain, there are
+// none on this chain.
+type Withdrawal struct {
+	index     uint64
+	validator uint64
+	address   common.Address
+	amount    uint64
+}
+
+func (w *Withdrawal) Index(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.index)
+}
+
+func (w *Withdrawal) Validator(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.validator)
+}
+
+func (w *Withdrawal) Address(_ context.Context) common.Address {
+	return w.address
+}
+
+func (w *Withdrawal) Amount(_ context.Context) hexutil.Uint64 {
+	return hexutil.Uint64(w.amount)
+}
+
+// Transaction represents an Ethereum transaction.
+// backend and hash are mandatory; all others will be fetched when required.
+type Transaction struct {
+	r    *Resolver
+	hash common.Hash // Must be present after initialization
+	mu   sync.Mutex
+	// mu protects following resources
+	tx    *rpctypes.RPCTransaction
+	block *Block
+}
+
+// resolve returns the RPC representation of the transaction, fetching it if
+// needed. It also returns the block the tx belongs to, unless it is a pending
+// tx.
+func (t *Transaction) resolve(ctx context.Context) (*rpctypes.RPCTransaction, *Block, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tx != nil {
+		return t.tx, t.block, nil
+	}
+	tx, err := t.r.backend.GetTransactionByHash(ctx, t.hash)
+	if err != nil || tx == nil {
+		return nil, nil, err
+	}
+	t.tx = tx
+	if tx.BlockNumber != nil {
+		t.block = t.r.blockByNumber(rpctypes.BlockNumber(tx.BlockNumber.ToInt().Int64()))
+	}
+	return t.tx, t.block, nil
+}
+
+func (t *Transaction) Hash(_ context.Context) common.Hash {
+	return t.hash
+}
+
+func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	return tx.Input, nil
+}
+
+func (t *Transaction) Gas(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return tx.Gas, nil
+}
+
+func (t *Transaction) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.GasPrice == nil {
+		return hexutil.Big{}, err
+	}
+	// the RPC representation already holds the effective price of mined txs
+	return *tx.GasPrice, nil
+}
+
+func (t *Transaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.EffectiveGasPrice), nil
+}
+
+func (t *Transaction) MaxFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.GasFeeCap, nil
+}
+
+func (t *Transaction) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.GasTipCap, nil
+}
+
+func (t *Transaction) MaxFeePerBlobGas(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx.MaxFeePerBlobGas, nil
+}
+
+func (t *Transaction) BlobVersionedHashes(ctx context.Context) (*[]common.Hash, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type != ethtypes.BlobTxType {
+		return nil, err
+	}
+	return &tx.BlobVersionedHashes, nil
+}
+
+func (t *Transaction) EffectiveTip(ctx context.Context) (*hexutil.Big, error) {
+	tx, block, err := t.resolve(ctx)
+	// Pending tx
+	if err != nil || tx == nil || block == nil {
+		return nil, err
+	}
+	header, err := block.resolveHeader(ctx)
+	if err != nil || header == nil {
+		return nil, err
+	}
+	gasFeeCap, gasTipCap := tx.GasPrice.ToInt(), tx.GasPrice.ToInt()
+	if tx.GasFeeCap != nil && tx.GasTipCap != nil {
+		gasFeeCap, gasTipCap = tx.GasFeeCap.ToInt(), tx.GasTipCap.ToInt()
+	}
+	if header.BaseFee == nil {
+		return (*hexutil.Big)(gasTipCap), nil
+	}
+	tip := new(big.Int).Sub(gasFeeCap, header.BaseFee)
+	if tip.Cmp(gasTipCap) > 0 {
+		tip = gasTipCap
+	}
+	return (*hexutil.Big)(tip), nil
+}
+
+func (t *Transaction) Value(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Big{}, err
+	}
+	if tx.Value == nil {
+		return hexutil.Big{}, fmt.Errorf("invalid transaction value %x", t.hash)
+	}
+	return *tx.Value, nil
+}
+
+func (t *Transaction) Nonce(ctx context.Context) (hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return 0, err
+	}
+	return tx.Nonce, nil
+}
+
+func (t *Transaction) To(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.To == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       *tx.To,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       tx.From,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Block(ctx context.Context) (*Block, error) {
+	_, block, err := t.resolve(ctx)
+	return block, err
+}
+
+func (t *Transaction) Index(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, block, err := t.resolve(ctx)
+	// Pending tx
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return tx.TransactionIndex, nil
+}
+
+// getReceipt returns the receipt associated with this transaction, if any.
+func (t *Transaction) getReceipt(ctx context.Context) (*ethtypes.Receipt, error) {
+	tx, block, err := t.resolve(ctx)
+	// Pending tx
+	if err != nil || block == nil || tx.TransactionIndex == nil {
+		return nil, err
+	}
+	receipts, err := block.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := uint64(*tx.TransactionIndex)
+	if index >= uint64(len(receipts)) {
+		return nil, fmt.Errorf("receipt of transaction %s not found", t.hash.Hex())
+	}
+	return receipts[index], nil
+}
+
+func (t *Transaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.Status)
+	return &ret, nil
+}
+
+func (t *Transaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.GasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.CumulativeGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(receipt.BlobGasUsed)
+	return &ret, nil
+}
+
+func (t *Transaction) BlobGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Type != ethtypes.BlobTxType {
+		return nil, err
+	}
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(receipt.BlobGasPrice), nil
+}
+
+func (t *Transaction) CreatedContract(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == (common.Address{}) {
+		return nil, err
+	}
+	return &Account{
+		r:             t.r,
+		address:       receipt.ContractAddress,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		ret = append(ret, &Log{
+			r:           t.r,
+			transaction: t,
+			log:         log,
+		})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) Type(ctx context.Context) (*hexutil.Uint64, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &tx.Type, nil
+}
+
+func (t *Transaction) AccessList(ctx context.Context) (*[]*AccessTuple, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.Accesses == nil {
+		return nil, err
+	}
+	ret := make([]*AccessTuple, 0, len(*tx.Accesses))
+	for _, al := range *tx.Accesses {
+		ret = append(ret, &AccessTuple{
+			address:     al.Address,
+			storageKeys: al.StorageKeys,
+		})
+	}
+	return &ret, nil
+}
+
+func (t *Transaction) R(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.R == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.R, nil
+}
+
+func (t *Transaction) S(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.S == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.S, nil
+}
+
+func (t *Transaction) V(ctx context.Context) (hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.V == nil {
+		return hexutil.Big{}, err
+	}
+	return *tx.V, nil
+}
+
+func (t *Transaction) YParity(ctx context.Context) (*hexutil.Big, error) {
+	tx, _, err := t.resolve(ctx)
+	if err != nil || tx == nil || tx.YParity == nil {
+		return nil, err
+	}
+	ret := hexutil.Big(*new(big.Int).SetUint64(uint64(*tx.YParity)))
+	return &ret, nil
+}
+
+func (t *Transaction) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	tx, block, err := t.resolve(ctx)
+	if err != nil || tx == nil {
+		return hexutil.Bytes{}, err
+	}
+	if block == nil {
+		ethTx, err := t.r.pendingTransaction(ctx, t.hash)
+		if err != nil || ethTx == nil {
+			return hexutil.Bytes{}, err
+		}
+		return ethTx.MarshalBinary()
+	}
+	ethBlock, err := block.resolve(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	ethTx := ethBlock.Transaction(t.hash)
+	if ethTx == nil {
+		return hexutil.Bytes{}, fmt.Errorf("transaction %s not found in block %d", t.hash.Hex(), ethBlock.NumberU64())
+	}
+	return ethTx.MarshalBinary()
+}
+
+func (t *Transaction) RawReceipt(ctx context.Context) (hexutil.Bytes, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return hexutil.Bytes{}, err
+	}
+	return receipt.MarshalBinary()
+}
+
+// Block represents an Ethereum block. The block hash is the CometBFT block
+// hash, like in the JSON-RPC API.
+// backend, and numberOrHash are mandatory. All other fields are lazily fetched
+// when required.
+type Block struct {
+	r            *Resolver
+	numberOrHash rpctypes.BlockNumberOrHash
+	mu           sync.Mutex
+	// mu protects following resources
+	resBlock *cmtrpctypes.ResultBlock
+	blockRes *cmtrpctypes.ResultBlockResults
+	block    *ethtypes.Block
+	receipts []*ethtypes.Receipt
+}
+
+// resolveComet returns the CometBFT block, fetching it if needed. It returns
+// nil if the block doesn't exist.
+func (b *Block) resolveComet(ctx context.Context) (*cmtrpctypes.ResultBlock, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.resolveCometLocked(ctx)
+}
+
+func (b *Block) resolveCometLocked(ctx context.Context) (*cmtrpctypes.ResultBlock, error) {
+	if b.resBlock != nil {
+		return b.resBlock, nil
+	}
+	var (
+		resBlock *cmtrpctypes.ResultBlock
+		err      error
+	)
+	if b.numberOrHash.BlockHash != nil {
+		resBlock, err = b.r.backend.CometBlockByHash(ctx, *b.numberOrHash.BlockHash)
+	} else {
+		resBlock, err = b.r.backend.CometBlockByNumber(ctx, *b.numberOrHash.BlockNumber)
+	}
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, err
+	}
+	b.resBlock = resBlock
+	return b.resBlock, nil
+}
+
+func (b *Block) resolveBlockResultsLocked(ctx context.Context) (*cmtrpctypes.ResultBlock, *cmtrpctypes.ResultBlockResults, error) {
+	resBlock, err := b.resolveCometLocked(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resBlock == nil {
+		return nil, nil, errors.New("block not found")
+	}
+	if b.blockRes == nil {
+		if b.blockRes, err = b.r.backend.CometBlockResultByNumber(ctx, &resBlock.Block.Height); err != nil {
+			return nil, nil, err
+		}
+	}
+	return resBlock, b.blockRes, nil
+}
+
+// resolve returns the Ethereum representation of the block, fetching it if
+// needed.
+func (b *Block) resolve(ctx context.Context) (*ethtypes.Block, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.block != nil {
+		return b.block, nil
+	}
+	resBlock, blockRes, err := b.resolveBlockResultsLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b.block, err = b.r.backend.EthBlockFromCometBlock(ctx, resBlock, blockRes)
+	return b.block, err
+}
+
+func (b *Block) resolveHeader(ctx context.Context) (*ethtypes.Header, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+// resolveReceipts returns the receipts of the Ethereum txs of the block,
+// fetching them if needed.
+func (b *Block) resolveReceipts(ctx context.Context) ([]*ethtypes.Receipt, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.receipts != nil {
+		return b.receipts, nil
+	}
+	resBlock, blockRes, err := b.resolveBlockResultsLocked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := b.r.backend.EthMsgsFromCometBlock(ctx, resBlock, blockRes)
+	b.receipts, err = b.r.backend.ReceiptsFromCometBlock(ctx, resBlock, blockRes, msgs)
+	return b.receipts, err
+}
+
+// height returns the height of the block, it must exist.
+func (b *Block) height(ctx context.Context) (int64, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if resBlock == nil {
+		return 0, errors.New("block not found")
+	}
+	return resBlock.Block.Height, nil
+}
+
+// blockNrOrHash returns the number of the block, to query the state at.
+func (b *Block) blockNrOrHash(ctx context.Context) (rpctypes.BlockNumberOrHash, error) {
+	height, err := b.height(ctx)
+	if err != nil {
+		return rpctypes.BlockNumberOrHash{}, err
+	}
+	blockNum := rpctypes.BlockNumber(height)
+	return rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}, nil
+}
+
+func (b *Block) Number(ctx context.Context) (hexutil.Uint64, error) {
+	height, err := b.height(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(height), nil //nolint:gosec // G115 // block height is never negative
+}
+
+func (b *Block) Hash(ctx context.Context) (common.Hash, error) {
+	resBlock, err := b.resolveComet(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if resBlock == nil {
+		return common.Hash{}, errors.New("block not found")
+	}
+	return common.BytesToHash(resBlock.Block.Hash()), nil
+}
+
+func (b *Block) GasLimit(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasLimit), nil
+}
+
+func (b *Block) GasUsed(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.GasUsed), nil
+}
+
+func (b *Block) BaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.BaseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(header.BaseFee), nil
+}
+
+// NextBaseFeePerGas returns the base fee of the next block, it's only known
+// once the next block is committed as it's set by the fee market module.
+func (b *Block) NextBaseFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	height, err := b.height(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := b.r.backend.BlockNumber(ctx)
+	if err != nil || height >= int64(latest) { //nolint:gosec // G115 // block height won't exceed int64
+		return nil, err
+	}
+	next, err := b.r.backend.HeaderByNumber(ctx, rpctypes.BlockNumber(height+1))
+	if err != nil || next.BaseFee == nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(next.BaseFee), nil
+}
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	height, err := b.height(ctx)
+	if err != nil || height <= 1 {
+		return nil, err
+	}
+	return b.r.blockByNumber(rpctypes.BlockNumber(height - 1)), nil
+}
+
+func (b *Block) Difficulty(ctx context.Context) (hexutil.Big, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return hexutil.Big(*header.Difficulty), nil
+}
+
+func (b *Block) Timestamp(ctx context.Context) (hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(header.Time), nil
+}
+
+func (b *Block) Nonce(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Nonce[:], nil
+}
+
+func (b *Block) MixHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.MixDigest, nil
+}
+
+func (b *Block) TransactionsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.TxHash, nil
+}
+
+func (b *Block) StateRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Root, nil
+}
+
+func (b *Block) ReceiptsRoot(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.ReceiptHash, nil
+}
+
+func (b *Block) OmmerHash(ctx context.Context) (common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.UncleHash, nil
+}
+
+// OmmerCount returns 0 as there are no ommers on this chain.
+func (b *Block) OmmerCount(_ context.Context) (*hexutil.Uint64, error) {
+	count := hexutil.Uint64(0)
+	return &count, nil
+}
+
+// Ommers returns an empty list as there are no ommers on this chain.
+func (b *Block) Ommers(_ context.Context) (*[]*Block, error) {
+	return &[]*Block{}, nil
+}
+
+// OmmerAt returns nil as there are no ommers on this chain.
+func (b *Block) OmmerAt(_ context.Context, _ struct{ Index Long }) (*Block, error) {
+	return nil, nil
+}
+
+func (b *Block) ExtraData(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Extra, nil
+}
+
+func (b *Block) LogsBloom(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return header.Bloom.Bytes(), nil
+}
+
+func (b *Block) RawHeader(ctx context.Context) (hexutil.Bytes, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(header)
+}
+
+func (b *Block) Raw(ctx context.Context) (hexutil.Bytes, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return hexutil.Bytes{}, err
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+func (b *Block) Miner(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       header.Coinbase,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*hexutil.Uint64, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := hexutil.Uint64(len(block.Transactions()))
+	return &count, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(block.Transactions()))
+	for i := range block.Transactions() {
+		tx, err := b.transactionAt(ctx, block, i)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, tx)
+	}
+	return &ret, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index Long }) (*Transaction, error) {
+	block, err := b.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(block.Transactions()) {
+		return nil, nil
+	}
+	return b.transactionAt(ctx, block, int(args.Index))
+}
+
+func (b *Block) transactionAt(ctx context.Context, block *ethtypes.Block, index int) (*Transaction, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx := block.Transactions()[index]
+	return &Transaction{
+		r:     b.r,
+		hash:  tx.Hash(),
+		tx:    rpctypes.NewRPCTransaction(tx, hash, block.NumberU64(), block.Time(), uint64(index), block.BaseFee(), b.r.backend.ChainConfig()), //nolint:gosec // G115 // index is not negative
+		block: b,
+	}, nil
+}
+
+// WithdrawalsRoot returns nil unless the header commits to withdrawals, there
+// are none on this chain.
+func (b *Block) WithdrawalsRoot(ctx context.Context) (*common.Hash, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return header.WithdrawalsHash, nil
+}
+
+// Withdrawals returns an empty list as there are no withdrawals on this chain.
+func (b *Block) Withdrawals(_ context.Context) (*[]*Withdrawal, error) {
+	return &[]*Withdrawal{}, nil
+}
+
+func (b *Block) BlobGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.BlobGasUsed == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.BlobGasUsed)
+	return &ret, nil
+}
+
+func (b *Block) ExcessBlobGas(ctx context.Context) (*hexutil.Uint64, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.ExcessBlobGas == nil {
+		return nil, err
+	}
+	ret := hexutil.Uint64(*header.ExcessBlobGas)
+	return &ret, nil
+}
+
+// BlockFilterCriteria encapsulates criteria passed to a `logs` accessor inside
+// a block.
+type BlockFilterCriteria struct {
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+// runFilter accepts a filter and executes it, returning all its results as
+// `Log` objects.
+func runFilter(ctx context.Context, r *Resolver, filter *rpcfilters.Filter) ([]*Log, error) {
+	logs, err := filter.Logs(ctx, int(r.backend.RPCLogsCap()), int64(r.backend.RPCBlockRangeCap()))
+	if err != nil || logs == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(logs))
+	for _, log := range logs {
+		ret = append(ret, &Log{
+			r:           r,
+			transaction: &Transaction{r: r, hash: log.TxHash},
+			log:         log,
+		})
+	}
+	return ret, nil
+}
+
+func (b *Block) Logs(ctx context.Context, args struct{ Filter BlockFilterCriteria }) ([]*Log, error) {
+	hash, err := b.Hash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	crit := filters.FilterCriteria{BlockHash: &hash}
+	if args.Filter.Addresses != nil {
+		crit.Addresses = *args.Filter.Addresses
+	}
+	if args.Filter.Topics != nil {
+		crit.Topics = *args.Filter.Topics
+	}
+	return runFilter(ctx, b.r, rpcfilters.NewBlockFilter(b.r.logger, b.r.backend, crit))
+}
+
+func (b *Block) Account(ctx context.Context, args struct {
+	Address common.Address
+},
+) (*Account, error) {
+	blockNrOrHash, err := b.blockNrOrHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       args.Address,
+		blockNrOrHash: blockNrOrHash,
+	}, nil
+}
+
+// CallData encapsulates arguments to `call` or `estimateGas`.
+// All arguments are optional.
+type CallData struct {
+	From                 *common.Address // The Ethereum address the call is from.
+	To                   *common.Address // The Ethereum address the call is to.
+	Gas                  *Long           // The amount of gas provided for the call.
+	GasPrice             *hexutil.Big    // The price of each unit of gas, in wei.
+	MaxFeePerGas         *hexutil.Big    // The max price of each unit of gas, in wei (1559).
+	MaxPriorityFeePerGas *hexutil.Big    // The max tip of each unit of gas, in wei (1559).
+	Value                *hexutil.Big    // The value sent along with the call.
+	Data                 *hexutil.Bytes  // Any data sent with the call.
+}
+
+// toTransactionArgs converts the call data to the arguments of a simulated tx.
+func (c CallData) toTransactionArgs() evmtypes.TransactionArgs {
+	args := evmtypes.TransactionArgs{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             c.GasPrice,
+		MaxFeePerGas:         c.MaxFeePerGas,
+		MaxPriorityFeePerGas: c.MaxPriorityFeePerGas,
+		Value:                c.Value,
+		Data:                 c.Data,
+	}
+	if c.Gas != nil {
+		gas := hexutil.Uint64(*c.Gas) //nolint:gosec // G115 // negative gas is rejected as too high
+		args.Gas = &gas
+	}
+	return args
+}
+
+// CallResult encapsulates the result of an invocation of the `call` accessor.
+type CallResult struct {
+	data    hexutil.Bytes  // The return data from the call
+	gasUsed hexutil.Uint64 // The amount of gas used
+	status  hexutil.Uint64 // The return status of the call - 0 for failure or 1 for success.
+}
+
+func (c *CallResult) Data() hexutil.Bytes {
+	return c.data
+}
+
+func (c *CallResult) GasUsed() hexutil.Uint64 {
+	return c.gasUsed
+}
+
+func (c *CallResult) Status() hexutil.Uint64 {
+	return c.status
+}
+
+// doCall executes the call at the given block, a reverted call isn't an error
+// but a result with a failure status.
+func (r *Resolver) doCall(ctx context.Context, data CallData, blockNum rpctypes.BlockNumber) (*CallResult, error) {
+	res, err := r.backend.DoCall(ctx, data.toTransactionArgs(), blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(1)
+	if res.Failed() {
+		status = 0
+	}
+	return &CallResult{
+		data:    res.Ret,
+		gasUsed: hexutil.Uint64(res.GasUsed),
+		status:  status,
+	}, nil
+}
+
+func (b *Block) Call(ctx context.Context, args struct {
+	Data CallData
+},
+) (*CallResult, error) {
+	height, err := b.height(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.r.doCall(ctx, args.Data, rpctypes.BlockNumber(height))
+}
+
+func (b *Block) EstimateGas(ctx context.Context, args struct {
+	Data CallData
+},
+) (hexutil.Uint64, error) {
+	blockNrOrHash, err := b.blockNrOrHash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return b.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), &blockNrOrHash, nil)
+}
+
+// Pending represents the current pending state.
+type Pending struct {
+	r *Resolver
+}
+
+func (p *Pending) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	txs, err := p.r.pendingTransactions(ctx)
+	return hexutil.Uint64(len(txs)), err
+}
+
+func (p *Pending) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	txs, err := p.r.pendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	header, err := p.r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		ret = append(ret, &Transaction{
+			r:    p.r,
+			hash: tx.Hash(),
+			tx:   rpctypes.NewRPCPendingTransaction(tx, header, p.r.backend.ChainConfig()),
+		})
+	}
+	return &ret, nil
+}
+
+func (p *Pending) Account(_ context.Context, args struct {
+	Address common.Address
+},
+) *Account {
+	pending := rpctypes.EthPendingBlockNumber
+	return &Account{
+		r:             p.r,
+		address:       args.Address,
+		blockNrOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &pending},
+	}
+}
+
+func (p *Pending) Call(ctx context.Context, args struct {
+	Data CallData
+},
+) (*CallResult, error) {
+	return p.r.doCall(ctx, args.Data, rpctypes.EthPendingBlockNumber)
+}
+
+func (p *Pending) EstimateGas(ctx context.Context, args struct {
+	Data CallData
+},
+) (hexutil.Uint64, error) {
+	latest := rpctypes.EthLatestBlockNumber
+	return p.r.backend.EstimateGas(ctx, args.Data.toTransactionArgs(), &rpctypes.BlockNumberOrHash{BlockNumber: &latest}, nil)
+}
+
+// Resolver is the top-level object in the GraphQL hierarchy.
+type Resolver struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// blockByNumber returns the block at the given number, which is resolved
+// lazily.
+func (r *Resolver) blockByNumber(blockNum rpctypes.BlockNumber) *Block {
+	return &Block{r: r, numberOrHash: rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}}
+}
+
+// pendingTransactions returns the Ethereum txs of the mempool.
+func (r *Resolver) pendingTransactions(ctx context.Context) ([]*ethtypes.Transaction, error) {
+	pending, err := r.backend.PendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var txs []*ethtypes.Transaction
+	for _, tx := range pending {
+		for _, msg := range (*tx).GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				txs = append(txs, ethMsg.AsTransaction())
+			}
+		}
+	}
+	return txs, nil
+}
+
+// pendingTransaction returns the Ethereum tx of the mempool with the given
+// hash, nil if there isn't any.
+func (r *Resolver) pendingTransaction(ctx context.Context, hash common.Hash) (*ethtypes.Transaction, error) {
+	txs, err := r.pendingTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range txs {
+		if tx.Hash() == hash {
+			return tx, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+},
+) (*Block, error) {
+	var block *Block
+	switch {
+	case args.Number != nil && args.Hash != nil:
+		return nil, errBlockInvariant
+	case args.Hash != nil:
+		block = &Block{r: r, numberOrHash: rpctypes.BlockNumberOrHash{BlockHash: args.Hash}}
+	case args.Number != nil:
+		if *args.Number < 0 {
+			return nil, nil
+		}
+		latest, err := r.backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(*args.Number) > uint64(latest) {
+			return nil, nil
+		}
+		block = r.blockByNumber(rpctypes.BlockNumber(*args.Number))
+	default:
+		block = r.blockByNumber(rpctypes.EthLatestBlockNumber)
+	}
+	// Resolve the block, return nil if it doesn't exist.
+	resBlock, err := block.resolveComet(ctx)
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *Resolver) Blocks(ctx context.Context, args struct {
+	From *Long
+	To   *Long
+},
+) ([]*Block, error) {
+	if args.From == nil {
+		return nil, errors.New("from block number must be specified")
+	}
+	latest, err := r.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from := rpctypes.BlockNumber(*args.From)
+	to := rpctypes.BlockNumber(latest) //nolint:gosec // G115 // block height won't exceed int64
+	if args.To != nil && rpctypes.BlockNumber(*args.To) < to {
+		to = rpctypes.BlockNumber(*args.To)
+	}
+	if to < from {
+		return nil, errInvalidBlockRange
+	}
+	if blockLimit := rpctypes.BlockNumber(r.backend.RPCBlockRangeCap()); blockLimit > 0 && to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	var ret []*Block
+	for i := from; i <= to; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block := r.blockByNumber(i)
+		// Resolve the block to check for existence.
+		resBlock, err := block.resolveComet(ctx)
+		if err != nil {
+			return nil, err
+		} else if resBlock == nil {
+			// Blocks after must be non-existent too, break.
+			break
+		}
+		ret = append(ret, block)
+	}
+	return ret, nil
+}
+
+func (r *Resolver) Pending(_ context.Context) *Pending {
+	return &Pending{r}
+}
+
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx := &Transaction{
+		r:    r,
+		hash: args.Hash,
+	}
+	// Resolve the transaction; if it doesn't exist, return nil.
+	t, _, err := tx.resolve(ctx)
+	if err != nil || t == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (r *Resolver) SendRawTransaction(ctx context.Context, args struct{ Data hexutil.Bytes }) (common.Hash, error) {
+	return r.backend.SendRawTransaction(ctx, args.Data)
+}
+
+// FilterCriteria encapsulates the arguments to `logs` on the root resolver object.
+type FilterCriteria struct {
+	FromBlock *Long             // beginning of the queried range, nil means latest block
+	ToBlock   *Long             // end of the range, nil means latest block
+	Addresses *[]common.Address // restricts matches to events created by specific contracts
+
+	// The Topic list restricts matches to particular event topics. Each event has a list
+	// of topics. Topics matches a prefix of that list. An empty element slice matches any
+	// topic. Non-empty elements represent an alternative that matches any of the
+	// contained topics.
+	Topics *[][]common.Hash
+}
+
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	// Convert the RPC block numbers into internal representations
+	begin := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.FromBlock != nil {
+		begin = int64(*args.Filter.FromBlock)
+	}
+	end := rpctypes.EthLatestBlockNumber.Int64()
+	if args.Filter.ToBlock != nil {
+		end = int64(*args.Filter.ToBlock)
+	}
+	if begin > 0 && end > 0 && begin > end {
+		return nil, errInvalidBlockRange
+	}
+	var addresses []common.Address
+	if args.Filter.Addresses != nil {
+		addresses = *args.Filter.Addresses
+	}
+	var topics [][]common.Hash
+	if args.Filter.Topics != nil {
+		topics = *args.Filter.Topics
+	}
+	// Construct the range filter
+	filter := rpcfilters.NewRangeFilter(r.logger, r.backend, begin, end, addresses, topics)
+	return runFilter(ctx, r, filter)
+}
+
+func (r *Resolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	price, err := r.backend.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *Resolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	head, err := r.backend.CurrentHeader(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	tipcap, err := r.backend.SuggestGasTipCap(ctx, head.BaseFee)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return (hexutil.Big)(*tipcap), nil
+}
+
+func (r *Resolver) ChainID(ctx context.Context) (hexutil.Big, error) {
+	chainID, err := r.backend.ChainID(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *chainID, nil
+}
+
+// SyncState represents the synchronisation status returned from the `syncing` accessor.
+type SyncState struct {
+	startingBlock hexutil.Uint64
+	currentBlock  hexutil.Uint64
+}
+
+func (s *SyncState) StartingBlock() hexutil.Uint64 {
+	return s.startingBlock
+}
+
+func (s *SyncState) CurrentBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// HighestBlock returns the current block, the highest block of the network
+// isn't known while catching up.
+func (s *SyncState) HighestBlock() hexutil.Uint64 {
+	return s.currentBlock
+}
+
+// Syncing returns false in case the node is in sync with the network. If it is
+// syncing, it returns the first and current blocks.
+func (r *Resolver) Syncing(ctx context.Context) (*SyncState, error) {
+	res, err := r.backend.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	progress, ok := res.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	startingBlock, _ := progress["startingBlock"].(hexutil.Uint64)
+	currentBlock, _ := progress["currentBlock"].(hexutil.Uint64)
+	return &SyncState{startingBlock: startingBlock, currentBlock: currentBlock}, nil
+}
@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+)
+
+// testBackend implements the few backend methods the tests query, the other
+// ones panic if called.
+type testBackend struct {
+	Backend
+}
+
+func (testBackend) ChainID(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(9001)), nil
+}
+
+func (testBackend) GasPrice(context.Context) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1000)), nil
+}
+
+func (testBackend) Syncing(context.Context) (interface{}, error) {
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(1),
+		"currentBlock":  hexutil.Uint64(10),
+	}, nil
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	h, err := NewHandler(log.NewNopLogger(), testBackend{}, 0)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		body       string
+		expStatus  int
+		expResult  string
+		expErrPart string
+	}{
+		"query chain id and gas price": {
+			body:      `{"query": "{ chainID gasPrice }"}`,
+			expStatus: http.StatusOK,
+			expResult: `{"data":{"chainID":"0x2329","gasPrice":"0x3e8"}}`,
+		},
+		"query syncing state": {
+			body:      `{"query": "{ syncing { startingBlock currentBlock highestBlock } }"}`,
+			expStatus: http.StatusOK,
+			expResult: `{"data":{"syncing":{"startingBlock":"0x1","currentBlock":"0xa","highestBlock":"0xa"}}}`,
+		},
+		"unknown field": {
+			body:       `{"query": "{ unknown }"}`,
+			expStatus:  http.StatusBadRequest,
+			expErrPart: "Cannot query field",
+		},
+		"invalid request": {
+			body:       `not json`,
+			expStatus:  http.StatusBadRequest,
+			expErrPart: "invalid character",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(tc.body)))
+			require.Equal(t, tc.expStatus, rec.Code)
+			if tc.expErrPart != "" {
+				require.Contains(t, rec.Body.String(), tc.expErrPart)
+				return
+			}
+			require.JSONEq(t, tc.expResult, rec.Body.String())
+		})
+	}
+}
+
+func TestLongUnmarshalGraphQL(t *testing.T) {
+	for _, input := range []interface{}{"0x10", "16", int32(16), int64(16), float64(16)} {
+		var l Long
+		require.NoError(t, l.UnmarshalGraphQL(input))
+		require.Equal(t, Long(16), l)
+	}
+	var l Long
+	require.Error(t, l.UnmarshalGraphQL(true))
+}
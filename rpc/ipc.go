@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// IPCServer serves the JSON-RPC requests and subscriptions over a unix
+// socket, with the stream of JSON messages of the geth IPC endpoint.
+type IPCServer struct {
+	srv    *websocketsServer
+	logger log.Logger
+
+	mu     sync.Mutex
+	ln     net.Listener
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+// NewIPCServer creates an IPC server, the requests other than the
+// subscriptions are served in process by the handler.
+func NewIPCServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	evmBackend backend.EVMBackend,
+	cfg *config.Config,
+	handler http.Handler,
+) *IPCServer {
+	logger = logger.With("api", "ipc-server")
+	return &IPCServer{
+		srv: &websocketsServer{
+			api:     newPubSubAPI(clientCtx, logger, stream, evmBackend, int64(cfg.JSONRPC.BlockRangeCap)),
+			logger:  logger,
+			handler: handler,
+		},
+		logger: logger,
+		conns:  make(map[net.Conn]struct{}),
+	}
+}
+
+// Serve accepts the connections of the listener until it's closed by Stop.
+func (s *IPCServer) Serve(ln net.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return net.ErrClosed
+	}
+	s.ln = ln
+	s.mu.Unlock()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			_ = conn.Close()
+			return nil
+		}
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+
+		go func() {
+			defer func() {
+				s.mu.Lock()
+				delete(s.conns, conn)
+				s.mu.Unlock()
+			}()
+			s.srv.readLoop(&wsConn{
+				mux:  new(sync.Mutex),
+				conn: newIPCConn(conn),
+			})
+		}()
+	}
+}
+
+// Stop closes the listener, which removes the socket, and the open
+// connections, which cancels their subscriptions.
+func (s *IPCServer) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.ln != nil {
+		_ = s.ln.Close()
+	}
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+}
+
+// ipcConn reads and writes the JSON messages of an IPC connection.
+type ipcConn struct {
+	conn net.Conn
+	dec  *json.Decoder
+	enc  *json.Encoder
+}
+
+func newIPCConn(conn net.Conn) *ipcConn {
+	return &ipcConn{
+		conn: conn,
+		dec:  json.NewDecoder(conn),
+		enc:  json.NewEncoder(conn),
+	}
+}
+
+// ReadMessage reads the next JSON message, as a text websocket message.
+func (c *ipcConn) ReadMessage() (int, []byte, error) {
+	var msg json.RawMessage
+	if err := c.dec.Decode(&msg); err != nil {
+		return 0, nil, err
+	}
+	return websocket.TextMessage, msg, nil
+}
+
+// WriteJSON writes the JSON encoding of v followed by a newline.
+func (c *ipcConn) WriteJSON(v any) error {
+	return c.enc.Encode(v)
+}
+
+// Close closes the connection.
+func (c *ipcConn) Close() error {
+	return c.conn.Close()
+}
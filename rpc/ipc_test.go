@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/stream"
+	"github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+type testEchoService struct{}
+
+func (testEchoService) Echo(s string) string { return s }
+
+func TestIPCServer(t *testing.T) {
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", testEchoService{}))
+
+	path := filepath.Join(t.TempDir(), "test.ipc")
+	ln, err := net.Listen("unix", path)
+	require.NoError(t, err)
+
+	srv := NewIPCServer(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil, &config.Config{}, rpcServer)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+
+	c, err := rpc.DialIPC(context.Background(), path)
+	require.NoError(t, err)
+	defer c.Close()
+
+	// the requests are served in process
+	var res string
+	require.NoError(t, c.Call(&res, "test_echo", "hello"))
+	require.Equal(t, "hello", res)
+
+	batch := []rpc.BatchElem{
+		{Method: "test_echo", Args: []any{"a"}, Result: new(string)},
+		{Method: "test_echo", Args: []any{"b"}, Result: new(string)},
+	}
+	require.NoError(t, c.BatchCall(batch))
+	require.Equal(t, "a", *batch[0].Result.(*string))
+	require.Equal(t, "b", *batch[1].Result.(*string))
+
+	// the subscription requests are served by the pubsub api
+	var unsubscribed bool
+	require.NoError(t, c.Call(&unsubscribed, "eth_unsubscribe", "0x1"))
+	require.False(t, unsubscribed)
+
+	// stopping the server closes the connections and removes the socket
+	srv.Stop()
+	require.NoError(t, <-errCh)
+	require.Error(t, c.Call(&res, "test_echo", "hello"))
+	_, err = os.Stat(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
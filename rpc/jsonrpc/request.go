@@ -0,0 +1,206 @@
+// Package jsonrpc parses the JSON-RPC requests received by the HTTP handlers
+// wrapping the JSON-RPC server, and writes their error responses.
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	// MaxRequestContentLength is the max size of the request read to find
+	// its methods, as the one of the geth rpc server
+	MaxRequestContentLength = 1024 * 1024 * 5
+
+	// ErrCodeInvalidRequest is the JSON-RPC error code of the invalid requests.
+	ErrCodeInvalidRequest = -32600
+	// ErrCodeMethodNotFound is the JSON-RPC error code of the requests of an
+	// unavailable method.
+	ErrCodeMethodNotFound = -32601
+)
+
+type message struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+
+	raw json.RawMessage
+}
+
+type errorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorMessage    `json:"error"`
+}
+
+// Request is a JSON-RPC request or batch of requests.
+type Request struct {
+	messages []message
+	batch    bool
+}
+
+// ParseRequest parses the ids and methods of a JSON-RPC request, or batch of
+// requests. An invalid request has no method.
+func ParseRequest(body []byte) Request {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var raws []json.RawMessage
+		_ = json.Unmarshal(body, &raws)
+		msgs := make([]message, 0, len(raws))
+		for _, raw := range raws {
+			var msg message
+			_ = json.Unmarshal(raw, &msg)
+			msg.raw = raw
+			msgs = append(msgs, msg)
+		}
+		return Request{messages: msgs, batch: true}
+	}
+	var msg message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return Request{}
+	}
+	msg.raw = body
+	return Request{messages: []message{msg}}
+}
+
+// ReadRequest reads and parses the body of the HTTP request, which is
+// restored to be read again by the next handler.
+func ReadRequest(r *http.Request) (Request, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxRequestContentLength))
+	if err != nil {
+		return Request{}, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return ParseRequest(body), nil
+}
+
+// IsBatch returns true if the request is a batch of requests.
+func (r Request) IsBatch() bool {
+	return r.batch
+}
+
+// Methods returns the methods of the request.
+func (r Request) Methods() []string {
+	methods := make([]string, 0, len(r.messages))
+	for _, msg := range r.messages {
+		methods = append(methods, msg.Method)
+	}
+	return methods
+}
+
+// Split splits the messages of the request in the ones for which keep returns
+// true and the other ones.
+func (r Request) Split(keep func(method string) bool) (kept, others Request) {
+	kept.batch, others.batch = r.batch, r.batch
+	for _, msg := range r.messages {
+		if keep(msg.Method) {
+			kept.messages = append(kept.messages, msg)
+			continue
+		}
+		others.messages = append(others.messages, msg)
+	}
+	return kept, others
+}
+
+// Len returns the number of messages of the request.
+func (r Request) Len() int {
+	return len(r.messages)
+}
+
+// Body returns the encoding of the request.
+func (r Request) Body() []byte {
+	if !r.batch {
+		if len(r.messages) == 0 {
+			return nil
+		}
+		return r.messages[0].raw
+	}
+	raws := make([]json.RawMessage, 0, len(r.messages))
+	for _, msg := range r.messages {
+		raws = append(raws, msg.raw)
+	}
+	// raw messages are valid JSON, so this never fails
+	bz, _ := json.Marshal(raws)
+	return bz
+}
+
+// ErrorResponse returns the JSON-RPC response with the error for each of the
+// messages of the request.
+func (r Request) ErrorResponse(code int, message string) any {
+	errFn := func(string) (int, string) { return code, message }
+	if !r.batch {
+		var id json.RawMessage
+		if len(r.messages) > 0 {
+			id = r.messages[0].ID
+		}
+		return newErrorResponse(id, code, message)
+	}
+	return r.ErrorResponses(errFn)
+}
+
+// ErrorResponses returns the errors returned by errFn for each of the
+// messages of the request, as a list even if the request isn't a batch.
+func (r Request) ErrorResponses(errFn func(method string) (int, string)) []any {
+	responses := make([]any, 0, len(r.messages))
+	for _, msg := range r.messages {
+		code, message := errFn(msg.Method)
+		responses = append(responses, newErrorResponse(msg.ID, code, message))
+	}
+	return responses
+}
+
+func newErrorResponse(id json.RawMessage, code int, message string) errorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return errorResponse{Version: "2.0", ID: id, Error: errorMessage{Code: code, Message: message}}
+}
+
+// WriteJSON writes the JSON encoding of v as response with the status.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// ResponseBuffer is a http.ResponseWriter keeping the response in memory.
+type ResponseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+// NewResponseBuffer creates an empty response buffer.
+func NewResponseBuffer() *ResponseBuffer {
+	return &ResponseBuffer{header: make(http.Header), status: http.StatusOK}
+}
+
+// Header implements http.ResponseWriter.
+func (b *ResponseBuffer) Header() http.Header { return b.header }
+
+// Write implements http.ResponseWriter.
+func (b *ResponseBuffer) Write(p []byte) (int, error) { return b.body.Write(p) }
+
+// WriteHeader implements http.ResponseWriter.
+func (b *ResponseBuffer) WriteHeader(status int) { b.status = status }
+
+// Status returns the status of the response.
+func (b *ResponseBuffer) Status() int { return b.status }
+
+// Bytes returns the body of the response.
+func (b *ResponseBuffer) Bytes() []byte { return b.body.Bytes() }
+
+// CopyTo writes the buffered response to w.
+func (b *ResponseBuffer) CopyTo(w http.ResponseWriter) {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(b.status)
+	_, _ = w.Write(b.body.Bytes())
+}
@@ -0,0 +1,101 @@
+package cosmos
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/utils"
+
+	"cosmossdk.io/log/v2"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/cosmos")
+
+// API is the collection of cross-VM lookup APIs exposed over the `cosmos`
+// namespace. It lets EVM tooling answer Cosmos questions without talking to
+// the CometBFT RPC.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Cosmos lookup methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// GetCosmosTxHash returns the hash of the Cosmos transaction that contains the
+// given Ethereum transaction.
+func (a *API) GetCosmosTxHash(hash common.Hash) (_ rpctypes.CosmosTxHash, err error) {
+	a.logger.Debug("cosmos_getCosmosTxHash", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getCosmosTxHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetCosmosTxHash(ctx, hash)
+}
+
+// GetEthTxHashes returns the hashes of the Ethereum transactions contained in
+// the given Cosmos transaction.
+func (a *API) GetEthTxHashes(hash rpctypes.CosmosTxHash) (_ []common.Hash, err error) {
+	a.logger.Debug("cosmos_getEthTxHashes", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getEthTxHashes")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetEthTxHashes(ctx, hash)
+}
+
+// GetTxEvents returns the result and the ABCI events of the given Cosmos
+// transaction.
+func (a *API) GetTxEvents(hash rpctypes.CosmosTxHash) (_ *rpctypes.CosmosTxEvents, err error) {
+	a.logger.Debug("cosmos_getTxEvents", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getTxEvents")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetCosmosTxEvents(ctx, hash)
+}
+
+// GetBlockEvents returns the ABCI events emitted in the given block.
+func (a *API) GetBlockEvents(blockNr rpctypes.BlockNumber) (_ *rpctypes.CosmosBlockEvents, err error) {
+	a.logger.Debug("cosmos_getBlockEvents", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getBlockEvents", trace.WithAttributes(attribute.Int64("number", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetCosmosBlockEvents(ctx, blockNr)
+}
+
+// HexToBech32 converts a hex address to a bech32 account address.
+func (a *API) HexToBech32(address common.Address) string {
+	a.logger.Debug("cosmos_hexToBech32", "address", address)
+	return utils.EthToCosmosAddr(address).String()
+}
+
+// Bech32ToHex converts a bech32 account, validator or consensus address to a
+// hex address.
+func (a *API) Bech32ToHex(address string) (common.Address, error) {
+	a.logger.Debug("cosmos_bech32ToHex", "address", address)
+	return utils.HexAddressFromBech32String(address)
+}
+
+// GetAllBalances returns the bank balances of all the denoms held by the
+// address, with the ERC20 address of the denoms registered as token pairs.
+func (a *API) GetAllBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ []*rpctypes.CosmosBalance, err error) {
+	a.logger.Debug("cosmos_getAllBalances", "address", address, "block number or hash", blockNrOrHash)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getAllBalances", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetCosmosBalances(ctx, address, blockNrOrHash)
+}
+
+// GetBlockMessages returns the Cosmos messages of the given block that
+// touched the address.
+func (a *API) GetBlockMessages(blockNr rpctypes.BlockNumber, address common.Address) (_ []*rpctypes.CosmosMessage, err error) {
+	a.logger.Debug("cosmos_getBlockMessages", "number", blockNr, "address", address)
+	ctx, span := tracer.Start(context.Background(), "cosmos_getBlockMessages", trace.WithAttributes(attribute.Int64("number", blockNr.Int64()), attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetCosmosBlockMessages(ctx, blockNr, address)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	stderrors "github.com/pkg/errors"
@@ -477,3 +478,46 @@ func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]co
 	}
 	return ([]common.Hash)(nil), nil
 }
+
+// StorageRangeAt returns the storage of the contract at the given block and
+// transaction index, starting at keyStart and returning at most maxResult
+// slots. Only the block boundaries are available, i.e. txIndex 0 for the
+// state before the block and the transaction count for the state after it.
+func (a *API) StorageRangeAt(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (_ *rpctypes.StorageRangeResult, err error) {
+	a.logger.Debug("debug_storageRangeAt", "block number or hash", blockNrOrHash, "txIndex", txIndex, "address", contractAddress)
+	ctx, span := tracer.Start(ctx, "debug_storageRangeAt", trace.WithAttributes(attribute.String("address", contractAddress.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.StorageRangeAt(ctx, blockNrOrHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange enumerates the accounts at the given block, starting at the
+// start address and returning at most maxResults accounts.
+func (a *API) AccountRange(
+	ctx context.Context,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (_ *state.Dump, err error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "maxResults", maxResults)
+	ctx, span := tracer.Start(ctx, "debug_accountRange")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.AccountRange(ctx, blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
+// DumpBlock returns the accounts at the given block, with their code and
+// storage. Large states are cut off, the next key of the dump continues with
+// AccountRange.
+func (a *API) DumpBlock(ctx context.Context, blockNr rpctypes.BlockNumber) (_ *state.Dump, err error) {
+	a.logger.Debug("debug_dumpBlock", "number", blockNr)
+	ctx, span := tracer.Start(ctx, "debug_dumpBlock", trace.WithAttributes(attribute.Int64("number", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.DumpBlock(ctx, blockNr)
+}
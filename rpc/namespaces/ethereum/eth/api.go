@@ -3,6 +3,8 @@ package eth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -12,7 +14,9 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"go.opentelemetry.io/otel"
 
+	"github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtrace "github.com/cosmos/evm/trace"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -22,6 +26,10 @@ import (
 
 var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/eth")
 
+// txSyncIndexDelay is the delay before looking up again a tx that was not
+// found after a new block, in eth_sendRawTransactionSync.
+const txSyncIndexDelay = 200 * time.Millisecond
+
 // The Ethereum API allows applications to connect to an node of any Cosmos EVM based blockchain.
 // Developers can interact with on-chain EVM data
 // and send different types of transactions to the network by utilizing the
@@ -47,6 +55,9 @@ type EthereumAPI interface {
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
+	GetTransactionBySenderAndNonce(sender common.Address, nonce hexutil.Uint64) (*rpctypes.RPCTransaction, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.AddressTransactionsArgs) (*rpctypes.AddressTransactionsResult, error)
+	GetAssetTransfers(args rpctypes.AssetTransfersArgs) (*rpctypes.AssetTransfersResult, error)
 	// eth_getBlockReceipts
 
 	// Writing Transactions
@@ -54,6 +65,9 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionConditional(data hexutil.Bytes, conditions mempool.TxConditions) (common.Hash, error)
+	SendBundle(args rpctypes.SendBundleArgs) (*rpctypes.SendBundleResult, error)
+	SendRawTransactionSync(data hexutil.Bytes, timeoutMs *hexutil.Uint64) (map[string]interface{}, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -72,6 +86,7 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error)
 
 	// Chain Information
 	//
@@ -116,13 +131,15 @@ var _ EthereumAPI = (*PublicAPI)(nil)
 type PublicAPI struct {
 	logger  log.Logger
 	backend backend.EVMBackend
+	stream  *stream.RPCStream
 }
 
 // NewPublicAPI creates an instance of the public ETH Web3 API.
-func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend, stream *stream.RPCStream) *PublicAPI {
 	api := &PublicAPI{
 		logger:  logger.With("client", "json-rpc"),
 		backend: backend,
+		stream:  stream,
 	}
 
 	return api
@@ -200,6 +217,36 @@ func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (_ *rpctypes.RPCTrans
 	return e.backend.GetTransactionByHash(ctx, hash)
 }
 
+// GetTransactionBySenderAndNonce returns the transaction sent by the address
+// with the given nonce, either included in a block or pending in the mempool.
+func (e *PublicAPI) GetTransactionBySenderAndNonce(sender common.Address, nonce hexutil.Uint64) (_ *rpctypes.RPCTransaction, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getTransactionBySenderAndNonce")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getTransactionBySenderAndNonce", "sender", sender.Hex(), "nonce", uint64(nonce))
+	return e.backend.GetTransactionBySenderAndNonce(ctx, sender, uint64(nonce))
+}
+
+// GetTransactionsByAddress returns a page of the transactions the address
+// appears in as sender, recipient or created contract. The next page is
+// requested with the page key of the result.
+func (e *PublicAPI) GetTransactionsByAddress(address common.Address, args rpctypes.AddressTransactionsArgs) (_ *rpctypes.AddressTransactionsResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getTransactionsByAddress")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getTransactionsByAddress", "address", address.Hex())
+	return e.backend.GetTransactionsByAddress(ctx, address, args)
+}
+
+// GetAssetTransfers returns a page of the ERC-20, ERC-721, ERC-1155 and native
+// coin transfers filtered by address, token contract, category and block
+// range, like alchemy_getAssetTransfers. The next page is requested with the
+// page key of the result.
+func (e *PublicAPI) GetAssetTransfers(args rpctypes.AssetTransfersArgs) (_ *rpctypes.AssetTransfersResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_getAssetTransfers")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_getAssetTransfers", "order", args.Order)
+	return e.backend.GetAssetTransfers(ctx, args)
+}
+
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ *hexutil.Uint64, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_getTransactionCount")
@@ -265,6 +312,114 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err e
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendRawTransactionConditional sends a raw Ethereum transaction that is
+// dropped from the mempool, rather than included, once its conditions on the
+// block number, timestamp or account storage no longer hold.
+func (e *PublicAPI) SendRawTransactionConditional(data hexutil.Bytes, conditions mempool.TxConditions) (_ common.Hash, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendRawTransactionConditional")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionConditional", "length", len(data))
+	return e.backend.SendRawTransactionConditional(ctx, data, conditions)
+}
+
+// SendBundle sends an ordered bundle of raw Ethereum transactions that are
+// included contiguously in one of the blocks it targets, or not at all.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) (_ *rpctypes.SendBundleResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendBundle")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendBundle", "txs", len(args.Txs), "block", args.BlockNumber)
+	return e.backend.SendBundle(ctx, args)
+}
+
+// SendRawTransactionSync sends a raw Ethereum transaction and waits for its
+// inclusion, returning its receipt (EIP-7966). If the transaction isn't
+// included before the timeout, a TxSyncTimeoutError carrying its hash is
+// returned.
+func (e *PublicAPI) SendRawTransactionSync(data hexutil.Bytes, timeoutMs *hexutil.Uint64) (_ map[string]interface{}, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendRawTransactionSync")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data))
+
+	timeout := e.txSyncTimeout(timeoutMs)
+	// read the offset of the header stream before sending the tx, so the
+	// header of the block including it can't be missed
+	headers := e.stream.HeaderStream()
+	offset := headers.LastID()
+
+	hash, err := e.backend.SendRawTransaction(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return e.waitForTx(ctx, headers, offset, hash, timeout)
+}
+
+// txSyncTimeout returns the time to wait for the inclusion of a tx, given the
+// timeout of the request if any, capped by the max timeout.
+func (e *PublicAPI) txSyncTimeout(timeoutMs *hexutil.Uint64) time.Duration {
+	timeout := e.backend.RPCTxSyncTimeout()
+	if timeoutMs != nil && *timeoutMs > 0 {
+		timeout = time.Duration(*timeoutMs) * time.Millisecond //nolint:gosec // G115 // capped below
+	}
+	if maxTimeout := e.backend.RPCTxSyncMaxTimeout(); timeout > maxTimeout || timeout <= 0 {
+		timeout = maxTimeout
+	}
+	return timeout
+}
+
+// waitForTx waits for the inclusion of the tx, looking up its status in the
+// mempool tx tracker each time a header is streamed after offset, and returns
+// its receipt. A TxSyncTimeoutError is returned if the tx isn't included
+// before the timeout, and an error if it was replaced.
+func (e *PublicAPI) waitForTx(
+	ctx context.Context,
+	headers *stream.Stream[stream.RPCHeader],
+	offset int,
+	hash common.Hash,
+	timeout time.Duration,
+) (map[string]interface{}, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		if _, offset = headers.ReadBlocking(waitCtx, offset); waitCtx.Err() != nil {
+			return nil, &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: timeout}
+		}
+		included, err := e.txIncluded(waitCtx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if included {
+			return e.backend.GetTransactionReceipt(ctx, hash)
+		}
+	}
+}
+
+// txIncluded returns true if the tx is included and indexed, and an error if
+// it was replaced. Evicted txs are still waited for, since the txs included in
+// a block may be reported evicted when the mempool isn't exclusive. As the
+// blocks are indexed concurrently with their header being streamed, a tx that
+// isn't indexed yet is looked up once more after a short delay.
+func (e *PublicAPI) txIncluded(ctx context.Context, hash common.Hash) (bool, error) {
+	status, err := e.backend.TxStatus(ctx, hash)
+	if err != nil {
+		return false, err
+	}
+	switch mempool.TxState(status.Status) {
+	case mempool.TxStateReplaced:
+		return false, fmt.Errorf("transaction %s was replaced by %s", hash, status.ReplacedBy)
+	case mempool.TxStateIncluded:
+		if _, err := e.backend.GetTxByEthHash(ctx, hash); err == nil {
+			return true, nil
+		}
+	}
+	select {
+	case <-ctx.Done():
+		return false, nil
+	case <-time.After(txSyncIndexDelay):
+	}
+	_, err = e.backend.GetTxByEthHash(ctx, hash)
+	return err == nil, nil
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
@@ -344,6 +499,18 @@ func (e *PublicAPI) Call(
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes a series of transactions on top of a base state, in one
+// or more simulated blocks with optional block and state overrides.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (_ []*rpctypes.SimBlockResult, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_simulateV1")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+	return e.backend.SimulateV1(ctx, opts, blockNrOrHash)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
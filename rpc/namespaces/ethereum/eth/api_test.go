@@ -0,0 +1,163 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+// txSyncBackend is a backend reporting a fixed status for the txs, which are
+// indexed if indexed is set.
+type txSyncBackend struct {
+	backend.EVMBackend
+
+	mu         sync.Mutex
+	status     rpctypes.TxStatusResult
+	indexed    bool
+	timeout    time.Duration
+	maxTimeout time.Duration
+}
+
+// include marks the txs included and indexed.
+func (b *txSyncBackend) include() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.status.Status, b.indexed = string(mempool.TxStateIncluded), true
+}
+
+func (b *txSyncBackend) TxStatus(context.Context, common.Hash) (*rpctypes.TxStatusResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	status := b.status
+	return &status, nil
+}
+
+func (b *txSyncBackend) GetTxByEthHash(context.Context, common.Hash) (*servertypes.TxResult, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.indexed {
+		return nil, errors.New("tx not found")
+	}
+	return &servertypes.TxResult{Height: 1}, nil
+}
+
+func (b *txSyncBackend) GetTransactionReceipt(_ context.Context, hash common.Hash) (map[string]interface{}, error) {
+	return map[string]interface{}{"transactionHash": hash}, nil
+}
+
+func (b *txSyncBackend) RPCTxSyncTimeout() time.Duration    { return b.timeout }
+func (b *txSyncBackend) RPCTxSyncMaxTimeout() time.Duration { return b.maxTimeout }
+
+func TestWaitForTx(t *testing.T) {
+	hash := common.HexToHash("0x1")
+	replacement := common.HexToHash("0x2")
+
+	testCases := []struct {
+		name    string
+		status  rpctypes.TxStatusResult
+		indexed bool
+		expErr  error
+	}{
+		{
+			name:    "included",
+			status:  rpctypes.TxStatusResult{Status: string(mempool.TxStateIncluded)},
+			indexed: true,
+		},
+		{
+			// the tracker may not record the inclusion of the txs
+			name:    "indexed but untracked",
+			status:  rpctypes.TxStatusResult{Status: string(mempool.TxStateUnknown)},
+			indexed: true,
+		},
+		{
+			name:   "pending",
+			status: rpctypes.TxStatusResult{Status: string(mempool.TxStatePending)},
+			expErr: &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: time.Second},
+		},
+		{
+			name:   "included but not indexed",
+			status: rpctypes.TxStatusResult{Status: string(mempool.TxStateIncluded)},
+			expErr: &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: time.Second},
+		},
+		{
+			name:   "evicted",
+			status: rpctypes.TxStatusResult{Status: string(mempool.TxStateEvicted), Reason: "old"},
+			expErr: &rpctypes.TxSyncTimeoutError{Hash: hash, Timeout: time.Second},
+		},
+		{
+			name:   "replaced",
+			status: rpctypes.TxStatusResult{Status: string(mempool.TxStateReplaced), ReplacedBy: &replacement},
+			expErr: errors.New("transaction 0x0000000000000000000000000000000000000000000000000000000000000001 was replaced by 0x0000000000000000000000000000000000000000000000000000000000000002"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &PublicAPI{backend: &txSyncBackend{status: tc.status, indexed: tc.indexed}}
+			headers := stream.NewStream[stream.RPCHeader](16, 32)
+			offset := headers.LastID()
+			headers.Add(stream.RPCHeader{})
+
+			receipt, err := api.waitForTx(context.Background(), headers, offset, hash, time.Second)
+			if tc.expErr != nil {
+				require.Equal(t, tc.expErr.Error(), err.Error())
+				require.IsType(t, tc.expErr, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, hash, receipt["transactionHash"])
+		})
+	}
+}
+
+func TestWaitForTxNextBlock(t *testing.T) {
+	hash := common.HexToHash("0x1")
+	b := &txSyncBackend{status: rpctypes.TxStatusResult{Status: string(mempool.TxStatePending)}}
+	api := &PublicAPI{backend: b}
+	headers := stream.NewStream[stream.RPCHeader](16, 32)
+	offset := headers.LastID()
+
+	// the tx is included in the second block streamed
+	headers.Add(stream.RPCHeader{})
+	go func() {
+		time.Sleep(2 * txSyncIndexDelay)
+		b.include()
+		headers.Add(stream.RPCHeader{})
+	}()
+
+	receipt, err := api.waitForTx(context.Background(), headers, offset, hash, 10*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, hash, receipt["transactionHash"])
+}
+
+func TestTxSyncTimeout(t *testing.T) {
+	ms := func(ms uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&ms) }
+
+	testCases := []struct {
+		name      string
+		timeoutMs *hexutil.Uint64
+		expected  time.Duration
+	}{
+		{"default timeout", nil, 20 * time.Second},
+		{"zero timeout", ms(0), 20 * time.Second},
+		{"request timeout", ms(5000), 5 * time.Second},
+		{"request timeout above the max", ms(60000), 29 * time.Second},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &PublicAPI{backend: &txSyncBackend{timeout: 20 * time.Second, maxTimeout: 29 * time.Second}}
+			require.Equal(t, tc.expected, api.txSyncTimeout(tc.timeoutMs))
+		})
+	}
+}
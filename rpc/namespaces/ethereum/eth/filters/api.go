@@ -33,7 +33,7 @@ var (
 
 // FilterAPI gathers
 type FilterAPI interface {
-	NewPendingTransactionFilter() rpc.ID
+	NewPendingTransactionFilter(fullTx *bool) rpc.ID
 	NewBlockFilter() rpc.ID
 	NewFilter(criteria filters.FilterCriteria) (rpc.ID, error)
 	GetFilterChanges(id rpc.ID) (interface{}, error)
@@ -51,6 +51,8 @@ type Backend interface {
 	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	GetPendingTransactions(ctx context.Context, hashes []common.Hash) ([]*types.RPCTransaction, error)
+	LogIndexedBlocks(ctx context.Context, addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, bool, error)
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -69,7 +71,8 @@ type filter struct {
 	typ      filters.Type
 	deadline *time.Timer // filter is inactive when deadline triggers
 	crit     filters.FilterCriteria
-	offset   int // offset for stream subscription
+	offset   int  // offset for stream subscription
+	fullTx   bool // pending tx filter returns full txs instead of hashes
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -141,13 +144,14 @@ func (api *PublicFilterAPI) timeoutLoop() {
 }
 
 // NewPendingTransactionFilter creates a filter that fetches pending transaction hashes
-// as transactions enter the pending state.
+// as transactions enter the pending state. If fullTx is true, the full transactions
+// still pending when polled are returned instead.
 //
 // It is part of the filter package because this filter can be used through the
 // `eth_getFilterChanges` polling method that is also used for log filters.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newPendingTransactionFilter
-func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
+func (api *PublicFilterAPI) NewPendingTransactionFilter(fullTx *bool) rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
@@ -161,6 +165,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		typ:      filters.PendingTransactionsSubscription,
 		deadline: time.NewTimer(api.deadline),
 		offset:   offset,
+		fullTx:   fullTx != nil && *fullTx,
 	}
 
 	return id
@@ -345,6 +350,9 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	case filters.PendingTransactionsSubscription:
 		var hashes []common.Hash
 		hashes, f.offset = api.events.PendingTxStream().ReadAllNonBlocking(f.offset)
+		if f.fullTx {
+			return api.backend.GetPendingTransactions(context.Background(), hashes)
+		}
 		return returnHashes(hashes), nil
 	case filters.BlocksSubscription:
 		var headers []stream.RPCHeader
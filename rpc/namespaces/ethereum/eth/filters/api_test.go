@@ -5,9 +5,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log/v2"
 )
 
 func TestTimeoutLoop_PanicOnNilCancel(t *testing.T) {
@@ -38,3 +46,24 @@ func TestTimeoutLoop_PanicOnNilCancel(t *testing.T) {
 	}
 	require.False(t, panicked)
 }
+
+func TestPendingTransactionFilterFullTx(t *testing.T) {
+	hash := common.HexToHash("0x1")
+	backend := filtermocks.NewBackend(t)
+	backend.EXPECT().RPCFilterCap().Return(int32(10))
+	backend.EXPECT().GetPendingTransactions(mock.Anything, []common.Hash{hash}).Return([]*rpctypes.RPCTransaction{{Hash: hash}}, nil)
+
+	api := &PublicFilterAPI{
+		backend:  backend,
+		events:   stream.NewRPCStreams(nil, log.NewNopLogger(), nil),
+		filters:  make(map[rpc.ID]*filter),
+		deadline: time.Minute,
+	}
+	fullTx := true
+	id := api.NewPendingTransactionFilter(&fullTx)
+	api.events.ListenPendingTx(hash)
+
+	changes, err := api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*rpctypes.RPCTransaction{{Hash: hash}}, changes)
+}
@@ -168,13 +168,21 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return nil, errInvalidBlockRange
 	}
 
-	if blockLimit > 0 && to-from > uint64(blockLimit) {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	// the log index narrows down the blocks to scan and lifts the block range
+	// limit when it covers the range
+	heights, indexed := f.logIndexedBlocks(ctx, int64(from), int64(to)) //#nosec G115
+	if !indexed {
+		if blockLimit > 0 && to-from > uint64(blockLimit) {
+			return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+		}
+		heights = make([]int64, 0, to-from+1)
+		for height := from; height <= to; height++ {
+			heights = append(heights, int64(height)) //#nosec G115
+		}
 	}
 
-	for height := from; height <= to; height++ {
-		h := int64(height) //#nosec G115
-		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
+	for _, height := range heights {
+		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from CometBFT", "height", height, "error", err.Error())
 			return nil, fmt.Errorf("failed to fetch block result from CometBFT: %w", err)
@@ -199,6 +207,27 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 	return logs, nil
 }
 
+// logIndexedBlocks returns the blocks of the range that may contain matching
+// logs according to the log index. The second return value is false if the
+// criteria don't filter on addresses nor first topics, or the log index can't
+// be used for the range.
+func (f *Filter) logIndexedBlocks(ctx context.Context, from, to int64) ([]int64, bool) {
+	var topics0 []common.Hash
+	if len(f.criteria.Topics) > 0 {
+		topics0 = f.criteria.Topics[0]
+	}
+	if len(f.criteria.Addresses) == 0 && len(topics0) == 0 {
+		return nil, false
+	}
+
+	heights, indexed, err := f.backend.LogIndexedBlocks(ctx, f.criteria.Addresses, topics0, from, to)
+	if err != nil {
+		f.logger.Error("failed to query the log index, scanning the blocks", "from", from, "to", to, "error", err.Error())
+		return nil, false
+	}
+	return heights, indexed
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -146,6 +146,27 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "log index lifts the block range limit",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(1000), Addresses: []common.Address{common.HexToAddress("0x1")}},
+			expectations: func(b *filtermocks.Backend) {
+				height := int64(700)
+				blockRes := &cmtrpctypes.ResultBlockResults{Height: height}
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(1000)}, nil)
+				b.EXPECT().LogIndexedBlocks(mock.Anything, []common.Address{common.HexToAddress("0x1")}, []common.Hash(nil), int64(1), int64(1000)).Return([]int64{height}, true, nil)
+				b.EXPECT().CometBlockResultByNumber(mock.Anything, &height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(mock.Anything, blockRes).Return(ethtypes.Bloom{}, nil)
+			},
+		},
+		{
+			name:   "block range limit applies without log index",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(1000), Topics: [][]common.Hash{{common.HexToHash("0x1")}}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(1000)}, nil)
+				b.EXPECT().LogIndexedBlocks(mock.Anything, []common.Address(nil), []common.Hash{common.HexToHash("0x1")}, int64(1), int64(1000)).Return(nil, false, nil)
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
 	}
 
 	for _, tc := range testCases {
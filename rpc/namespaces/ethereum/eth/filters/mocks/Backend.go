@@ -439,6 +439,65 @@ func (_c *Backend_GetLogsByHeight_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetPendingTransactions provides a mock function with given fields: ctx, hashes
+func (_m *Backend) GetPendingTransactions(ctx context.Context, hashes []common.Hash) ([]*rpctypes.RPCTransaction, error) {
+	ret := _m.Called(ctx, hashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactions")
+	}
+
+	var r0 []*rpctypes.RPCTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Hash) ([]*rpctypes.RPCTransaction, error)); ok {
+		return rf(ctx, hashes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Hash) []*rpctypes.RPCTransaction); ok {
+		r0 = rf(ctx, hashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rpctypes.RPCTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Hash) error); ok {
+		r1 = rf(ctx, hashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backend_GetPendingTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingTransactions'
+type Backend_GetPendingTransactions_Call struct {
+	*mock.Call
+}
+
+// GetPendingTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hashes []common.Hash
+func (_e *Backend_Expecter) GetPendingTransactions(ctx interface{}, hashes interface{}) *Backend_GetPendingTransactions_Call {
+	return &Backend_GetPendingTransactions_Call{Call: _e.mock.On("GetPendingTransactions", ctx, hashes)}
+}
+
+func (_c *Backend_GetPendingTransactions_Call) Run(run func(ctx context.Context, hashes []common.Hash)) *Backend_GetPendingTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]common.Hash))
+	})
+	return _c
+}
+
+func (_c *Backend_GetPendingTransactions_Call) Return(_a0 []*rpctypes.RPCTransaction, _a1 error) *Backend_GetPendingTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backend_GetPendingTransactions_Call) RunAndReturn(run func(context.Context, []common.Hash) ([]*rpctypes.RPCTransaction, error)) *Backend_GetPendingTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HeaderByHash provides a mock function with given fields: ctx, blockHash
 func (_m *Backend) HeaderByHash(ctx context.Context, blockHash common.Hash) (*types.Header, error) {
 	ret := _m.Called(ctx, blockHash)
@@ -557,6 +616,75 @@ func (_c *Backend_HeaderByNumber_Call) RunAndReturn(run func(context.Context, rp
 	return _c
 }
 
+// LogIndexedBlocks provides a mock function with given fields: ctx, addresses, topics0, from, to
+func (_m *Backend) LogIndexedBlocks(ctx context.Context, addresses []common.Address, topics0 []common.Hash, from int64, to int64) ([]int64, bool, error) {
+	ret := _m.Called(ctx, addresses, topics0, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogIndexedBlocks")
+	}
+
+	var r0 []int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, []common.Hash, int64, int64) ([]int64, bool, error)); ok {
+		return rf(ctx, addresses, topics0, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []common.Address, []common.Hash, int64, int64) []int64); ok {
+		r0 = rf(ctx, addresses, topics0, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []common.Address, []common.Hash, int64, int64) bool); ok {
+		r1 = rf(ctx, addresses, topics0, from, to)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []common.Address, []common.Hash, int64, int64) error); ok {
+		r2 = rf(ctx, addresses, topics0, from, to)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_LogIndexedBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogIndexedBlocks'
+type Backend_LogIndexedBlocks_Call struct {
+	*mock.Call
+}
+
+// LogIndexedBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []common.Address
+//   - topics0 []common.Hash
+//   - from int64
+//   - to int64
+func (_e *Backend_Expecter) LogIndexedBlocks(ctx interface{}, addresses interface{}, topics0 interface{}, from interface{}, to interface{}) *Backend_LogIndexedBlocks_Call {
+	return &Backend_LogIndexedBlocks_Call{Call: _e.mock.On("LogIndexedBlocks", ctx, addresses, topics0, from, to)}
+}
+
+func (_c *Backend_LogIndexedBlocks_Call) Run(run func(ctx context.Context, addresses []common.Address, topics0 []common.Hash, from int64, to int64)) *Backend_LogIndexedBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]common.Address), args[2].([]common.Hash), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *Backend_LogIndexedBlocks_Call) Return(_a0 []int64, _a1 bool, _a2 error) *Backend_LogIndexedBlocks_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_LogIndexedBlocks_Call) RunAndReturn(run func(context.Context, []common.Address, []common.Hash, int64, int64) ([]int64, bool, error)) *Backend_LogIndexedBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPCBlockRangeCap provides a mock function with no fields
 func (_m *Backend) RPCBlockRangeCap() int32 {
 	ret := _m.Called()
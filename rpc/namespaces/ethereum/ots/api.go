@@ -0,0 +1,150 @@
+package ots
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+
+	"cosmossdk.io/log/v2"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/ots")
+
+// maxPageSize caps the number of txs returned by a single search request.
+const maxPageSize = 500
+
+// API is the collection of Otterscan block explorer APIs exposed over the
+// `ots` namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Otterscan methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the Otterscan API level implemented by the node.
+func (a *API) GetApiLevel() uint64 { //nolint:revive,staticcheck // method name is part of the Otterscan API
+	a.logger.Debug("ots_getApiLevel")
+	return rpctypes.OtsAPILevel
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self-destructs that happened within the internal calls of a transaction.
+func (a *API) GetInternalOperations(hash common.Hash) (_ []*rpctypes.OtsInternalOperation, err error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getInternalOperations", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetInternalOperations(ctx, hash)
+}
+
+// SearchTransactionsBefore returns a page of the transactions the address
+// appears in, strictly before the given block. A zero block number starts
+// from the most recent block.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNum uint64, pageSize uint64) (_ *rpctypes.OtsSearchResult, err error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "block", blockNum, "pageSize", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsBefore", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.SearchTransactions(ctx, address, blockNum, min(pageSize, maxPageSize), true)
+}
+
+// SearchTransactionsAfter returns a page of the transactions the address
+// appears in, strictly after the given block. A zero block number starts from
+// the earliest block.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNum uint64, pageSize uint64) (_ *rpctypes.OtsSearchResult, err error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "block", blockNum, "pageSize", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_searchTransactionsAfter", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.SearchTransactions(ctx, address, blockNum, min(pageSize, maxPageSize), false)
+}
+
+// GetContractCreator returns the transaction and the sender that created a
+// contract, returns null if the address is not a contract.
+func (a *API) GetContractCreator(address common.Address) (_ *rpctypes.OtsContractCreator, err error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	ctx, span := tracer.Start(context.Background(), "ots_getContractCreator", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetContractCreator(ctx, address)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce.
+func (a *API) GetTransactionBySenderAndNonce(sender common.Address, nonce hexutil.Uint64) (_ *common.Hash, err error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "sender", sender, "nonce", nonce)
+	ctx, span := tracer.Start(context.Background(), "ots_getTransactionBySenderAndNonce", trace.WithAttributes(attribute.String("sender", sender.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetTransactionHashBySenderAndNonce(ctx, sender, uint64(nonce))
+}
+
+// TraceTransaction returns the call frames of a transaction flattened in
+// execution order.
+func (a *API) TraceTransaction(hash common.Hash) (_ []*rpctypes.OtsTraceEntry, err error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_traceTransaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.TraceTransactionEntries(ctx, hash)
+}
+
+// HasCode returns whether the address holds contract code at the given block.
+func (a *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (_ bool, err error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block", blockNrOrHash)
+	ctx, span := tracer.Start(context.Background(), "ots_hasCode", trace.WithAttributes(attribute.String("address", address.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	code, err := a.backend.GetCode(ctx, address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetTransactionError returns the raw revert data of a transaction.
+func (a *API) GetTransactionError(hash common.Hash) (_ hexutil.Bytes, err error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getTransactionError", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetTransactionError(ctx, hash)
+}
+
+// GetBlockDetails returns a block without its transactions, together with the
+// total fees paid by them.
+func (a *API) GetBlockDetails(blockNr rpctypes.BlockNumber) (_ *rpctypes.OtsBlockDetails, err error) {
+	a.logger.Debug("ots_getBlockDetails", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetails", trace.WithAttributes(attribute.Int64("number", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetBlockDetails(ctx, blockNr)
+}
+
+// GetBlockDetailsByHash returns a block without its transactions, together
+// with the total fees paid by them.
+func (a *API) GetBlockDetailsByHash(hash common.Hash) (_ *rpctypes.OtsBlockDetails, err error) {
+	a.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockDetailsByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.GetBlockDetailsByHash(ctx, hash)
+}
+
+// GetBlockTransactions returns a page of the transactions of a block with
+// their receipts, pages are counted from the end of the block.
+func (a *API) GetBlockTransactions(blockNr rpctypes.BlockNumber, pageNumber uint64, pageSize uint64) (_ *rpctypes.OtsBlockTransactions, err error) {
+	a.logger.Debug("ots_getBlockTransactions", "number", blockNr, "page", pageNumber, "pageSize", pageSize)
+	ctx, span := tracer.Start(context.Background(), "ots_getBlockTransactions", trace.WithAttributes(attribute.Int64("number", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if pageSize == 0 {
+		return nil, errors.New("page size must be greater than zero")
+	}
+	return a.backend.GetBlockTransactions(ctx, blockNr, pageNumber, min(pageSize, maxPageSize))
+}
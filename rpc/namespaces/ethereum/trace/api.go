@@ -0,0 +1,71 @@
+package trace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+
+	"cosmossdk.io/log/v2"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/trace")
+
+// API is the collection of Parity (OpenEthereum) style tracing APIs exposed
+// over the `trace` namespace.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Parity style tracing methods.
+func NewAPI(logger log.Logger, backend backend.EVMBackend) *API {
+	return &API{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all the transactions of a block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) (_ []*rpctypes.ParityTrace, err error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+	ctx, span := tracer.Start(context.Background(), "trace_block", trace.WithAttributes(attribute.Int64("number", blockNr.Int64())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	return a.backend.TraceBlockFlat(ctx, blockNr)
+}
+
+// Transaction returns the flat call traces of a transaction.
+func (a *API) Transaction(hash common.Hash) (_ []*rpctypes.ParityTrace, err error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "trace_transaction", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.TraceTransactionFlat(ctx, hash)
+}
+
+// Filter returns the flat call traces matching the given block range and
+// sender/recipient addresses.
+func (a *API) Filter(args rpctypes.TraceFilterArgs) (_ []*rpctypes.ParityTrace, err error) {
+	a.logger.Debug("trace_filter", "args", args)
+	ctx, span := tracer.Start(context.Background(), "trace_filter")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.TraceFilter(ctx, args)
+}
+
+// ReplayBlockTransactions replays all the transactions of a block and returns
+// the requested trace types ("trace" and/or "stateDiff") for each of them.
+func (a *API) ReplayBlockTransactions(blockNrOrHash rpctypes.BlockNumberOrHash, traceTypes []string) (_ []*rpctypes.TraceReplayResult, err error) {
+	a.logger.Debug("trace_replayBlockTransactions", "block", blockNrOrHash, "traceTypes", traceTypes)
+	ctx, span := tracer.Start(context.Background(), "trace_replayBlockTransactions")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return a.backend.TraceReplayBlockTransactions(ctx, blockNrOrHash, traceTypes)
+}
@@ -64,3 +64,12 @@ func (api *PublicAPI) Status() (_ map[string]hexutil.Uint, err error) {
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 	return api.backend.Status(ctx)
 }
+
+// TxStatus returns the stage of its lifecycle the transaction is in, e.g. why
+// it is queued or was evicted from the pool
+func (api *PublicAPI) TxStatus(hash common.Hash) (_ *types.TxStatusResult, err error) {
+	api.logger.Debug("txpool_txStatus", "hash", hash.Hex())
+	ctx, span := tracer.Start(context.Background(), "TxStatus", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	return api.backend.TxStatus(ctx, hash)
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/evm/rpc/jsonrpc"
+)
+
+// LimitExceededMessage returns the message of the rejected requests.
+func LimitExceededMessage(retryAfter time.Duration) string {
+	if retryAfter <= 0 {
+		return "rate limit exceeded"
+	}
+	return "rate limit exceeded, retry after " + retryAfter.Round(time.Millisecond).String()
+}
+
+// Handler wraps a JSON-RPC handler to only serve the requests within the
+// limits of their client, the other ones are answered with a -32005 error.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if l.isAdmitted(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		req, err := jsonrpc.ReadRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		client := ClientFromRequest(r)
+		if err := l.ValidateClient(client); err != nil {
+			jsonrpc.WriteJSON(w, http.StatusUnauthorized, req.ErrorResponse(jsonrpc.ErrCodeInvalidRequest, err.Error()))
+			return
+		}
+
+		if ok, retryAfter := l.AllowRequest(client, req.Methods()); !ok {
+			if retryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			}
+			jsonrpc.WriteJSON(w, http.StatusTooManyRequests, req.ErrorResponse(ErrCodeLimitExceeded, LimitExceededMessage(retryAfter)))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
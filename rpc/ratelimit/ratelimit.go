@@ -0,0 +1,270 @@
+// Package ratelimit implements the admission of the JSON-RPC requests with
+// token buckets per client IP or API key, where each method has a cost.
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// ErrCodeLimitExceeded is the JSON-RPC error code of the rejected requests.
+	ErrCodeLimitExceeded = -32005
+
+	// APIKeyHeader is the header of the API key.
+	APIKeyHeader = "X-API-Key"
+
+	// APIKeyPathVar is the route variable of the API key, when given as the
+	// URL path.
+	APIKeyPathVar = "apikey"
+
+	// admittedHeader marks the requests forwarded by the websocket server,
+	// which were already admitted.
+	admittedHeader = "X-Rate-Limit-Admitted"
+
+	// pruneInterval is the interval of the removal of the idle buckets
+	pruneInterval = time.Minute
+)
+
+// Limit is the rate of cost units per second and the burst of a bucket.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Config is the configuration of the limiter.
+type Config struct {
+	// IP is the limit of each client IP, for the requests without API key.
+	IP Limit
+	// APIKeys are the limits of the API keys, a request with an API key that
+	// isn't part of them is rejected.
+	APIKeys map[string]Limit
+	// MethodCosts are the costs of the methods, the other methods cost 1.
+	MethodCosts map[string]int
+	// Subscribe is the limit of the websocket subscriptions creation of each
+	// client.
+	Subscribe Limit
+	// MaxSubscriptions is the max number of active subscriptions of a
+	// websocket connection, 0 for no limit.
+	MaxSubscriptions int
+}
+
+// Client identifies the client of a request.
+type Client struct {
+	IP     string
+	APIKey string
+}
+
+// key returns the key of the client buckets.
+func (c Client) key() string {
+	if c.APIKey != "" {
+		return "key:" + c.APIKey
+	}
+	return "ip:" + c.IP
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	burst   int
+}
+
+// Limiter admits the requests of the clients within their limits.
+type Limiter struct {
+	cfg Config
+	// admittedToken authenticates the requests forwarded by the websocket
+	// server
+	admittedToken string
+
+	mu               sync.Mutex
+	requestBuckets   map[string]*bucket
+	subscribeBuckets map[string]*bucket
+	lastPrune        time.Time
+}
+
+// NewLimiter creates a new limiter with the configuration.
+func NewLimiter(cfg Config) (*Limiter, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("failed to generate the admitted token: %w", err)
+	}
+	return &Limiter{
+		cfg:              cfg,
+		admittedToken:    hex.EncodeToString(token),
+		requestBuckets:   make(map[string]*bucket),
+		subscribeBuckets: make(map[string]*bucket),
+		lastPrune:        time.Now(),
+	}, nil
+}
+
+// ClientFromRequest returns the client of the request, the API key is read
+// from the header or the route variable.
+func ClientFromRequest(r *http.Request) Client {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	key := r.Header.Get(APIKeyHeader)
+	if key == "" {
+		key = mux.Vars(r)[APIKeyPathVar]
+	}
+	return Client{IP: ip, APIKey: key}
+}
+
+// ValidateClient returns an error if the API key of the client is unknown.
+func (l *Limiter) ValidateClient(c Client) error {
+	if c.APIKey == "" {
+		return nil
+	}
+	if _, ok := l.cfg.APIKeys[c.APIKey]; !ok {
+		return fmt.Errorf("unknown API key")
+	}
+	return nil
+}
+
+// Cost returns the total cost of the methods, a request without method
+// costs 1.
+func (l *Limiter) Cost(methods []string) int {
+	if len(methods) == 0 {
+		return 1
+	}
+	cost := 0
+	for _, method := range methods {
+		if c, ok := l.cfg.MethodCosts[method]; ok {
+			cost += c
+			continue
+		}
+		cost++
+	}
+	return cost
+}
+
+// AllowRequest takes the cost of the methods from the bucket of the client.
+// If there aren't enough tokens, it returns false with the time to wait
+// before retrying.
+func (l *Limiter) AllowRequest(c Client, methods []string) (bool, time.Duration) {
+	limit := l.cfg.IP
+	if c.APIKey != "" {
+		limit = l.cfg.APIKeys[c.APIKey]
+	}
+	return l.allow(l.requestBuckets, c.key(), limit, l.Cost(methods))
+}
+
+// AllowSubscribe takes a token from the subscriptions bucket of the client.
+func (l *Limiter) AllowSubscribe(c Client) (bool, time.Duration) {
+	return l.allow(l.subscribeBuckets, c.key(), l.cfg.Subscribe, 1)
+}
+
+// MaxSubscriptions returns the max number of active subscriptions of a
+// websocket connection, 0 for no limit.
+func (l *Limiter) MaxSubscriptions() int {
+	return l.cfg.MaxSubscriptions
+}
+
+func (l *Limiter) allow(buckets map[string]*bucket, key string, limit Limit, cost int) (bool, time.Duration) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	b, ok := buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst), burst: limit.Burst}
+		buckets[key] = b
+	}
+
+	r := b.limiter.ReserveN(now, cost)
+	if !r.OK() {
+		// the cost exceeds the burst, the request is never admitted
+		return false, 0
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// prune removes the full buckets, which are the same as new ones, so that
+// the buckets of the past clients don't accumulate.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for _, buckets := range []map[string]*bucket{l.requestBuckets, l.subscribeBuckets} {
+		for key, b := range buckets {
+			if b.limiter.TokensAt(now) >= float64(b.burst) {
+				delete(buckets, key)
+			}
+		}
+	}
+}
+
+// MarkAdmitted marks a request forwarded by the websocket server as already
+// admitted, so that it isn't charged again.
+func (l *Limiter) MarkAdmitted(r *http.Request) {
+	r.Header.Set(admittedHeader, l.admittedToken)
+}
+
+func (l *Limiter) isAdmitted(r *http.Request) bool {
+	return r.Header.Get(admittedHeader) == l.admittedToken
+}
+
+// ParseMethodCosts parses the method costs given as `method=cost`.
+func ParseMethodCosts(entries []string) (map[string]int, error) {
+	costs := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		method, costStr, ok := strings.Cut(entry, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method cost %q, expected method=cost", entry)
+		}
+		cost, err := strconv.Atoi(costStr)
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid cost of method %s: %q", method, costStr)
+		}
+		costs[method] = cost
+	}
+	return costs, nil
+}
+
+// ParseAPIKeys parses the API keys given as `key` or `key:rate:burst`, the
+// keys without limit have the default one.
+func ParseAPIKeys(entries []string, defaultLimit Limit) (map[string]Limit, error) {
+	keys := make(map[string]Limit, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if parts[0] == "" || strings.Contains(parts[0], "/") {
+			return nil, fmt.Errorf("invalid API key %q", entry)
+		}
+		switch len(parts) {
+		case 1:
+			keys[parts[0]] = defaultLimit
+		case 3:
+			r, err := strconv.ParseFloat(parts[1], 64)
+			if err != nil || r < 0 {
+				return nil, fmt.Errorf("invalid rate of API key %s: %q", parts[0], parts[1])
+			}
+			burst, err := strconv.Atoi(parts[2])
+			if err != nil || burst < 0 {
+				return nil, fmt.Errorf("invalid burst of API key %s: %q", parts[0], parts[2])
+			}
+			keys[parts[0]] = Limit{Rate: r, Burst: burst}
+		default:
+			return nil, fmt.Errorf("invalid API key %q, expected key or key:rate:burst", entry)
+		}
+	}
+	return keys, nil
+}
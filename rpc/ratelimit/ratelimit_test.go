@@ -0,0 +1,141 @@
+package ratelimit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/rpc/ratelimit"
+)
+
+func TestAllowRequest(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Config{
+		IP:          ratelimit.Limit{Rate: 0, Burst: 10},
+		APIKeys:     map[string]ratelimit.Limit{"key": {Rate: 0, Burst: 20}},
+		MethodCosts: map[string]int{"debug_traceBlockByNumber": 8, "eth_getLogs": 4},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, limiter.Cost(nil))
+	require.Equal(t, 13, limiter.Cost([]string{"debug_traceBlockByNumber", "eth_getLogs", "eth_chainId"}))
+
+	client := ratelimit.Client{IP: "10.0.0.1"}
+	ok, _ := limiter.AllowRequest(client, []string{"debug_traceBlockByNumber"})
+	require.True(t, ok)
+	ok, _ = limiter.AllowRequest(client, []string{"eth_getLogs"})
+	require.False(t, ok, "the bucket of the IP has 2 tokens left")
+	ok, _ = limiter.AllowRequest(client, []string{"eth_chainId", "eth_chainId"})
+	require.True(t, ok)
+
+	// the other IPs and the API keys have their own buckets
+	ok, _ = limiter.AllowRequest(ratelimit.Client{IP: "10.0.0.2"}, []string{"eth_getLogs"})
+	require.True(t, ok)
+	keyClient := ratelimit.Client{IP: "10.0.0.1", APIKey: "key"}
+	require.NoError(t, limiter.ValidateClient(keyClient))
+	ok, _ = limiter.AllowRequest(keyClient, []string{"debug_traceBlockByNumber", "debug_traceBlockByNumber"})
+	require.True(t, ok)
+
+	require.Error(t, limiter.ValidateClient(ratelimit.Client{APIKey: "unknown"}))
+}
+
+func TestHandler(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(ratelimit.Config{
+		IP:          ratelimit.Limit{Rate: 0.001, Burst: 3},
+		APIKeys:     map[string]ratelimit.Limit{"key": {Rate: 0.001, Burst: 100}},
+		MethodCosts: map[string]int{"debug_traceBlockByNumber": 2},
+	})
+	require.NoError(t, err)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	})
+	r := mux.NewRouter()
+	r.Handle("/", limiter.Handler(next))
+	r.Handle("/{"+ratelimit.APIKeyPathVar+"}", limiter.Handler(next))
+
+	send := func(path, body string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		for k, v := range header {
+			req.Header.Set(k, v[0])
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send("/", `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the batch costs 2, with 1 token left
+	rec = send("/", `[{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":"3","method":"eth_chainId"}]`, nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.NotEmpty(t, rec.Header().Get("Retry-After"))
+	var batchRes []struct {
+		ID    json.RawMessage `json:"id"`
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &batchRes))
+	require.Len(t, batchRes, 2)
+	require.Equal(t, `2`, string(batchRes[0].ID))
+	require.Equal(t, `"3"`, string(batchRes[1].ID))
+	require.Equal(t, ratelimit.ErrCodeLimitExceeded, batchRes[0].Error.Code)
+
+	rec = send("/", `{"jsonrpc":"2.0","id":4,"method":"debug_traceBlockByNumber"}`, nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":4,"error":{"code":-32005,"message":"`+rateLimitMessage(t, rec)+`"}}`, rec.Body.String())
+
+	// the API key has its own bucket, given as header or path
+	rec = send("/", `{"jsonrpc":"2.0","id":5,"method":"debug_traceBlockByNumber"}`, http.Header{ratelimit.APIKeyHeader: {"key"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = send("/key", `{"jsonrpc":"2.0","id":6,"method":"debug_traceBlockByNumber"}`, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = send("/unknown", `{"jsonrpc":"2.0","id":7,"method":"eth_chainId"}`, nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// the requests forwarded by the websocket server are not charged again
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":8,"method":"debug_traceBlockByNumber"}`))
+	req.RemoteAddr = "10.0.0.1:1234"
+	limiter.MarkAdmitted(req)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func rateLimitMessage(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var res struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, strings.HasPrefix(res.Error.Message, "rate limit exceeded"))
+	return res.Error.Message
+}
+
+func TestParseConfig(t *testing.T) {
+	costs, err := ratelimit.ParseMethodCosts([]string{"eth_getLogs=10", "eth_call=0"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"eth_getLogs": 10, "eth_call": 0}, costs)
+	for _, invalid := range []string{"eth_getLogs", "=1", "eth_getLogs=-1", "eth_getLogs=x"} {
+		_, err := ratelimit.ParseMethodCosts([]string{invalid})
+		require.Error(t, err, invalid)
+	}
+
+	defaultLimit := ratelimit.Limit{Rate: 10, Burst: 20}
+	keys, err := ratelimit.ParseAPIKeys([]string{"a", "b:1.5:30"}, defaultLimit)
+	require.NoError(t, err)
+	require.Equal(t, map[string]ratelimit.Limit{"a": defaultLimit, "b": {Rate: 1.5, Burst: 30}}, keys)
+	for _, invalid := range []string{"", "a:1", "a:x:1", "a:1:x", "a/b"} {
+		_, err := ratelimit.ParseAPIKeys([]string{invalid}, defaultLimit)
+		require.Error(t, err, invalid)
+	}
+}
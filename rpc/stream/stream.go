@@ -69,10 +69,13 @@ func (s *Stream[V]) Add(vs ...V) int {
 // it only stops if the context is canceled.
 // it returns the last id of the items.
 func (s *Stream[V]) Subscribe(ctx context.Context, callback func([]V, int) error) error {
-	var (
-		items  []V
-		offset = -1
-	)
+	return s.SubscribeFrom(ctx, -1, callback)
+}
+
+// SubscribeFrom is like Subscribe, but starts with the items following the given id instead of the new ones,
+// so the items added since the id was read through LastID are not missed unless they are pruned.
+func (s *Stream[V]) SubscribeFrom(ctx context.Context, offset int, callback func([]V, int) error) error {
+	var items []V
 	for {
 		items, offset = s.ReadBlocking(ctx, offset)
 		if len(items) == 0 {
@@ -141,6 +144,14 @@ func (s *Stream[V]) ReadBlocking(ctx context.Context, offset int) ([]V, int) {
 	}
 }
 
+// LastID returns the id of the last item, 0 for empty stream.
+func (s *Stream[V]) LastID() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.lastID()
+}
+
 // lastID returns the id of the last item, 0 for empty stream.
 func (s *Stream[V]) lastID() int {
 	if s.segments.Length() == 0 {
@@ -121,3 +121,31 @@ func TestStreamReadFromEnd(t *testing.T) {
 	require.Equal(t, []int{2}, items)
 	require.Equal(t, 2, offset)
 }
+
+func TestStreamSubscribeFrom(t *testing.T) {
+	stream := NewStream[int](16, 31)
+	stream.Add(0, 1)
+	offset := stream.LastID()
+	require.Equal(t, 2, offset)
+
+	// the items added before the subscription starts are not missed
+	stream.Add(2, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var result []int
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		require.NoError(t, stream.SubscribeFrom(ctx, offset, func(items []int, _ int) error {
+			result = append(result, items...)
+			if len(result) == 3 {
+				cancel()
+			}
+			return nil
+		}))
+	}()
+
+	stream.Add(4)
+	<-done
+	require.Equal(t, []int{2, 3, 4}, result)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// CosmosTxHash is a Cosmos tx hash. It's encoded in the CometBFT format,
+// i.e. upper case hex without prefix, and decoded from hex with or without
+// the 0x prefix.
+type CosmosTxHash cmtbytes.HexBytes
+
+// MarshalText implements encoding.TextMarshaler.
+func (h CosmosTxHash) MarshalText() ([]byte, error) {
+	return []byte(cmtbytes.HexBytes(h).String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (h *CosmosTxHash) UnmarshalText(input []byte) error {
+	s := strings.TrimPrefix(strings.TrimPrefix(string(input), "0x"), "0X")
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid cosmos tx hash %q: %w", input, err)
+	}
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("invalid cosmos tx hash length, expect: %d, got: %d", common.HashLength, len(bz))
+	}
+	*h = bz
+	return nil
+}
+
+// CosmosBalance is a bank balance of an account. The ERC20 address is set
+// when the denom is registered as an ERC20 token pair.
+type CosmosBalance struct {
+	Denom        string          `json:"denom"`
+	Amount       *hexutil.Big    `json:"amount"`
+	Erc20Address *common.Address `json:"erc20Address,omitempty"`
+}
+
+// CosmosTxEvents is the result and the ABCI events of a Cosmos tx.
+type CosmosTxEvents struct {
+	Hash      CosmosTxHash   `json:"hash"`
+	Height    hexutil.Uint64 `json:"height"`
+	Index     hexutil.Uint   `json:"index"`
+	Code      uint32         `json:"code"`
+	Codespace string         `json:"codespace,omitempty"`
+	Log       string         `json:"log,omitempty"`
+	GasWanted hexutil.Uint64 `json:"gasWanted"`
+	GasUsed   hexutil.Uint64 `json:"gasUsed"`
+	Events    []abci.Event   `json:"events"`
+}
+
+// CosmosBlockEvents is the ABCI events of a block, both the per-tx events
+// and the ones emitted outside of the txs.
+type CosmosBlockEvents struct {
+	Height              hexutil.Uint64    `json:"height"`
+	Txs                 []*CosmosTxEvents `json:"txs"`
+	FinalizeBlockEvents []abci.Event      `json:"finalizeBlockEvents"`
+}
+
+// CosmosMessage is a message of a Cosmos tx encoded as proto JSON.
+type CosmosMessage struct {
+	TxHash   CosmosTxHash    `json:"txHash"`
+	TxIndex  hexutil.Uint    `json:"txIndex"`
+	MsgIndex hexutil.Uint    `json:"msgIndex"`
+	Type     string          `json:"type"`
+	Message  json.RawMessage `json:"message"`
+}
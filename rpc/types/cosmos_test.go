@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+)
+
+func TestCosmosTxHashJSON(t *testing.T) {
+	hexHash := strings.Repeat("ab", 32)
+
+	testCases := map[string]struct {
+		input  string
+		expErr bool
+	}{
+		"upper case without prefix": {input: `"` + strings.ToUpper(hexHash) + `"`},
+		"lower case with prefix":    {input: `"0x` + hexHash + `"`},
+		"invalid hex":               {input: `"0xzz"`, expErr: true},
+		"wrong length":              {input: `"0xabab"`, expErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var hash rpc.CosmosTxHash
+			err := json.Unmarshal([]byte(tc.input), &hash)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			bz, err := json.Marshal(hash)
+			require.NoError(t, err)
+			require.Equal(t, `"`+strings.ToUpper(hexHash)+`"`, string(bz))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// AccountRangeMaxResults is the maximum number of accounts returned by a
+	// single debug_accountRange call.
+	AccountRangeMaxResults = 256
+	// DumpBlockMaxResults is the maximum number of accounts returned by a
+	// single debug_dumpBlock call, the rest of the accounts are available
+	// through debug_accountRange starting at the next key of the dump.
+	DumpBlockMaxResults = 4096
+	// StorageRangeMaxResults is the maximum number of storage slots returned by
+	// a single debug_storageRangeAt call.
+	StorageRangeMaxResults = 1024
+)
+
+// StorageEntry is a storage slot of a contract.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt call. The storage
+// is keyed by the hash of the slot key like in geth, but the range is ordered
+// by the slot keys themselves.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	// NextKey is the slot key to continue from, nil if the range includes the
+	// last slot.
+	NextKey *common.Hash `json:"nextKey"`
+}
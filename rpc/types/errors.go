@@ -1,5 +1,33 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+// ErrCodeTxSyncTimeout is the JSON-RPC error code returned by
+// eth_sendRawTransactionSync when the transaction isn't included in time.
+const ErrCodeTxSyncTimeout = 4
+
+// TxSyncTimeoutError is returned by eth_sendRawTransactionSync when the
+// transaction was submitted but not included before the timeout, it carries
+// the transaction hash so the client can keep polling for the receipt.
+type TxSyncTimeoutError struct {
+	Hash    common.Hash
+	Timeout time.Duration
+}
+
+func (e *TxSyncTimeoutError) Error() string {
+	return fmt.Sprintf("transaction %s was not included within %s", e.Hash, e.Timeout)
+}
+
+// ErrorCode returns the JSON-RPC error code of the timeout error.
+func (e *TxSyncTimeoutError) ErrorCode() int { return ErrCodeTxSyncTimeout }
+
+// ErrorData returns the hash of the submitted transaction.
+func (e *TxSyncTimeoutError) ErrorData() interface{} { return e.Hash.Hex() }
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OtsAPILevel is the Otterscan API level implemented by the ots namespace.
+const OtsAPILevel = 8
+
+// Otterscan internal operation types.
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+// CallFrame is a call frame as reported by the callTracer.
+type CallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []CallFrame     `json:"calls,omitempty"`
+}
+
+// OtsInternalOperation is a value transfer, contract creation or
+// self-destruct that happened within a transaction.
+type OtsInternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// OtsTraceEntry is a single call frame of the Otterscan transaction trace.
+type OtsTraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// OtsSearchResult is a page of the transactions an address appears in.
+type OtsSearchResult struct {
+	Txs       []*RPCTransaction        `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// OtsContractCreator is the transaction and the sender that created a
+// contract.
+type OtsContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// OtsIssuance is the coin issuance of a block. Block rewards are not minted by
+// the EVM, so it is always zero.
+type OtsIssuance struct {
+	BlockReward hexutil.Big `json:"blockReward"`
+	UncleReward hexutil.Big `json:"uncleReward"`
+	Issuance    hexutil.Big `json:"issuance"`
+}
+
+// OtsBlockDetails is a block summary without its transactions.
+type OtsBlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  OtsIssuance            `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// OtsBlockTransactions is a page of the transactions of a block.
+type OtsBlockTransactions struct {
+	FullBlock map[string]interface{}   `json:"fullblock"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+}
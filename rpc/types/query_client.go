@@ -6,21 +6,28 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Auth, bank and ERC20 module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Auth      authtypes.QueryClient
+	Bank      banktypes.QueryClient
+	Erc20     erc20types.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -29,6 +36,9 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Auth:          authtypes.NewQueryClient(clientCtx),
+		Bank:          banktypes.NewQueryClient(clientCtx),
+		Erc20:         erc20types.NewQueryClient(clientCtx),
 	}
 }
 
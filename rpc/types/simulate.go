@@ -0,0 +1,118 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+
+	// SimulateTimestampIncrement is the default amount of seconds added to the
+	// parent timestamp when a simulated block does not override its time.
+	SimulateTimestampIncrement = 1
+)
+
+// JSON-RPC error codes defined by the eth_simulateV1 specification.
+const (
+	SimErrCodeReverted          = 3
+	SimErrCodeVMError           = -32015
+	SimErrCodeInvalidParams     = -32602
+	SimErrCodeNonceTooHigh      = -38011
+	SimErrCodeNonceTooLow       = -38010
+	SimErrCodeBaseFeeTooLow     = -38012
+	SimErrCodeIntrinsicGas      = -38013
+	SimErrCodeInsufficientFunds = -38014
+	SimErrCodeBlockGasLimit     = -38015
+	SimErrCodeBlockNumberOrder  = -38020
+	SimErrCodeBlockTimeOrder    = -38021
+	SimErrCodeClientLimit       = -38026
+)
+
+// TransferLogAddress is the pseudo address used as the emitter of the
+// synthetic ERC-20 style logs produced when traceTransfers is enabled.
+var TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeEeEeEeEEEeeeeEeeeeeeeEEeE")
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// Validate performs the stateless sanity checks of the simulation options.
+func (opts *SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return &SimError{Code: SimErrCodeInvalidParams, Message: "empty input"}
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return &SimError{Code: SimErrCodeClientLimit, Message: "too many blocks"}
+	}
+	return nil
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride             `json:"stateOverrides,omitempty"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is a set of header fields to override for a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number,omitempty"`
+	Time          *hexutil.Uint64 `json:"time,omitempty"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit,omitempty"`
+	FeeRecipient  *common.Address `json:"feeRecipient,omitempty"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+}
+
+// SimCallResult is the result of a single simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed (reverted or halted) simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64   `json:"number"`
+	Hash          common.Hash      `json:"hash"`
+	ParentHash    common.Hash      `json:"parentHash"`
+	Timestamp     hexutil.Uint64   `json:"timestamp"`
+	GasLimit      hexutil.Uint64   `json:"gasLimit"`
+	GasUsed       hexutil.Uint64   `json:"gasUsed"`
+	Miner         common.Address   `json:"miner"`
+	BaseFeePerGas *hexutil.Big     `json:"baseFeePerGas,omitempty"`
+	MixHash       common.Hash      `json:"mixHash"`
+	Transactions  []interface{}    `json:"transactions"`
+	Calls         []*SimCallResult `json:"calls"`
+}
+
+// SimError is an error of an eth_simulateV1 request that aborts the whole
+// simulation. It carries the JSON-RPC error code defined by the specification.
+type SimError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *SimError) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code of the simulation error.
+func (e *SimError) ErrorCode() int { return e.Code }
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+)
+
+func TestSimOptsValidate(t *testing.T) {
+	testCases := map[string]struct {
+		blocks  int
+		expCode int
+	}{
+		"empty input":     {blocks: 0, expCode: rpc.SimErrCodeInvalidParams},
+		"single block":    {blocks: 1},
+		"max blocks":      {blocks: rpc.MaxSimulateBlocks},
+		"too many blocks": {blocks: rpc.MaxSimulateBlocks + 1, expCode: rpc.SimErrCodeClientLimit},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := rpc.SimOpts{BlockStateCalls: make([]rpc.SimBlock, tc.blocks)}
+			err := opts.Validate()
+			if tc.expCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var simErr *rpc.SimError
+			require.True(t, errors.As(err, &simErr))
+			require.Equal(t, tc.expCode, simErr.ErrorCode())
+		})
+	}
+}
+
+func TestSimOptsUnmarshal(t *testing.T) {
+	input := `{
+		"blockStateCalls": [{
+			"blockOverrides": {"number": "0x10", "time": "0x20", "baseFeePerGas": "0x0"},
+			"stateOverrides": {"0x0000000000000000000000000000000000000001": {"balance": "0x1"}},
+			"calls": [{"from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002", "value": "0x1"}]
+		}],
+		"traceTransfers": true,
+		"validation": true
+	}`
+
+	var opts rpc.SimOpts
+	require.NoError(t, json.Unmarshal([]byte(input), &opts))
+	require.NoError(t, opts.Validate())
+	require.True(t, opts.TraceTransfers)
+	require.True(t, opts.Validation)
+	require.False(t, opts.ReturnFullTransactions)
+	require.Len(t, opts.BlockStateCalls, 1)
+
+	block := opts.BlockStateCalls[0]
+	require.Equal(t, int64(0x10), block.BlockOverrides.Number.ToInt().Int64())
+	require.Equal(t, uint64(0x20), uint64(*block.BlockOverrides.Time))
+	require.Nil(t, block.BlockOverrides.GasLimit)
+	require.Contains(t, *block.StateOverrides, common.BytesToAddress([]byte{0x1}))
+	require.Len(t, block.Calls, 1)
+	require.Equal(t, common.BytesToAddress([]byte{0x2}), *block.Calls[0].To)
+}
@@ -0,0 +1,264 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Trace types supported by trace_replayBlockTransactions.
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+// Parity trace frame types.
+const (
+	ParityTraceTypeCall         = "call"
+	ParityTraceTypeCreate       = "create"
+	ParityTraceTypeSelfDestruct = "suicide"
+)
+
+// ParityTraceAction is the action of a Parity (OpenEthereum) style flat call
+// trace, as produced by the flatCallTracer.
+type ParityTraceAction struct {
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+	Address        *common.Address `json:"address,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// ParityTraceResult is the result of a Parity style flat call trace.
+type ParityTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// ParityTrace is a single frame of the Parity style flat call trace of a
+// transaction.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash"`
+	BlockNumber         uint64             `json:"blockNumber"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result,omitempty"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash"`
+	TransactionPosition uint64             `json:"transactionPosition"`
+	Type                string             `json:"type"`
+}
+
+// Addresses returns the sender and the recipient of the trace frame as
+// interpreted by trace_filter. The recipient of a contract creation is the
+// created contract and the recipient of a self-destruct is the beneficiary.
+func (t *ParityTrace) Addresses() (from, to *common.Address) {
+	switch t.Type {
+	case ParityTraceTypeCreate:
+		if t.Result != nil {
+			to = t.Result.Address
+		}
+		return t.Action.From, to
+	case ParityTraceTypeSelfDestruct:
+		return t.Action.Address, t.Action.RefundAddress
+	default:
+		return t.Action.From, t.Action.To
+	}
+}
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *hexutil.Uint64  `json:"after"`
+	Count       *hexutil.Uint64  `json:"count"`
+}
+
+// Matches returns true if the trace frame satisfies the address criteria of
+// the filter. Both the sender and the recipient must match when both address
+// sets are provided, an empty set matches any address.
+func (args *TraceFilterArgs) Matches(t *ParityTrace) bool {
+	from, to := t.Addresses()
+	return matchAddress(args.FromAddress, from) && matchAddress(args.ToAddress, to)
+}
+
+func matchAddress(set []common.Address, addr *common.Address) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range set {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// TraceReplayResult is the result of replaying a transaction with
+// trace_replayBlockTransactions.
+type TraceReplayResult struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       StateDiff      `json:"stateDiff"`
+	Trace           []*ParityTrace `json:"trace"`
+	VMTrace         interface{}    `json:"vmTrace"`
+	TransactionHash *common.Hash   `json:"transactionHash,omitempty"`
+}
+
+// PrestateAccount is an account as reported by the prestateTracer.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the result of the prestateTracer in diff mode.
+type PrestateDiff struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post"`
+}
+
+// StateDiff is the Parity style state diff of a transaction, keyed by the
+// modified accounts.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff is the Parity style diff of a single account. Every field is
+// either the "=" marker for unchanged values, or an object with a single "+"
+// (born), "-" (died) or "*" (changed, with "from" and "to") key.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// diffSame is the Parity marker of an unchanged value.
+const diffSame = "="
+
+type diffChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+func diffBorn(v interface{}) map[string]interface{} { return map[string]interface{}{"+": v} }
+
+func diffDied(v interface{}) map[string]interface{} { return map[string]interface{}{"-": v} }
+
+func diffChanged(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": diffChange{From: from, To: to}}
+}
+
+// NewStateDiff converts the result of the prestateTracer in diff mode into a
+// Parity style state diff.
+func NewStateDiff(diff *PrestateDiff) StateDiff {
+	res := make(StateDiff)
+	if diff == nil {
+		return res
+	}
+
+	for addr, post := range diff.Post {
+		pre, ok := diff.Pre[addr]
+		if !ok {
+			// account created by the transaction
+			acc := &AccountDiff{
+				Balance: diffBorn(balanceOf(post)),
+				Nonce:   diffBorn(hexutil.Uint64(post.Nonce)),
+				Code:    diffBorn(codeOf(post)),
+				Storage: make(map[common.Hash]interface{}, len(post.Storage)),
+			}
+			for key, val := range post.Storage {
+				acc.Storage[key] = diffBorn(val)
+			}
+			res[addr] = acc
+			continue
+		}
+
+		acc := &AccountDiff{
+			Balance: diffSame,
+			Nonce:   diffSame,
+			Code:    diffSame,
+			Storage: make(map[common.Hash]interface{}),
+		}
+		if post.Balance != nil && balanceOf(pre).ToInt().Cmp(post.Balance.ToInt()) != 0 {
+			acc.Balance = diffChanged(balanceOf(pre), post.Balance)
+		}
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			acc.Nonce = diffChanged(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+		}
+		if len(post.Code) > 0 && !bytes.Equal(pre.Code, post.Code) {
+			acc.Code = diffChanged(codeOf(pre), post.Code)
+		}
+		// slots absent from the pre state were empty, slots absent from the
+		// post state were cleared
+		for key, val := range post.Storage {
+			acc.Storage[key] = diffChanged(pre.Storage[key], val)
+		}
+		for key, val := range pre.Storage {
+			if _, ok := post.Storage[key]; !ok {
+				acc.Storage[key] = diffChanged(val, common.Hash{})
+			}
+		}
+		res[addr] = acc
+	}
+
+	for addr, pre := range diff.Pre {
+		if _, ok := diff.Post[addr]; ok {
+			continue
+		}
+		// account destroyed by the transaction
+		acc := &AccountDiff{
+			Balance: diffDied(balanceOf(pre)),
+			Nonce:   diffDied(hexutil.Uint64(pre.Nonce)),
+			Code:    diffDied(codeOf(pre)),
+			Storage: make(map[common.Hash]interface{}, len(pre.Storage)),
+		}
+		for key, val := range pre.Storage {
+			acc.Storage[key] = diffDied(val)
+		}
+		res[addr] = acc
+	}
+
+	return res
+}
+
+func balanceOf(acc *PrestateAccount) *hexutil.Big {
+	if acc.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return acc.Balance
+}
+
+func codeOf(acc *PrestateAccount) hexutil.Bytes {
+	if acc.Code == nil {
+		return hexutil.Bytes{}
+	}
+	return acc.Code
+}
+
+// DecodeTracerResult re-encodes the generic result of a tracer into the
+// given typed value.
+func DecodeTracerResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpc "github.com/cosmos/evm/rpc/types"
+)
+
+func TestTraceFilterArgsMatches(t *testing.T) {
+	var (
+		alice    = common.BytesToAddress([]byte{0x1})
+		bob      = common.BytesToAddress([]byte{0x2})
+		contract = common.BytesToAddress([]byte{0x3})
+	)
+
+	call := &rpc.ParityTrace{
+		Type:   rpc.ParityTraceTypeCall,
+		Action: rpc.ParityTraceAction{From: &alice, To: &bob},
+	}
+	create := &rpc.ParityTrace{
+		Type:   rpc.ParityTraceTypeCreate,
+		Action: rpc.ParityTraceAction{From: &alice},
+		Result: &rpc.ParityTraceResult{Address: &contract},
+	}
+	selfDestruct := &rpc.ParityTrace{
+		Type:   rpc.ParityTraceTypeSelfDestruct,
+		Action: rpc.ParityTraceAction{Address: &contract, RefundAddress: &bob},
+	}
+
+	testCases := map[string]struct {
+		args  rpc.TraceFilterArgs
+		trace *rpc.ParityTrace
+		match bool
+	}{
+		"no addresses":             {args: rpc.TraceFilterArgs{}, trace: call, match: true},
+		"call from match":          {args: rpc.TraceFilterArgs{FromAddress: []common.Address{alice}}, trace: call, match: true},
+		"call from mismatch":       {args: rpc.TraceFilterArgs{FromAddress: []common.Address{bob}}, trace: call, match: false},
+		"call from and to match":   {args: rpc.TraceFilterArgs{FromAddress: []common.Address{alice}, ToAddress: []common.Address{bob}}, trace: call, match: true},
+		"call to mismatch":         {args: rpc.TraceFilterArgs{FromAddress: []common.Address{alice}, ToAddress: []common.Address{alice}}, trace: call, match: false},
+		"create to created":        {args: rpc.TraceFilterArgs{ToAddress: []common.Address{contract}}, trace: create, match: true},
+		"self-destruct from":       {args: rpc.TraceFilterArgs{FromAddress: []common.Address{contract}}, trace: selfDestruct, match: true},
+		"self-destruct to refund":  {args: rpc.TraceFilterArgs{ToAddress: []common.Address{bob}}, trace: selfDestruct, match: true},
+		"self-destruct to missing": {args: rpc.TraceFilterArgs{ToAddress: []common.Address{alice}}, trace: selfDestruct, match: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.match, tc.args.Matches(tc.trace))
+		})
+	}
+}
+
+func TestNewStateDiff(t *testing.T) {
+	var (
+		modified = common.BytesToAddress([]byte{0x1})
+		created  = common.BytesToAddress([]byte{0x2})
+		deleted  = common.BytesToAddress([]byte{0x3})
+		slot     = common.BytesToHash([]byte{0x1})
+		value    = common.BytesToHash([]byte{0x2})
+	)
+	balance := func(v int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(v)) }
+
+	diff := rpc.NewStateDiff(&rpc.PrestateDiff{
+		Pre: map[common.Address]*rpc.PrestateAccount{
+			modified: {Balance: balance(10), Nonce: 1, Code: hexutil.Bytes{0x60}},
+			deleted:  {Balance: balance(5), Storage: map[common.Hash]common.Hash{slot: value}},
+		},
+		Post: map[common.Address]*rpc.PrestateAccount{
+			modified: {Balance: balance(7), Storage: map[common.Hash]common.Hash{slot: value}},
+			created:  {Balance: balance(3), Nonce: 1},
+		},
+	})
+	require.Len(t, diff, 3)
+
+	acc := diff[modified]
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"balance": {"*": {"from": "0xa", "to": "0x7"}},
+		"nonce": "=",
+		"code": "=",
+		"storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": {"*": {"from": "0x0000000000000000000000000000000000000000000000000000000000000000", "to": "0x0000000000000000000000000000000000000000000000000000000000000002"}}}
+	}`, string(bz))
+
+	acc = diff[created]
+	require.Equal(t, map[string]interface{}{"+": balance(3)}, acc.Balance)
+	require.Equal(t, map[string]interface{}{"+": hexutil.Uint64(1)}, acc.Nonce)
+	require.Equal(t, map[string]interface{}{"+": hexutil.Bytes{}}, acc.Code)
+	require.Empty(t, acc.Storage)
+
+	acc = diff[deleted]
+	require.Equal(t, map[string]interface{}{"-": balance(5)}, acc.Balance)
+	require.Equal(t, map[string]interface{}{"-": value}, acc.Storage[slot])
+}
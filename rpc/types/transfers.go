@@ -0,0 +1,93 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+// AssetTransfersArgs are the arguments of eth_getAssetTransfers, in the form
+// of the ones of alchemy_getAssetTransfers.
+type AssetTransfersArgs struct {
+	// FromBlock and ToBlock bound the inclusive block range, they default to
+	// the earliest and the latest block.
+	FromBlock *BlockNumber `json:"fromBlock"`
+	ToBlock   *BlockNumber `json:"toBlock"`
+	// FromAddress and ToAddress filter the transfers by sender and recipient.
+	FromAddress *common.Address `json:"fromAddress"`
+	ToAddress   *common.Address `json:"toAddress"`
+	// ContractAddresses filter the token transfers by token contract.
+	ContractAddresses []common.Address `json:"contractAddresses"`
+	// Category filters the transfers by category: "external", "erc20",
+	// "erc721", "erc1155" or "native".
+	Category []string `json:"category"`
+	// Order is "asc", the default, or "desc".
+	Order string `json:"order"`
+	// MaxCount is the maximum number of transfers of the page.
+	MaxCount *hexutil.Uint64 `json:"maxCount"`
+	// PageKey is the page key returned with the previous page.
+	PageKey string `json:"pageKey"`
+}
+
+// AssetTransfersResult is a page of asset transfers.
+type AssetTransfersResult struct {
+	Transfers []*AssetTransfer `json:"transfers"`
+	// PageKey is the key of the next page, it's omitted on the last page.
+	PageKey string `json:"pageKey,omitempty"`
+}
+
+// AssetTransfer is a transfer of tokens or native coins.
+type AssetTransfer struct {
+	BlockNum hexutil.Uint64 `json:"blockNum"`
+	UniqueID string         `json:"uniqueId"`
+	// Hash is the hash of the eth tx, or of the cosmos tx for the native
+	// transfers of the cosmos txs
+	Hash            common.Hash        `json:"hash"`
+	From            common.Address     `json:"from"`
+	To              common.Address     `json:"to"`
+	Category        string             `json:"category"`
+	Asset           string             `json:"asset,omitempty"`
+	ERC721TokenID   *hexutil.Big       `json:"erc721TokenId,omitempty"`
+	ERC1155Metadata []*ERC1155Metadata `json:"erc1155Metadata,omitempty"`
+	RawContract     AssetRawContract   `json:"rawContract"`
+}
+
+// ERC1155Metadata is the amount of an ERC-1155 token id transferred.
+type ERC1155Metadata struct {
+	TokenID *hexutil.Big `json:"tokenId"`
+	Value   *hexutil.Big `json:"value"`
+}
+
+// AssetRawContract is the raw amount and the token contract of a transfer.
+type AssetRawContract struct {
+	Value   *hexutil.Big    `json:"value"`
+	Address *common.Address `json:"address"`
+}
+
+// NewAssetTransfer returns the asset transfer of an indexed transfer.
+func NewAssetTransfer(t *servertypes.Transfer) *AssetTransfer {
+	transfer := &AssetTransfer{
+		BlockNum: hexutil.Uint64(t.Height), //nolint:gosec // G115 // block height is never negative
+		UniqueID: t.TxHash.Hex() + ":" + hexutil.EncodeUint64(t.Index),
+		Hash:     t.TxHash,
+		From:     t.From,
+		To:       t.To,
+		Category: t.Category,
+		Asset:    t.Denom,
+		RawContract: AssetRawContract{
+			Value:   (*hexutil.Big)(t.Value),
+			Address: t.Contract,
+		},
+	}
+	if t.TokenID != nil {
+		transfer.ERC721TokenID = (*hexutil.Big)(t.TokenID)
+	}
+	for _, erc1155 := range t.ERC1155 {
+		transfer.ERC1155Metadata = append(transfer.ERC1155Metadata, &ERC1155Metadata{
+			TokenID: (*hexutil.Big)(erc1155.TokenID),
+			Value:   (*hexutil.Big)(erc1155.Value),
+		})
+	}
+	return transfer
+}
@@ -184,6 +184,94 @@ type AccessListResult struct {
 	Error      string               `json:"error,omitempty"`
 }
 
+// SyncingResult is the notification of the `syncing` subscription, in the
+// same form as geth.
+type SyncingResult struct {
+	Syncing bool        `json:"syncing"`
+	Status  *SyncStatus `json:"status,omitempty"`
+}
+
+// SyncStatus is the progress of the block sync, the highest block of the
+// network is not known by CometBFT.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+}
+
+// AddressTransactionsArgs are the arguments of eth_getTransactionsByAddress.
+type AddressTransactionsArgs struct {
+	// FromBlock and ToBlock bound the inclusive block range, they default to
+	// the earliest and the latest block.
+	FromBlock *BlockNumber `json:"fromBlock"`
+	ToBlock   *BlockNumber `json:"toBlock"`
+	// Reverse returns the most recent transactions first.
+	Reverse bool `json:"reverse"`
+	// PageSize is the minimum number of transactions of the page when
+	// available, the transactions of a block are never split across pages.
+	PageSize *hexutil.Uint64 `json:"pageSize"`
+	// PageKey is the page key returned with the previous page.
+	PageKey *hexutil.Uint64 `json:"pageKey"`
+}
+
+// AddressTransactionsResult is a page of the transactions an address appears
+// in.
+type AddressTransactionsResult struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	// PageKey is the key of the next page, it's omitted on the last page.
+	PageKey *hexutil.Uint64 `json:"pageKey,omitempty"`
+}
+
+// AddressTransaction is a transaction an address appears in, with the roles of
+// the address in the transaction: "from", "to" or "created".
+type AddressTransaction struct {
+	*RPCTransaction
+	Roles []string `json:"roles"`
+}
+
+// SendBundleArgs are the arguments of eth_sendBundle.
+type SendBundleArgs struct {
+	// Txs are the signed raw txs of the bundle, in order.
+	Txs []hexutil.Bytes `json:"txs"`
+	// BlockNumber is the first block the bundle can be included in.
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	// MaxBlockNumber is the last block the bundle can be included in, it
+	// defaults to BlockNumber.
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"`
+	// MinTimestamp and MaxTimestamp bound the timestamp of the blocks the
+	// bundle can be included in.
+	MinTimestamp *hexutil.Uint64 `json:"minTimestamp"`
+	MaxTimestamp *hexutil.Uint64 `json:"maxTimestamp"`
+	// RevertProtection drops the bundle if any of its txs reverts.
+	RevertProtection bool `json:"revertProtection"`
+}
+
+// SendBundleResult is the result of eth_sendBundle.
+type SendBundleResult struct {
+	BundleHash common.Hash `json:"bundleHash"`
+}
+
+// TxStatusResult is the result of txpool_txStatus, the stage of its lifecycle
+// a tx is in: "pending", "queued", "underpriced", "replaced", "evicted",
+// "included" or "unknown".
+type TxStatusResult struct {
+	Status string `json:"status"`
+	// Nonce is the nonce of the tx, if it's in the mempool.
+	Nonce *hexutil.Uint64 `json:"nonce,omitempty"`
+	// ExpectedNonce is the nonce of the next tx of the sender the mempool
+	// expects, if the tx is queued.
+	ExpectedNonce *hexutil.Uint64 `json:"expectedNonce,omitempty"`
+	// BaseFee is the current base fee, if the tx is underpriced.
+	BaseFee *hexutil.Big `json:"baseFee,omitempty"`
+	// ReplacedBy is the hash of the tx that replaced the tx.
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"`
+	// Reason is why the tx was evicted.
+	Reason string `json:"reason,omitempty"`
+	// BlockNumber is the number of the block the tx was included in.
+	BlockNumber *hexutil.Uint64 `json:"blockNumber,omitempty"`
+	// RemovedAt is the unix time the tx exited the mempool at.
+	RemovedAt *hexutil.Uint64 `json:"removedAt,omitempty"`
+}
+
 // Embedded TraceConfig type to store raw JSON data of config in custom field
 type TraceConfig struct {
 	evmtypes.TraceConfig
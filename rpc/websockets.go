@@ -14,8 +14,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -23,8 +25,13 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	"github.com/cosmos/evm/rpc/access"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/jsonrpc"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log/v2"
@@ -34,6 +41,10 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+
+	// syncingPollInterval is the interval of the CometBFT sync status checks
+	// of the syncing subscriptions
+	syncingPollInterval = time.Second
 )
 
 type WebsocketsServer interface {
@@ -55,6 +66,9 @@ type SubscriptionNotification struct {
 type SubscriptionResult struct {
 	Subscription rpc.ID `json:"subscription"`
 	Result       any    `json:"result"`
+	// Cursor locates the notified header or log, a new subscription passing it
+	// resumes right after it.
+	Cursor string `json:"cursor,omitempty"`
 }
 
 type ErrorResponseJSON struct {
@@ -76,9 +90,25 @@ type websocketsServer struct {
 	allowedOrigins []string // allowed origins for WebSocket connections
 	api            *pubSubAPI
 	logger         log.Logger
+	// limiter admits the requests and subscriptions, nil if rate limiting
+	// is disabled
+	limiter *ratelimit.Limiter
+	// methods filters the served methods, nil if all of them are served
+	methods *access.MethodFilter
+	// handler serves the requests in process instead of the server at
+	// rpcAddr, if set
+	handler http.Handler
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	evmBackend backend.EVMBackend,
+	cfg *config.Config,
+	limiter *ratelimit.Limiter,
+	methods *access.MethodFilter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -86,14 +116,20 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		api:            newPubSubAPI(clientCtx, logger, stream, evmBackend, int64(cfg.JSONRPC.BlockRangeCap)),
 		logger:         logger,
+		limiter:        limiter,
+		methods:        methods,
 	}
 }
 
 func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
+	if s.limiter != nil {
+		// the API key can also be given as the URL path
+		ws.Handle("/{"+ratelimit.APIKeyPathVar+"}", s)
+	}
 
 	go func() {
 		var err error
@@ -181,6 +217,14 @@ func (s *websocketsServer) checkOrigin(r *http.Request) bool {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	client := ratelimit.ClientFromRequest(r)
+	if s.limiter != nil {
+		if err := s.limiter.ValidateClient(client); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: s.checkOrigin,
 	}
@@ -194,8 +238,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: client,
 	}
 
 	s.readLoop(ws)
@@ -214,9 +259,18 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// messageConn is the connection of a client sending JSON-RPC messages, either
+// a websocket or an IPC connection.
+type messageConn interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteJSON(v any) error
+	Close() error
+}
+
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   messageConn
+	mux    *sync.Mutex
+	client ratelimit.Client
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -300,6 +354,11 @@ readLoop:
 			continue
 		}
 
+		if s.methods != nil && !s.methods.Allowed(method) {
+			_ = wsConn.WriteJSON(jsonrpc.ParseRequest(mb).ErrorResponse(access.MethodNotFound(method))) // #nosec G703
+			continue
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
@@ -307,8 +366,15 @@ readLoop:
 				continue
 			}
 
+			if err := s.admitSubscription(wsConn, len(subscriptions)); err != nil {
+				s.sendLimitExceededResponse(wsConn, mb, err.Error())
+				continue
+			}
+
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			// the replayed notifications must not precede the subscription id
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -325,6 +391,7 @@ readLoop:
 				s.logger.Error("error writing subscription response", "error", err.Error())
 				break readLoop
 			}
+			close(ready)
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
@@ -379,36 +446,89 @@ func (s *websocketsServer) getParamsAndCheckValid(msg map[string]any, wsConn *ws
 	return params, true
 }
 
+// admitSubscription returns an error if the client can't create a new
+// subscription, given its number of active subscriptions.
+func (s *websocketsServer) admitSubscription(wsConn *wsConn, active int) error {
+	if s.limiter == nil {
+		return nil
+	}
+	if maxSubs := s.limiter.MaxSubscriptions(); maxSubs > 0 && active >= maxSubs {
+		return fmt.Errorf("too many subscriptions, max is %d", maxSubs)
+	}
+	if ok, retryAfter := s.limiter.AllowSubscribe(wsConn.client); !ok {
+		return errors.New(ratelimit.LimitExceededMessage(retryAfter))
+	}
+	return nil
+}
+
+// sendLimitExceededResponse responds to the request with the -32005 error.
+func (s *websocketsServer) sendLimitExceededResponse(wsConn *wsConn, mb []byte, msg string) {
+	res := jsonrpc.ParseRequest(mb).ErrorResponse(ratelimit.ErrCodeLimitExceeded, msg)
+	_ = wsConn.WriteJSON(res) // #nosec G703
+}
+
 // tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
 // to the client over websockets
 func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
+	if s.limiter != nil {
+		if ok, retryAfter := s.limiter.AllowRequest(wsConn.client, jsonrpc.ParseRequest(mb).Methods()); !ok {
+			s.sendLimitExceededResponse(wsConn, mb, ratelimit.LimitExceededMessage(retryAfter))
+			return nil
+		}
+	}
+
+	body, err := s.forwardRequest(mb)
+	if err != nil {
+		return err
+	}
+
+	var wsSend any
+	err = json.Unmarshal(body, &wsSend)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal rest-server response")
+	}
+
+	return wsConn.WriteJSON(wsSend)
+}
+
+// forwardRequest returns the response of the JSON-RPC server to the request,
+// served in process if the server has a handler.
+func (s *websocketsServer) forwardRequest(mb []byte) ([]byte, error) {
+	if s.handler != nil {
+		req, err := http.NewRequestWithContext(context.Background(), "POST", "/", bytes.NewReader(mb))
+		if err != nil {
+			return nil, errors.Wrap(err, "Could not build request")
+		}
+		req.Header.Set("Content-Type", "application/json")
+		buf := jsonrpc.NewResponseBuffer()
+		s.handler.ServeHTTP(buf, req)
+		return buf.Bytes(), nil
+	}
+
 	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
 	if err != nil {
-		return errors.Wrap(err, "Could not build request")
+		return nil, errors.Wrap(err, "Could not build request")
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		// the request was admitted above, it must not be charged again
+		s.limiter.MarkAdmitted(req)
+	}
 	client := &http.Client{}
 	// #nosec G704 -- URL is node's own rpcAddr from config, not user-controlled
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+		return nil, errors.Wrap(err, "Could not perform request")
 	}
 
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
-	}
-
-	var wsSend any
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+		return nil, errors.Wrap(err, "could not read body from response")
 	}
-
-	return wsConn.WriteJSON(wsSend)
+	return body, nil
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
@@ -416,19 +536,33 @@ type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
 	clientCtx client.Context
+	backend   backend.EVMBackend
+	// maxReplayBlocks caps the number of blocks replayed when resuming a
+	// subscription, 0 for no cap
+	maxReplayBlocks int64
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	evmBackend backend.EVMBackend,
+	maxReplayBlocks int64,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    stream,
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:          stream,
+		logger:          logger,
+		clientCtx:       clientCtx,
+		backend:         evmBackend,
+		maxReplayBlocks: maxReplayBlocks,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (context.CancelFunc, error) {
+// subscribe starts the subscription, the notifications replayed from history
+// are only sent once ready is closed.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any, ready <-chan struct{}) (context.CancelFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -436,15 +570,24 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 
 	switch method {
 	case "newHeads":
-		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribeNewHeads(wsConn, subID, params[1], ready)
+		}
+		return api.subscribeNewHeads(wsConn, subID, nil, ready)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			fullTx, ok := params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid parameters")
+			}
+			return api.subscribePendingTransactions(wsConn, subID, fullTx)
+		}
+		return api.subscribePendingTransactions(wsConn, subID, false)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -452,38 +595,106 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []any) (con
 	}
 }
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID, extra any, ready <-chan struct{}) (context.CancelFunc, error) {
+	var resume *subscriptionCursor
+	if extra != nil {
+		params, ok := extra.(map[string]any)
+		if !ok {
+			return nil, errors.New("invalid parameters")
+		}
+		var err error
+		if resume, err = parseResumeParams(params); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
-		for _, header := range headers {
-			// write to ws conn
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       header.EthHeader,
-				},
+	headers := api.events.HeaderStream()
+	if resume == nil {
+		//nolint: errcheck
+		go headers.Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
+			for _, header := range headers {
+				if err := api.notify(wsConn, subID, header.EthHeader, blockCursor(header.EthHeader.Number.Uint64()).String()); err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+		return cancel, nil
+	}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				api.logger.Error("error writing header, will drop peer", "error", err.Error())
+	// read the stream offset before the latest block, so the replay and the
+	// stream overlap instead of leaving a gap
+	offset := headers.LastID()
+	latest, err := api.replayRange(ctx, *resume)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
 
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
-				return err
-			}
+	go func() {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
+		if err := api.replayHeaders(ctx, wsConn, subID, *resume, latest); err != nil {
+			api.dropPeer(wsConn, "error replaying headers", err)
+			return
 		}
-		return nil
-	})
+
+		cursor := blockCursor(max(resume.height, latest))
+		//nolint: errcheck
+		headers.SubscribeFrom(ctx, offset, func(headers []stream.RPCHeader, _ int) error {
+			for _, header := range headers {
+				height := header.EthHeader.Number.Uint64()
+				if cursor.coversBlock(height) {
+					continue
+				}
+				cursor = blockCursor(height)
+				if err := api.notify(wsConn, subID, header.EthHeader, cursor.String()); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}()
 
 	return cancel, nil
 }
 
+// notify writes a subscription notification to the ws conn, and drops the
+// peer on failure.
+func (api *pubSubAPI) notify(wsConn *wsConn, subID rpc.ID, result any, cursor string) error {
+	res := &SubscriptionNotification{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscription",
+		Params: &SubscriptionResult{
+			Subscription: subID,
+			Result:       result,
+			Cursor:       cursor,
+		},
+	}
+
+	if err := wsConn.WriteJSON(res); err != nil {
+		api.dropPeer(wsConn, "error writing notification, will drop peer", err)
+		return err
+	}
+	return nil
+}
+
+// dropPeer closes the ws conn, the client is expected to reconnect and resume
+// its subscriptions from the last cursor received.
+func (api *pubSubAPI) dropPeer(wsConn *wsConn, msg string, err error) {
+	api.logger.Error(msg, "error", err.Error())
+
+	try(func() {
+		if err != websocket.ErrCloseSent {
+			_ = wsConn.Close()
+		}
+	}, api.logger, "closing websocket peer sub")
+}
+
 func try(fn func(), l log.Logger, desc string) {
 	defer func() {
 		if x := recover(); x != nil {
@@ -501,8 +712,11 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (context.CancelFunc, error) {
-	crit := filters.FilterCriteria{}
+func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any, ready <-chan struct{}) (context.CancelFunc, error) {
+	var (
+		crit   = filters.FilterCriteria{}
+		resume *subscriptionCursor
+	)
 
 	if extra != nil {
 		params, ok := extra.(map[string]any)
@@ -590,35 +804,94 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 				crit.Topics[topicIdx] = subtopicsCollect
 			}
 		}
+
+		var err error
+		if resume, err = parseResumeParams(params); err != nil {
+			return nil, err
+		}
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
-		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-		if len(logs) == 0 {
+	logStream := api.events.LogStream()
+	if resume == nil {
+		//nolint: errcheck
+		go logStream.Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
+			for _, ethLog := range rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics) {
+				if err := api.notify(wsConn, subID, ethLog, logCursor(ethLog).String()); err != nil {
+					return err
+				}
+			}
 			return nil
+		})
+		return cancel, nil
+	}
+
+	// read the stream offset before the latest block, so the replay and the
+	// stream overlap instead of leaving a gap
+	offset := logStream.LastID()
+	latest, err := api.replayRange(ctx, *resume)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
+		if err := api.replayLogs(ctx, wsConn, subID, crit, *resume, latest); err != nil {
+			api.dropPeer(wsConn, "error replaying logs", err)
+			return
 		}
 
-		for _, ethLog := range logs {
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       ethLog,
-				},
+		// the replay delivered all the logs up to the end of the latest block
+		cursor := *resume
+		if latest >= cursor.height {
+			cursor = blockCursor(latest)
+		}
+		//nolint: errcheck
+		logStream.SubscribeFrom(ctx, offset, func(txLogs []*ethtypes.Log, _ int) error {
+			for _, ethLog := range rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics) {
+				if cursor.coversLog(ethLog) {
+					continue
+				}
+				cursor = logCursor(ethLog)
+				if err := api.notify(wsConn, subID, ethLog, cursor.String()); err != nil {
+					return err
+				}
 			}
+			return nil
+		})
+	}()
 
-			err := wsConn.WriteJSON(res)
-			if err != nil {
-				api.logger.Error("error writing header, will drop peer", "error", err.Error())
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
-					}
-				}, api.logger, "closing websocket peer sub")
+	return cancel, nil
+}
+
+// subscribePendingTransactions notifies the hashes of the txs entering the
+// mempool, or the full txs if fullTx is set, skipping the ones that already
+// left the mempool when notified.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
+		if !fullTx {
+			for _, hash := range items {
+				if err := api.notify(wsConn, subID, hash, ""); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
 
+		txs, err := api.backend.GetPendingTransactions(ctx, items)
+		if err != nil {
+			api.logger.Debug("failed to get pending transactions", "error", err.Error())
+			return nil
+		}
+		for _, tx := range txs {
+			if err := api.notify(wsConn, subID, tx, ""); err != nil {
 				return err
 			}
 		}
@@ -628,43 +901,48 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra any) (co
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+// subscribeSyncing notifies the changes of the CometBFT catch-up status, and
+// the progress of the sync while catching up.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
 	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
-			// write to ws conn
-			res := &SubscriptionNotification{
-				Jsonrpc: "2.0",
-				Method:  "eth_subscription",
-				Params: &SubscriptionResult{
-					Subscription: subID,
-					Result:       hash,
-				},
-			}
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
 
-			err := wsConn.WriteJSON(res)
+		last := rpctypes.SyncingResult{}
+		for {
+			status, err := api.clientCtx.Client.Status(ctx)
 			if err != nil {
-				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+				api.logger.Debug("failed to fetch sync status", "error", err.Error())
+			} else {
+				result := rpctypes.SyncingResult{Syncing: status.SyncInfo.CatchingUp}
+				if result.Syncing {
+					result.Status = &rpctypes.SyncStatus{
+						StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight), //nolint:gosec // G115 // block height is never negative
+						CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),   //nolint:gosec // G115 // block height is never negative
+					}
+				}
 
-				try(func() {
-					if err != websocket.ErrCloseSent {
-						_ = wsConn.Close()
+				progressed := result.Syncing && last.Syncing && result.Status.CurrentBlock != last.Status.CurrentBlock
+				if result.Syncing != last.Syncing || progressed {
+					if err := api.notify(wsConn, subID, result, ""); err != nil {
+						return
 					}
-				}, api.logger, "closing websocket peer sub")
-				return err
+					last = result
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
 			}
 		}
-		return nil
-	})
+	}()
 
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
-}
-
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
 // isBatch returns true when the first non-whitespace characters is '['
 func isBatch(raw []byte) bool {
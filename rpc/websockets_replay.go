@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+const (
+	blockCursorLength = 8
+	logCursorLength   = 8 + 8 + 8
+)
+
+// subscriptionCursor is the position of the last item delivered by a
+// subscription, either a block or a log of a block. It's returned to the
+// client with each notification, so a new subscription can resume after it.
+type subscriptionCursor struct {
+	height uint64
+	// hasLog is false if the cursor points to the end of the block
+	hasLog   bool
+	txIndex  uint64
+	logIndex uint64
+}
+
+func blockCursor(height uint64) subscriptionCursor {
+	return subscriptionCursor{height: height}
+}
+
+func logCursor(log *ethtypes.Log) subscriptionCursor {
+	return subscriptionCursor{height: log.BlockNumber, hasLog: true, txIndex: uint64(log.TxIndex), logIndex: uint64(log.Index)}
+}
+
+// String encodes the cursor as an opaque hex string.
+func (c subscriptionCursor) String() string {
+	bz := binary.BigEndian.AppendUint64(nil, c.height)
+	if c.hasLog {
+		bz = binary.BigEndian.AppendUint64(bz, c.txIndex)
+		bz = binary.BigEndian.AppendUint64(bz, c.logIndex)
+	}
+	return hexutil.Encode(bz)
+}
+
+// coversBlock returns true if the block at the given height was delivered.
+func (c subscriptionCursor) coversBlock(height uint64) bool {
+	return height <= c.height
+}
+
+// coversLog returns true if the log was delivered, logs are ordered by block,
+// then by tx and by index within the tx.
+func (c subscriptionCursor) coversLog(log *ethtypes.Log) bool {
+	switch {
+	case log.BlockNumber != c.height:
+		return log.BlockNumber < c.height
+	case !c.hasLog:
+		return true
+	case uint64(log.TxIndex) != c.txIndex:
+		return uint64(log.TxIndex) < c.txIndex
+	default:
+		return uint64(log.Index) <= c.logIndex
+	}
+}
+
+func parseSubscriptionCursor(s string) (subscriptionCursor, error) {
+	bz, err := hexutil.Decode(s)
+	if err != nil {
+		return subscriptionCursor{}, errors.Wrap(err, "invalid cursor")
+	}
+	switch len(bz) {
+	case blockCursorLength:
+		return blockCursor(binary.BigEndian.Uint64(bz)), nil
+	case logCursorLength:
+		return subscriptionCursor{
+			height:   binary.BigEndian.Uint64(bz[:8]),
+			hasLog:   true,
+			txIndex:  binary.BigEndian.Uint64(bz[8:16]),
+			logIndex: binary.BigEndian.Uint64(bz[16:]),
+		}, nil
+	default:
+		return subscriptionCursor{}, fmt.Errorf("invalid cursor length %d", len(bz))
+	}
+}
+
+// parseResumeParams returns the position to resume the subscription after,
+// given either as the `fromBlock` to replay from or as the `cursor` of the
+// last notification received. It returns nil if the subscription only
+// delivers the new items.
+func parseResumeParams(params map[string]any) (*subscriptionCursor, error) {
+	fromBlock, hasFromBlock := params["fromBlock"]
+	cursor, hasCursor := params["cursor"]
+
+	switch {
+	case hasFromBlock && hasCursor:
+		return nil, errors.New("fromBlock and cursor are mutually exclusive")
+	case hasCursor:
+		s, ok := cursor.(string)
+		if !ok {
+			return nil, errors.New("invalid cursor")
+		}
+		c, err := parseSubscriptionCursor(s)
+		if err != nil {
+			return nil, err
+		}
+		return &c, nil
+	case hasFromBlock:
+		s, ok := fromBlock.(string)
+		if !ok {
+			return nil, errors.New("invalid fromBlock")
+		}
+		height, err := hexutil.DecodeUint64(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid fromBlock")
+		}
+		if height > 0 {
+			height--
+		}
+		c := blockCursor(height)
+		return &c, nil
+	default:
+		return nil, nil
+	}
+}
+
+// replayRange returns the latest block to replay from history up to, before
+// continuing with the stream. The stream offset must be read before, so the
+// stream covers all the blocks after the returned one.
+func (api *pubSubAPI) replayRange(ctx context.Context, cursor subscriptionCursor) (uint64, error) {
+	status, err := api.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch the latest block")
+	}
+	latest := uint64(status.SyncInfo.LatestBlockHeight) //nolint:gosec // G115 // block height is never negative
+
+	if api.maxReplayBlocks > 0 && latest > cursor.height && latest-cursor.height > uint64(api.maxReplayBlocks) {
+		return 0, fmt.Errorf("cannot replay more than %d blocks, latest block is %d", api.maxReplayBlocks, latest)
+	}
+	return latest, nil
+}
+
+// replayHeaders delivers the headers of the blocks after the cursor up to the
+// latest one, in the same form as the header stream.
+func (api *pubSubAPI) replayHeaders(ctx context.Context, wsConn *wsConn, subID rpc.ID, cursor subscriptionCursor, latest uint64) error {
+	for height := cursor.height + 1; height <= latest; height++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		h := int64(height) //nolint:gosec // G115 // block height won't exceed int64
+		block, err := api.clientCtx.Client.Block(ctx, &h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch block %d", height)
+		}
+		blockRes, err := api.clientCtx.Client.BlockResults(ctx, &h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch block result %d", height)
+		}
+
+		baseFee := types.BaseFeeFromEvents(blockRes.FinalizeBlockEvents)
+		header := types.EthHeaderFromComet(block.Block.Header, ethtypes.Bloom{}, baseFee)
+		if err := api.notify(wsConn, subID, header, blockCursor(height).String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayLogs delivers the logs matching the criteria after the cursor up to
+// the end of the latest block.
+func (api *pubSubAPI) replayLogs(
+	ctx context.Context,
+	wsConn *wsConn,
+	subID rpc.ID,
+	crit filters.FilterCriteria,
+	cursor subscriptionCursor,
+	latest uint64,
+) error {
+	height := cursor.height
+	if !cursor.hasLog {
+		height++
+	}
+	for ; height <= latest; height++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		h := int64(height) //nolint:gosec // G115 // block height won't exceed int64
+		blockRes, err := api.clientCtx.Client.BlockResults(ctx, &h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch block result %d", height)
+		}
+
+		for _, txResult := range blockRes.TxsResults {
+			txLogs, err := evmtypes.DecodeTxLogs(txResult.Data, height)
+			if err != nil {
+				api.logger.Error("fail to decode evm tx response", "error", err.Error())
+				continue
+			}
+			for _, ethLog := range rpcfilters.FilterLogs(txLogs, nil, nil, crit.Addresses, crit.Topics) {
+				if cursor.coversLog(ethLog) {
+					continue
+				}
+				if err := api.notify(wsConn, subID, ethLog, logCursor(ethLog).String()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
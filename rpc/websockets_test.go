@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 
@@ -31,7 +32,7 @@ func newTestWebsocketServer() *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil, 0),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
@@ -214,3 +215,60 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestParseResumeParams(t *testing.T) {
+	logCur := subscriptionCursor{height: 10, hasLog: true, txIndex: 2, logIndex: 1}
+
+	tests := []struct {
+		name     string
+		params   map[string]any
+		expected *subscriptionCursor
+		expErr   bool
+	}{
+		{name: "no resume", params: map[string]any{"address": "0x1"}},
+		{name: "from block", params: map[string]any{"fromBlock": "0xa"}, expected: &subscriptionCursor{height: 9}},
+		{name: "from genesis", params: map[string]any{"fromBlock": "0x0"}, expected: &subscriptionCursor{height: 0}},
+		{name: "block cursor", params: map[string]any{"cursor": blockCursor(10).String()}, expected: &subscriptionCursor{height: 10}},
+		{name: "log cursor", params: map[string]any{"cursor": logCur.String()}, expected: &logCur},
+		{name: "both", params: map[string]any{"fromBlock": "0xa", "cursor": logCur.String()}, expErr: true},
+		{name: "invalid from block", params: map[string]any{"fromBlock": "latest"}, expErr: true},
+		{name: "invalid cursor", params: map[string]any{"cursor": "0x01"}, expErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := parseResumeParams(tt.params)
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, cursor)
+		})
+	}
+}
+
+func TestSubscriptionCursorCoversLog(t *testing.T) {
+	logCur := subscriptionCursor{height: 10, hasLog: true, txIndex: 2, logIndex: 1}
+
+	tests := []struct {
+		name     string
+		cursor   subscriptionCursor
+		log      *ethtypes.Log
+		expected bool
+	}{
+		{"previous block", logCur, &ethtypes.Log{BlockNumber: 9, TxIndex: 5}, true},
+		{"next block", logCur, &ethtypes.Log{BlockNumber: 11}, false},
+		{"previous tx", logCur, &ethtypes.Log{BlockNumber: 10, TxIndex: 1, Index: 3}, true},
+		{"next tx", logCur, &ethtypes.Log{BlockNumber: 10, TxIndex: 3}, false},
+		{"same log", logCur, &ethtypes.Log{BlockNumber: 10, TxIndex: 2, Index: 1}, true},
+		{"next log", logCur, &ethtypes.Log{BlockNumber: 10, TxIndex: 2, Index: 2}, false},
+		{"end of block", blockCursor(10), &ethtypes.Log{BlockNumber: 10, TxIndex: 9}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.cursor.coversLog(tt.log))
+		})
+	}
+}
@@ -7,10 +7,15 @@ import (
 	"path"
 	"time"
 
+	"github.com/holiman/uint256"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
 
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/access"
+	"github.com/cosmos/evm/rpc/ratelimit"
+
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -91,6 +96,12 @@ const (
 	// DefaultEVMTimeout is the default timeout for eth_call
 	DefaultEVMTimeout = 5 * time.Second
 
+	// DefaultTxSyncTimeout is the default time eth_sendRawTransactionSync waits for the tx inclusion
+	DefaultTxSyncTimeout = 20 * time.Second
+
+	// DefaultTxSyncMaxTimeout is the default max time eth_sendRawTransactionSync can be asked to wait
+	DefaultTxSyncMaxTimeout = time.Minute
+
 	// DefaultTxFeeCap is the default tx-fee cap for sending a transaction
 	DefaultTxFeeCap float64 = 1.0
 
@@ -114,18 +125,63 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultResponseCacheSize is the default memory budget in MB of the cache of the historical responses
+	DefaultResponseCacheSize = 64
+
+	// DefaultGasPriceOracleBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile = 60
+
+	// DefaultGasPriceOracleMaxPrice is the default max tip suggested by the gas price oracle (500 gwei)
+	DefaultGasPriceOracleMaxPrice = 500_000_000_000
+
+	// DefaultGasPriceOracleIgnorePrice is the default tip below which the transactions aren't sampled by the gas
+	// price oracle
+	DefaultGasPriceOracleIgnorePrice = 2
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
 	// DefaultWSOrigins is the default origin for WebSocket connections
 	DefaultWSOrigins = "127.0.0.1"
 
+	// DefaultRateLimitPerIP is the default number of request cost units per second allowed per client IP
+	DefaultRateLimitPerIP = 100.0
+
+	// DefaultRateLimitBurst is the default number of request cost units a client can spend at once
+	DefaultRateLimitBurst = 200
+
+	// DefaultRateLimitWSSubscribeRate is the default number of websocket subscriptions per second a client can create
+	DefaultRateLimitWSSubscribeRate = 1.0
+
+	// DefaultRateLimitWSSubscribeBurst is the default number of websocket subscriptions a client can create at once
+	DefaultRateLimitWSSubscribeBurst = 20
+
+	// DefaultRateLimitWSMaxSubscriptions is the default max number of active subscriptions of a websocket connection
+	DefaultRateLimitWSMaxSubscriptions = 100
+
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+// DefaultRateLimitMethodCosts are the default costs of the expensive JSON-RPC methods.
+var DefaultRateLimitMethodCosts = []string{
+	"debug_traceBlockByNumber=50",
+	"debug_traceBlockByHash=50",
+	"debug_traceTransaction=20",
+	"debug_traceCall=20",
+	"trace_block=50",
+	"trace_filter=50",
+	"trace_transaction=20",
+	"eth_getLogs=10",
+	"eth_call=2",
+	"eth_estimateGas=2",
+}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -169,8 +225,20 @@ type MempoolConfig struct {
 	AccountQueue uint64 `mapstructure:"account-queue"`
 	// GlobalQueue is the maximum number of non-executable transaction slots for all accounts
 	GlobalQueue uint64 `mapstructure:"global-queue"`
+	// GlobalBytes is the maximum size in bytes of all the transactions of the
+	// pool, the ones with the lowest effective tip are evicted first when it's
+	// exceeded (0 means unbounded)
+	GlobalBytes uint64 `mapstructure:"global-bytes"`
+	// AccountSlotsBalance is the balance in wei for which an account is
+	// granted AccountSlots and AccountQueue. The accounts with a higher
+	// balance are granted proportionally more, up to 4 times (empty disables
+	// the scaling)
+	AccountSlotsBalance string `mapstructure:"account-slots-balance"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// QueuedTxLifetime is the maximum amount of time a single non-executable
+	// transaction is queued, even if its account stays active (0 disables it)
+	QueuedTxLifetime time.Duration `mapstructure:"queued-tx-lifetime"`
 	// OperateExclusively determines if the mempool will assume that it is
 	// running as the only mempool in the application (no CometBFT mempool).
 	// This enables the use of new Krakatoa CometBFT ABCI methods should as
@@ -183,6 +251,46 @@ type MempoolConfig struct {
 	// InsertQueueSize is the maximum number of transactions that can be in the
 	// insert queue at once (0 means unbounded)
 	InsertQueueSize int `mapstructure:"insert-queue-size"`
+	// Journal is the path of the file the txs of the mempool are journaled
+	// to, so that they survive node restarts, relative to the data directory
+	// of the node. Journaling is disabled if it's empty.
+	Journal string `mapstructure:"journal"`
+	// Rejournal is the interval at which the journal is regenerated from the
+	// txs in the mempool
+	Rejournal time.Duration `mapstructure:"rejournal"`
+	// JournalLocals restricts the journal to the EVM txs submitted over RPC
+	JournalLocals bool `mapstructure:"journal-locals"`
+	// TxStatusRetention is how long the reason an EVM tx exited the mempool
+	// is retained for, to report its status (0 disables it)
+	TxStatusRetention time.Duration `mapstructure:"tx-status-retention"`
+	// Lanes reserve shares of the block space of the proposals to the txs
+	// with given message types
+	Lanes []MempoolLaneConfig `mapstructure:"lanes"`
+}
+
+// MempoolLaneConfig defines a lane of the block space of the proposals.
+type MempoolLaneConfig struct {
+	// Name identifies the lane in the metrics
+	Name string `mapstructure:"name"`
+	// Share is the percentage of the max block gas and bytes reserved to the
+	// lane
+	Share uint64 `mapstructure:"share"`
+	// MsgTypeURLs are the type URLs of the messages of the txs of the lane,
+	// the lane without type URLs matches the txs no other lane matches
+	MsgTypeURLs []string `mapstructure:"msg-type-urls"`
+}
+
+// ToLanes returns the mempool lanes of the lane configurations.
+func ToLanes(configs []MempoolLaneConfig) []evmmempool.Lane {
+	lanes := make([]evmmempool.Lane, len(configs))
+	for i, config := range configs {
+		lanes[i] = evmmempool.Lane{
+			Name:        config.Name,
+			Share:       config.Share,
+			MsgTypeURLs: config.MsgTypeURLs,
+		}
+	}
+	return lanes
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -194,10 +302,18 @@ func DefaultMempoolConfig() MempoolConfig {
 		GlobalSlots:              5120,                   // 4096 + 1024 = 5120 global executable slots
 		AccountQueue:             64,                     // 64 non-executable transaction slots per account
 		GlobalQueue:              1024,                   // 1024 global non-executable slots
+		GlobalBytes:              0,                      // No byte budget by default
+		AccountSlotsBalance:      "",                     // Per-account limits are not scaled by balance by default
 		Lifetime:                 3 * time.Hour,          // 3 hour lifetime for queued transactions
+		QueuedTxLifetime:         0,                      // Queued transactions only expire with their account by default
 		OperateExclusively:       false,                  // Assume CometBFT also has a mempool by default
 		PendingTxProposalTimeout: 250 * time.Millisecond, // 250 milliseconds to wait for rechecks
 		InsertQueueSize:          5_000,                  // 5000 txs maximum in the insert queue
+		Journal:                  "",                     // Journaling is disabled by default
+		Rejournal:                time.Hour,              // 1 hour between regenerations of the journal
+		JournalLocals:            false,                  // Journal all the txs of the mempool
+		TxStatusRetention:        10 * time.Minute,       // 10 minutes of retained tx statuses
+		Lanes:                    nil,                    // No block space is reserved by default
 	}
 }
 
@@ -224,9 +340,26 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	if c.QueuedTxLifetime < 0 {
+		return fmt.Errorf("queued tx lifetime must not be negative, got %s", c.QueuedTxLifetime)
+	}
+	if c.AccountSlotsBalance != "" {
+		if balance, err := uint256.FromDecimal(c.AccountSlotsBalance); err != nil || balance.IsZero() {
+			return fmt.Errorf("account slots balance must be a positive amount of wei, got %q", c.AccountSlotsBalance)
+		}
+	}
 	if c.InsertQueueSize < 1 {
 		return fmt.Errorf("insert queue size must be at least 1, got %d", c.InsertQueueSize)
 	}
+	if c.Journal != "" && c.Rejournal < time.Second {
+		return fmt.Errorf("rejournal interval must be at least 1 second, got %s", c.Rejournal)
+	}
+	if c.TxStatusRetention < 0 {
+		return fmt.Errorf("tx status retention must not be negative, got %s", c.TxStatusRetention)
+	}
+	if err := evmmempool.ValidateLanes(ToLanes(c.Lanes)); err != nil {
+		return fmt.Errorf("invalid mempool lanes: %w", err)
+	}
 	return nil
 }
 
@@ -246,6 +379,10 @@ type JSONRPCConfig struct {
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
+	// TxSyncTimeout is the time eth_sendRawTransactionSync waits for the tx inclusion when the request has no timeout.
+	TxSyncTimeout time.Duration `mapstructure:"tx-sync-timeout"`
+	// TxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the tx inclusion, capped below HTTPTimeout.
+	TxSyncMaxTimeout time.Duration `mapstructure:"tx-sync-max-timeout"`
 	// FilterCap is the global cap for total number of filters that can be created.
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
@@ -272,12 +409,81 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableTraceIndexer defines if enable the index of the call trace addresses used by `trace_filter`,
+	// and by the otterscan methods for the internal calls and the contracts deployed by other contracts.
+	EnableTraceIndexer bool `mapstructure:"enable-trace-indexer"`
+	// TraceIndexerBackfillHeight defines the earliest block the trace index is backfilled to, backward from its
+	// first indexed block, 0 disables the backfill.
+	TraceIndexerBackfillHeight int64 `mapstructure:"trace-indexer-backfill-height"`
+	// EnableLogIndexer defines if enable the index of the logs by address and first topic used by `eth_getLogs`.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// EnableAddressIndexer defines if enable the index of the eth txs by address and by sender and nonce used by the
+	// `ots` namespace, `eth_getTransactionBySenderAndNonce` and `eth_getTransactionsByAddress`.
+	EnableAddressIndexer bool `mapstructure:"enable-address-indexer"`
+	// EnableTransferIndexer defines if enable the index of the token and native coin transfers used by `eth_getAssetTransfers`.
+	EnableTransferIndexer bool `mapstructure:"enable-transfer-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableGraphQL enables the EIP-1767 GraphQL endpoint served at `/graphql` on the JSON-RPC address.
+	EnableGraphQL bool `mapstructure:"enable-graphql"`
+	// EnableRateLimit enables the rate limiting of the JSON-RPC and WebSocket requests per client IP or API key.
+	EnableRateLimit bool `mapstructure:"enable-rate-limit"`
+	// RateLimitPerIP is the number of request cost units per second allowed per client IP.
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitBurst is the number of request cost units a client IP can spend at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitAPIKeys defines the API keys, given as `key` to have the per IP limits or as `key:rate:burst`.
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// RateLimitMethodCosts defines the cost of the methods as `method=cost`, the other methods cost 1.
+	RateLimitMethodCosts []string `mapstructure:"rate-limit-method-costs"`
+	// RateLimitWSSubscribeRate is the number of WebSocket subscriptions per second a client can create.
+	RateLimitWSSubscribeRate float64 `mapstructure:"rate-limit-ws-subscribe-rate"`
+	// RateLimitWSSubscribeBurst is the number of WebSocket subscriptions a client can create at once.
+	RateLimitWSSubscribeBurst int `mapstructure:"rate-limit-ws-subscribe-burst"`
+	// RateLimitWSMaxSubscriptions is the max number of active subscriptions of a WebSocket connection, 0 for no limit.
+	RateLimitWSMaxSubscriptions int `mapstructure:"rate-limit-ws-max-subscriptions"`
+	// AllowMethods defines the methods served by the HTTP and WebSocket servers, as `namespace_method` or `namespace_*`.
+	// All the methods of the enabled namespaces are served if empty.
+	AllowMethods []string `mapstructure:"allow-methods"`
+	// DenyMethods defines the methods that are not served by the HTTP and WebSocket servers, even if allowed.
+	DenyMethods []string `mapstructure:"deny-methods"`
+	// Listeners defines the additional JSON-RPC HTTP listeners, each one with its own namespaces.
+	Listeners []JSONRPCListenerConfig `mapstructure:"listeners"`
+	// IPCPath defines the unix socket of the JSON-RPC IPC server, relative to the home directory if not
+	// absolute. The IPC server is disabled if empty.
+	IPCPath string `mapstructure:"ipc-path"`
+	// ResponseCacheSize defines the memory budget in MB of the cache of the blocks, receipts and logs of the
+	// committed blocks. The cache is disabled if 0.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// GasPriceOracleBlocks defines the number of recent blocks whose lowest effective tips are sampled to suggest
+	// the tip of eth_gasPrice and eth_maxPriorityFeePerGas.
+	GasPriceOracleBlocks int `mapstructure:"gpo-blocks"`
+	// GasPriceOraclePercentile defines the percentile of the sampled tips that is suggested.
+	GasPriceOraclePercentile int `mapstructure:"gpo-percentile"`
+	// GasPriceOracleMaxPrice defines the max suggested tip, in wei.
+	GasPriceOracleMaxPrice int64 `mapstructure:"gpo-max-price"`
+	// GasPriceOracleIgnorePrice defines the tip below which the transactions aren't sampled, in wei.
+	GasPriceOracleIgnorePrice int64 `mapstructure:"gpo-ignore-price"`
+}
+
+// JSONRPCListenerConfig defines an additional JSON-RPC HTTP listener, as a private one serving
+// the admin namespaces on localhost or a Unix socket.
+type JSONRPCListenerConfig struct {
+	// Address defines the address to listen on, either `host:port` or `unix:///path/to/socket`.
+	Address string `mapstructure:"address"`
+	// API defines the list of JSON-RPC namespaces served by the listener.
+	API []string `mapstructure:"api"`
+	// AllowMethods defines the methods served by the listener, all the methods of its namespaces if empty.
+	AllowMethods []string `mapstructure:"allow-methods"`
+	// DenyMethods defines the methods that are not served by the listener, even if allowed.
+	DenyMethods []string `mapstructure:"deny-methods"`
+	// JWTSecretFile is the file of the hex encoded HS256 secret authenticating the requests, generated if it
+	// doesn't exist. The requests aren't authenticated if empty.
+	JWTSecretFile string `mapstructure:"jwt-secret-file"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -325,7 +531,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "cosmos"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -344,6 +550,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:           DefaultEVMTimeout,
 		TxFeeCap:             DefaultTxFeeCap,
+		TxSyncTimeout:        DefaultTxSyncTimeout,
+		TxSyncMaxTimeout:     DefaultTxSyncMaxTimeout,
 		FilterCap:            DefaultFilterCap,
 		FeeHistoryCap:        DefaultFeeHistoryCap,
 		BlockRangeCap:        DefaultBlockRangeCap,
@@ -355,9 +563,34 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		EnableTraceIndexer:   false,
+		EnableLogIndexer:     false,
+		EnableAddressIndexer: false,
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		EnableGraphQL:        false,
+		EnableRateLimit:      false,
+		RateLimitPerIP:       DefaultRateLimitPerIP,
+		RateLimitBurst:       DefaultRateLimitBurst,
+		RateLimitAPIKeys:     []string{},
+		RateLimitMethodCosts: DefaultRateLimitMethodCosts,
+
+		RateLimitWSSubscribeRate:    DefaultRateLimitWSSubscribeRate,
+		RateLimitWSSubscribeBurst:   DefaultRateLimitWSSubscribeBurst,
+		RateLimitWSMaxSubscriptions: DefaultRateLimitWSMaxSubscriptions,
+
+		AllowMethods: []string{},
+		DenyMethods:  []string{},
+		Listeners:    []JSONRPCListenerConfig{},
+		IPCPath:      "",
+
+		ResponseCacheSize: DefaultResponseCacheSize,
+
+		GasPriceOracleBlocks:      DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile:  DefaultGasPriceOraclePercentile,
+		GasPriceOracleMaxPrice:    DefaultGasPriceOracleMaxPrice,
+		GasPriceOracleIgnorePrice: DefaultGasPriceOracleIgnorePrice,
 	}
 }
 
@@ -383,6 +616,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.TxSyncTimeout < 0 {
+		return errors.New("JSON-RPC tx sync timeout duration cannot be negative")
+	}
+
+	if c.TxSyncMaxTimeout < 0 {
+		return errors.New("JSON-RPC tx sync max timeout duration cannot be negative")
+	}
+
+	if c.TraceIndexerBackfillHeight < 0 {
+		return errors.New("JSON-RPC trace indexer backfill height cannot be negative")
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -407,6 +652,41 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.GasPriceOracleBlocks < 0 {
+		return errors.New("JSON-RPC gas price oracle blocks cannot be negative")
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return errors.New("JSON-RPC gas price oracle percentile must be between 0 and 100")
+	}
+
+	if c.GasPriceOracleMaxPrice < 0 {
+		return errors.New("JSON-RPC gas price oracle max price cannot be negative")
+	}
+
+	if c.GasPriceOracleIgnorePrice < 0 {
+		return errors.New("JSON-RPC gas price oracle ignore price cannot be negative")
+	}
+
+	if c.EnableRateLimit {
+		if c.RateLimitPerIP <= 0 || c.RateLimitBurst <= 0 {
+			return errors.New("JSON-RPC rate limit per IP and burst must be positive")
+		}
+		if c.RateLimitWSSubscribeRate <= 0 || c.RateLimitWSSubscribeBurst <= 0 {
+			return errors.New("JSON-RPC websocket subscribe rate and burst must be positive")
+		}
+		if c.RateLimitWSMaxSubscriptions < 0 {
+			return errors.New("JSON-RPC websocket max subscriptions cannot be negative")
+		}
+		if _, err := c.RateLimitConfig(); err != nil {
+			return err
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -417,9 +697,70 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	if _, err := access.NewMethodFilter(c.AllowMethods, c.DenyMethods); err != nil {
+		return err
+	}
+
+	seenAddresses := map[string]bool{c.Address: true, c.WsAddress: true}
+	for i, listener := range c.Listeners {
+		if err := listener.Validate(); err != nil {
+			return fmt.Errorf("invalid JSON-RPC listener %d: %w", i, err)
+		}
+		if seenAddresses[listener.Address] {
+			return fmt.Errorf("repeated JSON-RPC listener address '%s'", listener.Address)
+		}
+		seenAddresses[listener.Address] = true
+	}
+
 	return nil
 }
 
+// Validate returns an error if the JSON-RPC listener configuration fields are invalid.
+func (c JSONRPCListenerConfig) Validate() error {
+	if c.Address == "" {
+		return errors.New("address cannot be empty")
+	}
+
+	if len(c.API) == 0 {
+		return errors.New("cannot define a listener without any API namespace")
+	}
+
+	seenAPIs := make(map[string]bool)
+	for _, api := range c.API {
+		if seenAPIs[api] {
+			return fmt.Errorf("repeated API namespace '%s'", api)
+		}
+
+		seenAPIs[api] = true
+	}
+
+	if _, err := access.NewMethodFilter(c.AllowMethods, c.DenyMethods); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RateLimitConfig returns the configuration of the JSON-RPC rate limiter.
+func (c JSONRPCConfig) RateLimitConfig() (ratelimit.Config, error) {
+	ipLimit := ratelimit.Limit{Rate: c.RateLimitPerIP, Burst: c.RateLimitBurst}
+	apiKeys, err := ratelimit.ParseAPIKeys(c.RateLimitAPIKeys, ipLimit)
+	if err != nil {
+		return ratelimit.Config{}, err
+	}
+	methodCosts, err := ratelimit.ParseMethodCosts(c.RateLimitMethodCosts)
+	if err != nil {
+		return ratelimit.Config{}, err
+	}
+	return ratelimit.Config{
+		IP:               ipLimit,
+		APIKeys:          apiKeys,
+		MethodCosts:      methodCosts,
+		Subscribe:        ratelimit.Limit{Rate: c.RateLimitWSSubscribeRate, Burst: c.RateLimitWSSubscribeBurst},
+		MaxSubscriptions: c.RateLimitWSMaxSubscriptions,
+	}, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -55,6 +56,64 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal JSON-RPC listeners",
+			func() *viper.Viper {
+				v := viper.New()
+				v.SetConfigType("toml")
+				require.NoError(t, v.ReadConfig(strings.NewReader(`
+[json-rpc]
+deny-methods = ["debug_*"]
+
+[[json-rpc.listeners]]
+address = "unix:///tmp/jsonrpc.sock"
+api = ["eth", "debug"]
+deny-methods = ["debug_setHead"]
+jwt-secret-file = "/tmp/jwt.hex"
+`)))
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				cfg.JSONRPC.DenyMethods = []string{"debug_*"}
+				cfg.JSONRPC.Listeners = []serverconfig.JSONRPCListenerConfig{{
+					Address:       "unix:///tmp/jsonrpc.sock",
+					API:           []string{"eth", "debug"},
+					DenyMethods:   []string{"debug_setHead"},
+					JWTSecretFile: "/tmp/jwt.hex",
+				}}
+				return *cfg
+			},
+			false,
+		},
+		{
+			"test unmarshal mempool lanes",
+			func() *viper.Viper {
+				v := viper.New()
+				v.SetConfigType("toml")
+				require.NoError(t, v.ReadConfig(strings.NewReader(`
+[[evm.mempool.lanes]]
+name = "ibc"
+share = 20
+msg-type-urls = ["/ibc.core.channel.v1.MsgRecvPacket"]
+
+[[evm.mempool.lanes]]
+name = "cosmos"
+share = 10
+msg-type-urls = []
+`)))
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				cfg.EVM.Mempool.Lanes = []serverconfig.MempoolLaneConfig{
+					{Name: "ibc", Share: 20, MsgTypeURLs: []string{"/ibc.core.channel.v1.MsgRecvPacket"}},
+					{Name: "cosmos", Share: 10, MsgTypeURLs: []string{}},
+				}
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -49,15 +49,56 @@ account-queue = {{ .EVM.Mempool.AccountQueue }}
 # GlobalQueue is the maximum number of non-executable transaction slots for all accounts
 global-queue = {{ .EVM.Mempool.GlobalQueue }}
 
+# GlobalBytes is the maximum size in bytes of all the transactions of the pool, the ones with the lowest effective tip are evicted first when it's exceeded (0 means unbounded)
+global-bytes = {{ .EVM.Mempool.GlobalBytes }}
+
+# AccountSlotsBalance is the balance in wei for which an account is granted account-slots and account-queue, the accounts with a higher balance are granted proportionally more, up to 4 times (empty disables the scaling)
+account-slots-balance = "{{ .EVM.Mempool.AccountSlotsBalance }}"
+
 # Lifetime is the maximum amount of time non-executable transaction are queued
 lifetime = "{{ .EVM.Mempool.Lifetime }}"
 
+# QueuedTxLifetime is the maximum amount of time a single non-executable transaction is queued, even if its account stays active (0 disables it)
+queued-tx-lifetime = "{{ .EVM.Mempool.QueuedTxLifetime }}"
+
 # PendingTxProposalTimeout is the amount of time to spend waiting for rechecking of the mempool to complete when creating a proposal
 pending-tx-proposal-timeout = "{{ .EVM.Mempool.PendingTxProposalTimeout }}"
 
 # InsertQueueSize is the maximum number of transactions that can be in the insert queue at once (0 means unbounded)
 insert-queue-size = "{{ .EVM.Mempool.InsertQueueSize }}"
 
+# Journal is the path of the file the txs of the mempool are journaled to, so that they survive node restarts.
+# Relative paths are relative to the data directory of the node. Journaling is disabled if it's empty.
+# It requires the mempool to operate exclusively.
+journal = "{{ .EVM.Mempool.Journal }}"
+
+# Rejournal is the interval at which the journal is regenerated from the txs in the mempool
+rejournal = "{{ .EVM.Mempool.Rejournal }}"
+
+# JournalLocals restricts the journal to the EVM txs submitted over the JSON-RPC of the node
+journal-locals = {{ .EVM.Mempool.JournalLocals }}
+
+# TxStatusRetention is how long the reason an EVM tx exited the mempool is retained for, to report its status
+# over the txpool_txStatus JSON-RPC method and the TxStatus gRPC query (0 disables it)
+tx-status-retention = "{{ .EVM.Mempool.TxStatusRetention }}"
+
+# Lanes reserve a percentage of the max gas and bytes of the blocks proposed by the node to the txs whose
+# messages all have one of the type URLs of the lane. The lane without type URLs matches the other txs.
+# The space a lane doesn't use is used by the txs of the other lanes. It requires the mempool to operate exclusively.
+# Example:
+#
+# [[evm.mempool.lanes]]
+# name = "ibc"
+# share = 20
+# msg-type-urls = ["/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", "/ibc.core.channel.v1.MsgTimeout"]
+{{- range .EVM.Mempool.Lanes}}
+
+[[evm.mempool.lanes]]
+name = "{{ .Name }}"
+share = {{ .Share }}
+msg-type-urls = [{{range $index, $elmt := .MsgTypeURLs}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+{{- end}}
+
 
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
@@ -94,6 +135,12 @@ evm-timeout = "{{ .JSONRPC.EVMTimeout }}"
 # TxFeeCap is the global tx-fee cap for send transaction. Default: 1eth.
 txfee-cap = {{ .JSONRPC.TxFeeCap }}
 
+# TxSyncTimeout is the time eth_sendRawTransactionSync waits for the tx inclusion when the request has no timeout. Default: 20s.
+tx-sync-timeout = "{{ .JSONRPC.TxSyncTimeout }}"
+
+# TxSyncMaxTimeout is the max time eth_sendRawTransactionSync waits for the tx inclusion, capped below the http-timeout. Default: 1m.
+tx-sync-max-timeout = "{{ .JSONRPC.TxSyncMaxTimeout }}"
+
 # FilterCap sets the global cap for total number of filters that can be created
 filter-cap = {{ .JSONRPC.FilterCap }}
 
@@ -123,6 +170,29 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableTraceIndexer enables the index of the addresses found in the call traces of the EVM transactions,
+# used to serve 'trace_filter' without replaying every block of the requested range, and the internal calls
+# and the contracts deployed by other contracts in 'ots_searchTransactions*' and 'ots_getContractCreator'.
+enable-trace-indexer = {{ .JSONRPC.EnableTraceIndexer }}
+
+# TraceIndexerBackfillHeight is the earliest block the trace index is backfilled to in the background, backward from
+# its first indexed block, or from the latest block if it's empty. The backfill stops at the first block that can't
+# be traced, e.g. because its state is pruned. 0 disables the backfill.
+trace-indexer-backfill-height = {{ .JSONRPC.TraceIndexerBackfillHeight }}
+
+# EnableLogIndexer enables the index of the logs of the EVM transactions by address and first topic, used by
+# 'eth_getLogs' to only fetch the matching blocks. It requires the custom transaction indexer.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
+# EnableAddressIndexer enables the index of the EVM transactions by address and by sender and nonce, together
+# with the creators of the contracts, used by the 'ots' namespace, 'eth_getTransactionBySenderAndNonce' and
+# 'eth_getTransactionsByAddress'. It requires the custom transaction indexer.
+enable-address-indexer = {{ .JSONRPC.EnableAddressIndexer }}
+
+# EnableTransferIndexer enables the index of the ERC-20, ERC-721, ERC-1155 and native coin transfers of the EVM
+# transactions by address, used by 'eth_getAssetTransfers'. It requires the custom transaction indexer.
+enable-transfer-indexer = {{ .JSONRPC.EnableTransferIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -136,6 +206,87 @@ batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
 # Enabled profiling in the debug namespace
 enable-profiling = {{ .JSONRPC.EnableProfiling }}
 
+# EnableGraphQL enables the EIP-1767 GraphQL endpoint, served at '/graphql' on the JSON-RPC address.
+enable-graphql = {{ .JSONRPC.EnableGraphQL }}
+
+# EnableRateLimit enables the rate limiting of the JSON-RPC and WebSocket requests with token buckets per client IP,
+# or per API key given in the 'X-API-Key' header or as the URL path. Rejected requests get a -32005 error.
+enable-rate-limit = {{ .JSONRPC.EnableRateLimit }}
+
+# RateLimitPerIP is the number of request cost units per second allowed per client IP.
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitBurst is the number of request cost units a client IP can spend at once.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# RateLimitAPIKeys defines the API keys, given as "key" to have the per IP limits or as "key:rate:burst".
+# Requests with an unknown API key are rejected.
+rate-limit-api-keys = [{{range $index, $elmt := .JSONRPC.RateLimitAPIKeys}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitMethodCosts defines the cost of the methods as "method=cost", the other methods cost 1.
+rate-limit-method-costs = [{{range $index, $elmt := .JSONRPC.RateLimitMethodCosts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitWSSubscribeRate is the number of WebSocket subscriptions per second a client can create.
+rate-limit-ws-subscribe-rate = {{ .JSONRPC.RateLimitWSSubscribeRate }}
+
+# RateLimitWSSubscribeBurst is the number of WebSocket subscriptions a client can create at once.
+rate-limit-ws-subscribe-burst = {{ .JSONRPC.RateLimitWSSubscribeBurst }}
+
+# RateLimitWSMaxSubscriptions is the max number of active subscriptions of a WebSocket connection (0=unlimited).
+rate-limit-ws-max-subscriptions = {{ .JSONRPC.RateLimitWSMaxSubscriptions }}
+
+# AllowMethods defines the methods served by the HTTP and WebSocket servers, as "namespace_method" or "namespace_*".
+# All the methods of the enabled namespaces are served if empty.
+allow-methods = [{{range $index, $elmt := .JSONRPC.AllowMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# DenyMethods defines the methods that are not served by the HTTP and WebSocket servers, even if allowed.
+# Example: ["debug_*", "personal_*"]
+deny-methods = [{{range $index, $elmt := .JSONRPC.DenyMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# IPCPath defines the unix socket of the JSON-RPC IPC server, serving the namespaces of 'api' and the
+# subscriptions, relative to the home directory if not absolute. The IPC server is disabled if empty.
+# Example: "evmd.ipc"
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
+# ResponseCacheSize defines the memory budget in MB of the cache of the blocks, receipts and logs of the
+# committed blocks, which never change. The cache is disabled if 0.
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# GasPriceOracleBlocks defines the number of recent blocks whose lowest effective tips are sampled to suggest the
+# tip of eth_gasPrice and eth_maxPriorityFeePerGas. The suggested tip is raised when the pending transactions of the
+# mempool don't fit in the next block.
+gpo-blocks = {{ .JSONRPC.GasPriceOracleBlocks }}
+
+# GasPriceOraclePercentile defines the percentile of the sampled tips that is suggested.
+gpo-percentile = {{ .JSONRPC.GasPriceOraclePercentile }}
+
+# GasPriceOracleMaxPrice defines the max suggested tip, in wei.
+gpo-max-price = {{ .JSONRPC.GasPriceOracleMaxPrice }}
+
+# GasPriceOracleIgnorePrice defines the tip below which the transactions aren't sampled, in wei.
+gpo-ignore-price = {{ .JSONRPC.GasPriceOracleIgnorePrice }}
+
+# Listeners defines additional JSON-RPC HTTP listeners, each one with its own namespaces and methods, as a
+# private listener serving the admin namespaces on localhost or on a Unix socket. If 'jwt-secret-file' is set,
+# the requests must have a HS256 JWT bearer token signed with the secret of the file, generated if missing.
+# Example:
+#
+# [[json-rpc.listeners]]
+# address = "unix:///path/to/jsonrpc.sock"
+# api = ["eth", "debug", "txpool", "personal", "miner"]
+# allow-methods = []
+# deny-methods = []
+# jwt-secret-file = "/path/to/jwt.hex"
+{{- range .JSONRPC.Listeners}}
+
+[[json-rpc.listeners]]
+address = "{{ .Address }}"
+api = [{{range $index, $elmt := .API}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+allow-methods = [{{range $index, $elmt := .AllowMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+deny-methods = [{{range $index, $elmt := .DenyMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+jwt-secret-file = "{{ .JWTSecretFile }}"
+{{- end}}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -37,26 +37,55 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                = "json-rpc.enable"
+	JSONRPCAPI                   = "json-rpc.api"
+	JSONRPCAddress               = "json-rpc.address"
+	JSONWsAddress                = "json-rpc.ws-address"
+	JSONRPCWSOrigins             = "json-rpc.ws-origins"
+	JSONRPCGasCap                = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock   = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout            = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap              = "json-rpc.txfee-cap"
+	JSONRPCTxSyncTimeout         = "json-rpc.tx-sync-timeout"
+	JSONRPCTxSyncMaxTimeout      = "json-rpc.tx-sync-max-timeout"
+	JSONRPCFilterCap             = "json-rpc.filter-cap"
+	JSONRPCLogsCap               = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap         = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout           = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout       = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs   = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections    = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer         = "json-rpc.enable-indexer"
+	JSONRPCEnableTraceIndexer    = "json-rpc.enable-trace-indexer"
+	JSONRPCTraceIndexerBackfill  = "json-rpc.trace-indexer-backfill-height"
+	JSONRPCEnableLogIndexer      = "json-rpc.enable-log-indexer"
+	JSONRPCEnableAddressIndexer  = "json-rpc.enable-address-indexer"
+	JSONRPCEnableTransferIndexer = "json-rpc.enable-transfer-indexer"
+	JSONRPCBatchRequestLimit     = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize  = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling       = "json-rpc.enable-profiling"
+	JSONRPCEnableGraphQL         = "json-rpc.enable-graphql"
+	JSONRPCAllowMethods          = "json-rpc.allow-methods"
+	JSONRPCDenyMethods           = "json-rpc.deny-methods"
+	JSONRPCIPCPath               = "json-rpc.ipc-path"
+	JSONRPCResponseCacheSize     = "json-rpc.response-cache-size"
+
+	// JSON-RPC rate limit flags
+	JSONRPCEnableRateLimit             = "json-rpc.enable-rate-limit"
+	JSONRPCRateLimitPerIP              = "json-rpc.rate-limit-per-ip"
+	JSONRPCRateLimitBurst              = "json-rpc.rate-limit-burst"
+	JSONRPCRateLimitAPIKeys            = "json-rpc.rate-limit-api-keys"
+	JSONRPCRateLimitMethodCosts        = "json-rpc.rate-limit-method-costs"
+	JSONRPCRateLimitWSSubscribeRate    = "json-rpc.rate-limit-ws-subscribe-rate"
+	JSONRPCRateLimitWSSubscribeBurst   = "json-rpc.rate-limit-ws-subscribe-burst"
+	JSONRPCRateLimitWSMaxSubscriptions = "json-rpc.rate-limit-ws-max-subscriptions"
+
+	// JSON-RPC gas price oracle flags
+	JSONRPCGasPriceOracleBlocks      = "json-rpc.gpo-blocks"
+	JSONRPCGasPriceOraclePercentile  = "json-rpc.gpo-percentile"
+	JSONRPCGasPriceOracleMaxPrice    = "json-rpc.gpo-max-price"
+	JSONRPCGasPriceOracleIgnorePrice = "json-rpc.gpo-ignore-price"
+
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -78,10 +107,18 @@ const (
 	EVMMempoolGlobalSlots              = "evm.mempool.global-slots"
 	EVMMempoolAccountQueue             = "evm.mempool.account-queue"
 	EVMMempoolGlobalQueue              = "evm.mempool.global-queue"
+	EVMMempoolGlobalBytes              = "evm.mempool.global-bytes"
+	EVMMempoolAccountSlotsBalance      = "evm.mempool.account-slots-balance"
 	EVMMempoolLifetime                 = "evm.mempool.lifetime"
+	EVMMempoolQueuedTxLifetime         = "evm.mempool.queued-tx-lifetime"
 	EVMMempoolOperateExclusively       = "evm.mempool.operate-exclusively"
 	EVMMempoolPendingTxProposalTimeout = "evm.mempool.pending-tx-proposal-timeout"
 	EVMMempoolInsertQueueSize          = "evm.mempool.insert-queue-size"
+	EVMMempoolJournal                  = "evm.mempool.journal"
+	EVMMempoolRejournal                = "evm.mempool.rejournal"
+	EVMMempoolJournalLocals            = "evm.mempool.journal-locals"
+	EVMMempoolTxStatusRetention        = "evm.mempool.tx-status-retention"
+	EVMMempoolLanes                    = "evm.mempool.lanes"
 )
 
 // TLS flags
@@ -15,6 +15,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 )
 
+const (
+	flagIndexLogs      = "logs"
+	flagIndexAddresses = "addresses"
+	flagIndexTransfers = "transfers"
+)
+
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +32,16 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		With --logs, the logs are indexed by address and first topic as well, and the traverse starts from the first or latest
+		block whose logs are indexed instead, to backfill the log index of a node that enabled it after its eth txs were indexed.
+
+		With --addresses, the eth txs are indexed by address and by sender and nonce as well, and the traverse starts from the
+		first or latest block whose eth txs are indexed by them instead, to backfill these indexes of a node that enabled them
+		after its eth txs were indexed.
+
+		With --transfers, the token and native coin transfers are indexed by address as well, and the traverse starts from
+		the first or latest block whose transfers are indexed instead, to backfill the transfer index.
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,7 +64,36 @@ func NewIndexTxCmd() *cobra.Command {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			indexLogs, err := cmd.Flags().GetBool(flagIndexLogs)
+			if err != nil {
+				return err
+			}
+			indexAddresses, err := cmd.Flags().GetBool(flagIndexAddresses)
+			if err != nil {
+				return err
+			}
+			indexTransfers, err := cmd.Flags().GetBool(flagIndexTransfers)
+			if err != nil {
+				return err
+			}
+			if (indexLogs && indexAddresses) || (indexLogs && indexTransfers) || (indexAddresses && indexTransfers) {
+				return fmt.Errorf("only one of --%s, --%s and --%s can be used", flagIndexLogs, flagIndexAddresses, flagIndexTransfers)
+			}
+			idxer := indexer.NewKVIndexer(
+				idxDB, logger.With("module", "evmindex"), clientCtx,
+				indexer.WithLogIndex(indexLogs),
+				indexer.WithAddressIndex(indexAddresses),
+				indexer.WithTransferIndex(indexTransfers),
+			)
+			firstIndexedBlock, lastIndexedBlock := idxer.FirstIndexedBlock, idxer.LastIndexedBlock
+			switch {
+			case indexLogs:
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstLogIndexedBlock, idxer.LastLogIndexedBlock
+			case indexAddresses:
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstAddressIndexedBlock, idxer.LastAddressIndexedBlock
+			case indexTransfers:
+				firstIndexedBlock, lastIndexedBlock = idxer.FirstTransferIndexedBlock, idxer.LastTransferIndexedBlock
+			}
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -83,7 +128,7 @@ func NewIndexTxCmd() *cobra.Command {
 
 			switch args[0] {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := firstIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -97,7 +142,7 @@ func NewIndexTxCmd() *cobra.Command {
 					}
 				}
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := lastIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -117,5 +162,8 @@ func NewIndexTxCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool(flagIndexLogs, false, "Index the logs by address and first topic, traversing from the blocks whose logs are indexed")
+	cmd.Flags().Bool(flagIndexAddresses, false, "Index the eth txs by address and by sender and nonce, traversing from the blocks whose eth txs are indexed by them")
+	cmd.Flags().Bool(flagIndexTransfers, false, "Index the token and native coin transfers by address, traversing from the blocks whose transfers are indexed")
 	return cmd
 }
@@ -2,26 +2,39 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
+	"golang.org/x/net/netutil"
 	"golang.org/x/sync/errgroup"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
+	evmindexer "github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/access"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/graphql"
+	"github.com/cosmos/evm/rpc/ratelimit"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
 	"github.com/cosmos/evm/server/types"
 
+	"cosmossdk.io/log/v2"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
 )
 
 const shutdownTimeout = 200 * time.Millisecond
@@ -61,34 +74,69 @@ func StartJSONRPC(
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
 
-	evmBackend := backend.NewBackend(
-		srvCtx,
-		clientCtx,
-		indexer,
-		mempool,
+	backendOpts := []backend.Opt{
 		backend.WithUnprotectedTxs(config.JSONRPC.AllowUnprotectedTxs),
 		backend.WithAppMempool(mempool.IsExclusive()),
 		backend.WithLogger(srvCtx.Logger),
-	)
+	}
+
+	var traceIdxr types.TraceIndexer
+	if config.JSONRPC.EnableTraceIndexer {
+		traceIdxDB, err := OpenTraceIndexerDB(clientCtx.HomeDir, server.GetAppDBBackend(srvCtx.Viper))
+		if err != nil {
+			logger.Error("failed to open evm trace indexer DB", "error", err.Error())
+			return nil, err
+		}
+		traceIdxr = evmindexer.NewTraceIndexer(traceIdxDB, srvCtx.Logger.With("indexer", "evmtrace"))
+		backendOpts = append(backendOpts, backend.WithTraceIndexer(traceIdxr))
+	}
+
+	evmBackend := backend.NewBackend(srvCtx, clientCtx, indexer, mempool, backendOpts...)
+
+	if traceIdxr != nil {
+		startTraceIndexerService(ctx, srvCtx, clientCtx, g, traceIdxr, evmBackend, config.JSONRPC.TraceIndexerBackfillHeight)
+	}
 
 	apis := rpc.BuildRPCs(config.JSONRPC.API, srvCtx, clientCtx, stream, evmBackend)
 
-	rpcServer := ethrpc.NewServer()
-	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	rpcServer, err := newRPCServer(logger, apis, config)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
+	methods, err := access.NewMethodFilter(config.JSONRPC.AllowMethods, config.JSONRPC.DenyMethods)
+	if err != nil {
+		return nil, err
+	}
+	rpcHandler := methods.Handler(rpcServer)
+
+	var limiter *ratelimit.Limiter
+	if config.JSONRPC.EnableRateLimit {
+		rateLimitCfg, err := config.JSONRPC.RateLimitConfig()
+		if err != nil {
+			return nil, err
+		}
+		if limiter, err = ratelimit.NewLimiter(rateLimitCfg); err != nil {
 			return nil, err
 		}
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", withRateLimit(limiter, rpcHandler)).Methods("POST")
+
+	if config.JSONRPC.EnableGraphQL {
+		graphqlHandler, err := graphql.NewHandler(logger, evmBackend, config.JSONRPC.HTTPTimeout)
+		if err != nil {
+			logger.Error("failed to create GraphQL handler", "error", err.Error())
+			return nil, err
+		}
+		r.Handle("/graphql", withRateLimit(limiter, graphqlHandler)).Methods("POST")
+	}
+
+	if limiter != nil {
+		// the API key can also be given as the URL path
+		r.Handle("/{"+ratelimit.APIKeyPathVar+"}", limiter.Handler(rpcHandler)).Methods("POST")
+	}
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -103,15 +151,64 @@ func StartJSONRPC(
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
 		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
 	}
-	httpSrvDone := make(chan struct{}, 1)
 
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
 		return nil, err
 	}
+	serveJSONRPC(ctx, srvCtx, g, httpSrv, ln, config.JSONRPC.Address)
+
+	for _, listenerCfg := range config.JSONRPC.Listeners {
+		if err := startJSONRPCListener(ctx, srvCtx, clientCtx, g, config, listenerCfg, stream, evmBackend, limiter); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.JSONRPC.IPCPath != "" {
+		if err := startIPCServer(ctx, srvCtx, clientCtx, g, config, stream, evmBackend, rpcServer); err != nil {
+			return nil, err
+		}
+	}
+
+	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, evmBackend, config, limiter, methods)
+	wsSrv.Start()
+	return httpSrv, nil
+}
+
+// newRPCServer creates a JSON-RPC server serving the APIs.
+func newRPCServer(logger log.Logger, apis []ethrpc.API, config *serverconfig.Config) (*ethrpc.Server, error) {
+	rpcServer := ethrpc.NewServer()
+	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			logger.Error(
+				"failed to register service in JSON RPC namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return nil, err
+		}
+	}
+	return rpcServer, nil
+}
+
+// serveJSONRPC serves the JSON-RPC HTTP server on the listener until the
+// context is canceled.
+func serveJSONRPC(
+	ctx context.Context,
+	srvCtx *server.Context,
+	g *errgroup.Group,
+	httpSrv *http.Server,
+	ln net.Listener,
+	address string,
+) {
+	logger := srvCtx.Logger.With("module", "geth")
 
 	g.Go(func() error {
-		srvCtx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
+		srvCtx.Logger.Info("Starting JSON-RPC server", "address", address)
 		errCh := make(chan error)
 		go func() {
 			errCh <- httpSrv.Serve(ln)
@@ -123,7 +220,7 @@ func StartJSONRPC(
 		case <-ctx.Done():
 			// The calling process canceled or closed the provided context, so we must
 			// gracefully stop the JSON-RPC server.
-			logger.Info("stopping JSON-RPC server...", "address", config.JSONRPC.Address, "timeout", shutdownTimeout)
+			logger.Info("stopping JSON-RPC server...", "address", address, "timeout", shutdownTimeout)
 			ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 			defer cancel()
 			if err := httpSrv.Shutdown(ctxShutdown); err != nil {
@@ -132,7 +229,6 @@ func StartJSONRPC(
 			return nil
 		case err := <-errCh:
 			if err == http.ErrServerClosed {
-				close(httpSrvDone)
 				return nil
 			}
 
@@ -140,10 +236,189 @@ func StartJSONRPC(
 			return err
 		}
 	})
+}
 
-	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+// startJSONRPCListener starts an additional JSON-RPC HTTP listener serving
+// its own namespaces and methods, with the JWT authentication if it has a
+// secret file. The listener shares the rate limiter and the max open
+// connections of the main server.
+func startJSONRPCListener(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	listenerCfg serverconfig.JSONRPCListenerConfig,
+	stream *stream.RPCStream,
+	evmBackend backend.BackendI,
+	limiter *ratelimit.Limiter,
+) error {
+	logger := srvCtx.Logger.With("module", "geth", "listener", listenerCfg.Address)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
-	wsSrv.Start()
-	return httpSrv, nil
+	apis := rpc.BuildRPCs(listenerCfg.API, srvCtx, clientCtx, stream, evmBackend)
+	rpcServer, err := newRPCServer(logger, apis, config)
+	if err != nil {
+		return err
+	}
+
+	methods, err := access.NewMethodFilter(listenerCfg.AllowMethods, listenerCfg.DenyMethods)
+	if err != nil {
+		return err
+	}
+	handler := methods.Handler(rpcServer)
+
+	if listenerCfg.JWTSecretFile != "" {
+		secret, err := access.ObtainJWTSecret(listenerCfg.JWTSecretFile)
+		if err != nil {
+			return err
+		}
+		handler = access.NewJWTHandler(secret, handler)
+	}
+
+	httpSrv := &http.Server{
+		Handler:           withRateLimit(limiter, handler),
+		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+		ReadTimeout:       config.JSONRPC.HTTPTimeout,
+		WriteTimeout:      config.JSONRPC.HTTPTimeout,
+		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+	}
+
+	ln, err := listenAddress(listenerCfg.Address, config)
+	if err != nil {
+		return err
+	}
+	serveJSONRPC(ctx, srvCtx, g, httpSrv, ln, listenerCfg.Address)
+	return nil
+}
+
+// startIPCServer starts the JSON-RPC IPC server on the unix socket of the
+// IPC path, relative to the home directory if not absolute.
+func startIPCServer(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	stream *stream.RPCStream,
+	evmBackend backend.EVMBackend,
+	rpcServer *ethrpc.Server,
+) error {
+	logger := srvCtx.Logger.With("module", "geth")
+
+	path := config.JSONRPC.IPCPath
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(clientCtx.HomeDir, path)
+	}
+	ln, err := listenUnix(path)
+	if err != nil {
+		return err
+	}
+
+	ipcSrv := rpc.NewIPCServer(clientCtx, logger, stream, evmBackend, config, rpcServer)
+	g.Go(func() error {
+		srvCtx.Logger.Info("Starting JSON-RPC IPC server", "path", path)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- ipcSrv.Serve(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			// closing the listener removes the socket
+			logger.Info("stopping JSON-RPC IPC server...", "path", path)
+			ipcSrv.Stop()
+			return nil
+		case err := <-errCh:
+			if err != nil {
+				srvCtx.Logger.Error("failed to start JSON-RPC IPC server", "error", err.Error())
+			}
+			return err
+		}
+	})
+	return nil
+}
+
+// listenAddress starts a net.Listener on the address, either a unix socket
+// given as `unix:///path/to/socket` or a tcp address, limited to the max open
+// connections of the config.
+func listenAddress(addr string, config *serverconfig.Config) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, "unix://")
+	if !ok {
+		return Listen(addr, config)
+	}
+	ln, err := listenUnix(path)
+	if err != nil {
+		return nil, err
+	}
+	if config.JSONRPC.MaxOpenConnections > 0 {
+		ln = netutil.LimitListener(ln, config.JSONRPC.MaxOpenConnections)
+	}
+	return ln, nil
+}
+
+// listenUnix starts a net.Listener on the unix socket of the path, only
+// accessible by the node user. The socket left by a previous run is removed.
+func listenUnix(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
+
+// withRateLimit wraps the handler with the rate limiter, if enabled.
+func withRateLimit(limiter *ratelimit.Limiter, handler http.Handler) http.Handler {
+	if limiter == nil {
+		return handler
+	}
+	return limiter.Handler(handler)
+}
+
+// startTraceIndexerService starts the service indexing the call trace
+// addresses of the new blocks until the context is canceled.
+func startTraceIndexerService(
+	ctx context.Context,
+	srvCtx *server.Context,
+	clientCtx client.Context,
+	g *errgroup.Group,
+	traceIdxr types.TraceIndexer,
+	tracer BlockTracer,
+	backfillHeight int64,
+) {
+	idxLogger := srvCtx.Logger.With("indexer", "evmtrace")
+	traceIndexerService := NewEVMTraceIndexerService(traceIdxr, tracer, clientCtx.Client.(rpcclient.Client), backfillHeight)
+	traceIndexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+
+	g.Go(func() error {
+		errCh := make(chan error, 1)
+		go func() {
+			if err := traceIndexerService.Start(); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case <-ctx.Done():
+			idxLogger.Info("stopping evm trace indexer service due to context cancellation")
+			if err := traceIndexerService.Stop(); err != nil {
+				idxLogger.Error("failed to stop evm trace indexer service", "error", err.Error())
+			}
+			return ctx.Err()
+		case err := <-errCh:
+			if err != nil {
+				idxLogger.Error("evm trace indexer service failed", "error", err.Error())
+			}
+			return err
+		}
+	})
 }
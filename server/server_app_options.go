@@ -8,6 +8,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
 
@@ -138,9 +139,23 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	if globalQueue := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalQueue)); globalQueue != 0 {
 		legacyConfig.GlobalQueue = globalQueue
 	}
+	if globalBytes := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalBytes)); globalBytes != 0 {
+		legacyConfig.GlobalBytes = globalBytes
+	}
+	if accountSlotsBalance := cast.ToString(appOpts.Get(srvflags.EVMMempoolAccountSlotsBalance)); accountSlotsBalance != "" {
+		balance, err := uint256.FromDecimal(accountSlotsBalance)
+		if err != nil {
+			logger.Error("invalid account slots balance, not scaling the account slots", "balance", accountSlotsBalance, "error", err)
+		} else {
+			legacyConfig.AccountSlotsBalance = balance
+		}
+	}
 	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime != 0 {
 		legacyConfig.Lifetime = lifetime
 	}
+	if queuedTxLifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolQueuedTxLifetime)); queuedTxLifetime != 0 {
+		legacyConfig.QueuedTxLifetime = queuedTxLifetime
+	}
 
 	return &legacyConfig
 }
@@ -172,6 +187,69 @@ func GetMempoolInsertQueueSize(appOpts servertypes.AppOptions, logger log.Logger
 	return cast.ToInt(appOpts.Get(srvflags.EVMMempoolInsertQueueSize))
 }
 
+// GetMempoolJournal returns the path of the mempool journal, relative paths
+// being resolved against the data directory of the node. It returns an empty
+// path if journaling is disabled.
+func GetMempoolJournal(appOpts servertypes.AppOptions, logger log.Logger) string {
+	if appOpts == nil {
+		logger.Error("app options is nil, disabling the mempool journal")
+		return ""
+	}
+
+	path := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal))
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", path)
+}
+
+func GetMempoolRejournal(appOpts servertypes.AppOptions, logger log.Logger) time.Duration {
+	if appOpts == nil {
+		logger.Error("app options is nil, using rejournal interval of 1 hour")
+		return time.Hour
+	}
+
+	return cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal))
+}
+
+func GetMempoolJournalLocals(appOpts servertypes.AppOptions, logger log.Logger) bool {
+	if appOpts == nil {
+		logger.Error("app options is nil, journaling all the mempool txs")
+		return false
+	}
+
+	return cast.ToBool(appOpts.Get(srvflags.EVMMempoolJournalLocals))
+}
+
+func GetMempoolTxStatusRetention(appOpts servertypes.AppOptions, logger log.Logger) time.Duration {
+	if appOpts == nil {
+		logger.Error("app options is nil, retaining no tx statuses")
+		return 0
+	}
+
+	return cast.ToDuration(appOpts.Get(srvflags.EVMMempoolTxStatusRetention))
+}
+
+// GetMempoolLanes returns the lanes of the block space of the proposals, from
+// the array of tables of the app config.
+func GetMempoolLanes(appOpts servertypes.AppOptions, logger log.Logger) []evmmempool.Lane {
+	if appOpts == nil {
+		logger.Error("app options is nil, reserving no block space to lanes")
+		return nil
+	}
+
+	var lanes []evmmempool.Lane
+	for _, lane := range cast.ToSlice(appOpts.Get(srvflags.EVMMempoolLanes)) {
+		fields := cast.ToStringMap(lane)
+		lanes = append(lanes, evmmempool.Lane{
+			Name:        cast.ToString(fields["name"]),
+			Share:       cast.ToUint64(fields["share"]),
+			MsgTypeURLs: cast.ToStringSlice(fields["msg-type-urls"]),
+		})
+	}
+	return lanes
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		// we don't want to return 0 here, as then appOpts.Get() will return nil and that will be
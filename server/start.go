@@ -205,6 +205,8 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncTimeout, cosmosevmserverconfig.DefaultTxSyncTimeout, "Sets the time eth_sendRawTransactionSync waits for the tx inclusion when the request has no timeout")
+	cmd.Flags().Duration(srvflags.JSONRPCTxSyncMaxTimeout, cosmosevmserverconfig.DefaultTxSyncMaxTimeout, "Sets the max time eth_sendRawTransactionSync waits for the tx inclusion")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
@@ -214,8 +216,30 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableTraceIndexer, false, "Enable the call trace address indexer for trace_filter")
+	cmd.Flags().Int64(srvflags.JSONRPCTraceIndexerBackfill, 0, "Backfill the call trace address index down to this block in the background, 0 disables the backfill")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the log address and topic indexer for eth_getLogs (requires --json-rpc.enable-indexer)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndexer, false, "Enable the address and sender nonce indexer for the ots namespace and the eth tx lookups by address (requires --json-rpc.enable-indexer)") //nolint:lll
+	cmd.Flags().Bool(srvflags.JSONRPCEnableTransferIndexer, false, "Enable the token and native coin transfer indexer for eth_getAssetTransfers (requires --json-rpc.enable-indexer)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableGraphQL, false, "Enables the GraphQL endpoint at /graphql on the json-rpc address")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowMethods, []string{}, "Defines the methods served by the json-rpc and websocket servers, as namespace_method or namespace_* (all if empty)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDenyMethods, []string{}, "Defines the methods that are not served by the json-rpc and websocket servers, as namespace_method or namespace_*")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "Sets the unix socket of the json-rpc IPC server, relative to the home directory if not absolute (disabled if empty)")
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, cosmosevmserverconfig.DefaultResponseCacheSize, "Sets the memory budget in MB of the cache of the historical blocks, receipts and logs (0=disabled)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableRateLimit, false, "Enables the rate limiting of the json-rpc and websocket requests per client IP or API key")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerIP, cosmosevmserverconfig.DefaultRateLimitPerIP, "Sets the number of request cost units per second allowed per client IP")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, cosmosevmserverconfig.DefaultRateLimitBurst, "Sets the number of request cost units a client IP can spend at once")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitAPIKeys, []string{}, "Defines the API keys, as key or key:rate:burst")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethodCosts, cosmosevmserverconfig.DefaultRateLimitMethodCosts, "Defines the cost of the methods, as method=cost")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitWSSubscribeRate, cosmosevmserverconfig.DefaultRateLimitWSSubscribeRate, "Sets the number of websocket subscriptions per second a client can create")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitWSSubscribeBurst, cosmosevmserverconfig.DefaultRateLimitWSSubscribeBurst, "Sets the number of websocket subscriptions a client can create at once")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitWSMaxSubscriptions, cosmosevmserverconfig.DefaultRateLimitWSMaxSubscriptions, "Sets the max number of active subscriptions of a websocket connection (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOracleBlocks, cosmosevmserverconfig.DefaultGasPriceOracleBlocks, "Sets the number of recent blocks sampled by the gas price oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGasPriceOraclePercentile, cosmosevmserverconfig.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Int64(srvflags.JSONRPCGasPriceOracleMaxPrice, cosmosevmserverconfig.DefaultGasPriceOracleMaxPrice, "Sets the max tip in wei suggested by the gas price oracle")
+	cmd.Flags().Int64(srvflags.JSONRPCGasPriceOracleIgnorePrice, cosmosevmserverconfig.DefaultGasPriceOracleIgnorePrice, "Sets the tip in wei below which the transactions aren't sampled by the gas price oracle")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -230,10 +254,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalSlots, cosmosevmserverconfig.DefaultMempoolConfig().GlobalSlots, "the maximum number of executable transaction slots for all accounts")
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalBytes, cosmosevmserverconfig.DefaultMempoolConfig().GlobalBytes, "the maximum size in bytes of all the transactions of the pool, the ones with the lowest effective tip are evicted first when it's exceeded (0 means unbounded)")
+	cmd.Flags().String(srvflags.EVMMempoolAccountSlotsBalance, cosmosevmserverconfig.DefaultMempoolConfig().AccountSlotsBalance, "the balance in wei for which an account is granted the account slots and queue, richer accounts are granted proportionally more up to 4 times (empty disables the scaling)")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().Duration(srvflags.EVMMempoolQueuedTxLifetime, cosmosevmserverconfig.DefaultMempoolConfig().QueuedTxLifetime, "the maximum amount of time a single non-executable transaction is queued, even if its account stays active (0 disables it)")
 	cmd.Flags().Bool(srvflags.EVMMempoolOperateExclusively, cosmosevmserverconfig.DefaultMempoolConfig().OperateExclusively, "if this mempool is the only mempool in the application (CometBFT must be using the 'app' mempool if this mempool is operating exclusively)")
 	cmd.Flags().Duration(srvflags.EVMMempoolPendingTxProposalTimeout, cosmosevmserverconfig.DefaultMempoolConfig().PendingTxProposalTimeout, "the maximum amount of time to spend waiting for rechecking of the mempool to complete when creating a proposal")
 	cmd.Flags().Int(srvflags.EVMMempoolInsertQueueSize, cosmosevmserverconfig.DefaultMempoolConfig().InsertQueueSize, "the maximum number of transactions that can be in the insert queue at once")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolConfig().Journal, "the path of the journal of the mempool txs surviving node restarts, relative to the data directory (disabled if empty)")
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolConfig().Rejournal, "the interval at which the mempool journal is regenerated from the txs in the mempool")
+	cmd.Flags().Bool(srvflags.EVMMempoolJournalLocals, cosmosevmserverconfig.DefaultMempoolConfig().JournalLocals, "if only the EVM txs submitted over JSON-RPC are journaled")
+	cmd.Flags().Duration(srvflags.EVMMempoolTxStatusRetention, cosmosevmserverconfig.DefaultMempoolConfig().TxStatusRetention, "how long the reason an EVM tx exited the mempool is retained for, to report its status (0 disables it)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -431,6 +462,10 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		logger.Info("starting node in query only mode; CometBFT is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.EnableTraceIndexer = false
+		config.JSONRPC.EnableLogIndexer = false
+		config.JSONRPC.EnableAddressIndexer = false
+		config.JSONRPC.EnableTransferIndexer = false
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
@@ -461,6 +496,17 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		if m, ok := evmApp.GetMempool().(EventBusser); ok && m != nil {
 			m.SetEventBus(bftNode.EventBus())
 		}
+
+		// the journaled txs are reinserted once the chain state is loaded
+		type Journaler interface {
+			StartJournal() error
+		}
+		if m, ok := evmApp.GetMempool().(Journaler); ok && m != nil {
+			if err := m.StartJournal(); err != nil {
+				logger.Error("failed to start mempool journal", "error", err.Error())
+				return err
+			}
+		}
 		defer func() {
 			if bftNode.IsRunning() {
 				_ = bftNode.Stop()
@@ -504,7 +550,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 
 		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		idxer = indexer.NewKVIndexer(
+			idxDB, idxLogger, clientCtx,
+			indexer.WithLogIndex(config.JSONRPC.EnableLogIndexer),
+			indexer.WithAddressIndex(config.JSONRPC.EnableAddressIndexer),
+			indexer.WithTransferIndex(config.JSONRPC.EnableTransferIndexer),
+		)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -587,6 +638,12 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenTraceIndexerDB opens the call trace address indexer db, using the same db backend as the main app
+func OpenTraceIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmtraceindexer", backendType, dataDir)
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+)
+
+const TraceIndexerServiceName = "EVMTraceIndexerService"
+
+// BlockTracer returns the flat call traces of all the eth txs of a block.
+type BlockTracer interface {
+	TraceBlockFlat(ctx context.Context, blockNum rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error)
+}
+
+// EVMTraceIndexerService indexes the addresses of the call traces of the eth
+// txs for the trace_filter json-rpc method. The new blocks are indexed as they
+// are committed, and the earlier blocks are backfilled in the background down
+// to the backfill height, if any.
+type EVMTraceIndexerService struct {
+	service.BaseService
+
+	traceIdxr      servertypes.TraceIndexer
+	tracer         BlockTracer
+	client         rpcclient.Client
+	backfillHeight int64
+}
+
+// NewEVMTraceIndexerService returns a new service instance, a zero backfill
+// height disables the backfill.
+func NewEVMTraceIndexerService(
+	traceIdxr servertypes.TraceIndexer,
+	tracer BlockTracer,
+	client rpcclient.Client,
+	backfillHeight int64,
+) *EVMTraceIndexerService {
+	is := &EVMTraceIndexerService{traceIdxr: traceIdxr, tracer: tracer, client: client, backfillHeight: backfillHeight}
+	is.BaseService = *service.NewBaseService(nil, TraceIndexerServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing the traces of their eth txs.
+func (tis *EVMTraceIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := tis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latestBlock := status.SyncInfo.LatestBlockHeight
+	newBlockSignal := make(chan struct{}, 1)
+
+	blockHeadersChan, err := tis.client.Subscribe(
+		ctx,
+		TraceIndexerServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			msg := <-blockHeadersChan
+			eventDataHeader := msg.Data.(types.EventDataNewBlockHeader)
+			if eventDataHeader.Header.Height > latestBlock {
+				latestBlock = eventDataHeader.Header.Height
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	lastBlock, err := tis.traceIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		lastBlock = latestBlock
+	}
+
+	if tis.backfillHeight > 0 {
+		firstBlock, err := tis.traceIdxr.FirstIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if firstBlock == -1 {
+			firstBlock = lastBlock + 1
+		}
+		go tis.backfill(ctx, firstBlock-1)
+	}
+
+	// traceErr indicates an error tracing an expected block, the block is
+	// retried since the indexed range must not have gaps
+	var traceErr error
+
+	for {
+		if latestBlock <= lastBlock || traceErr != nil {
+			select {
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+			}
+			traceErr = nil
+			continue
+		}
+		for i := lastBlock + 1; i <= latestBlock; i++ {
+			if traceErr = tis.indexBlock(ctx, i); traceErr != nil {
+				tis.Logger.Error("failed to index block traces", "height", i, "err", traceErr)
+				break
+			}
+			lastBlock = i
+		}
+	}
+}
+
+// backfill indexes the blocks backward from the given height down to the
+// backfill height. It stops at the first block that can't be traced, e.g.
+// because its state is pruned, since the indexed range must not have gaps.
+func (tis *EVMTraceIndexerService) backfill(ctx context.Context, from int64) {
+	to := max(tis.backfillHeight, 1)
+	if from < to {
+		return
+	}
+	tis.Logger.Info("backfilling the trace index", "from", from, "to", to)
+	for height := from; height >= to; height-- {
+		select {
+		case <-tis.Quit():
+			return
+		default:
+		}
+		if err := tis.indexBlock(ctx, height); err != nil {
+			tis.Logger.Error("failed to backfill the trace index, stopping", "height", height, "err", err)
+			return
+		}
+	}
+	tis.Logger.Info("backfilled the trace index", "to", to)
+}
+
+// indexBlock traces the eth txs of the block and indexes their addresses.
+func (tis *EVMTraceIndexerService) indexBlock(ctx context.Context, height int64) error {
+	traces, err := tis.tracer.TraceBlockFlat(ctx, rpctypes.BlockNumber(height))
+	if err != nil {
+		return fmt.Errorf("trace block: %w", err)
+	}
+	return tis.traceIdxr.IndexBlock(height, traceAddresses(traces))
+}
+
+// traceAddresses groups the senders and recipients of the traces and the
+// contracts they create by eth tx.
+func traceAddresses(traces []*rpctypes.ParityTrace) []servertypes.TraceTxAddresses {
+	var (
+		txs  []servertypes.TraceTxAddresses
+		seen map[common.Address]byte
+	)
+	const (
+		seenFrom = 1 << iota
+		seenTo
+	)
+	for _, t := range traces {
+		txIndex := int32(t.TransactionPosition) //#nosec G115 -- eth tx index won't exceed int32
+		if len(txs) == 0 || txs[len(txs)-1].EthTxIndex != txIndex {
+			txs = append(txs, servertypes.TraceTxAddresses{EthTxIndex: txIndex})
+			seen = make(map[common.Address]byte)
+		}
+		tx := &txs[len(txs)-1]
+		from, to := t.Addresses()
+		if from != nil && seen[*from]&seenFrom == 0 {
+			seen[*from] |= seenFrom
+			tx.From = append(tx.From, *from)
+		}
+		if to != nil && seen[*to]&seenTo == 0 {
+			seen[*to] |= seenTo
+			tx.To = append(tx.To, *to)
+		}
+		if t.Type == rpctypes.ParityTraceTypeCreate && t.Error == "" && from != nil && to != nil {
+			tx.Created = append(tx.Created, servertypes.TraceCreation{Contract: *to, Creator: *from})
+		}
+	}
+	return txs
+}
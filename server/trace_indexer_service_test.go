@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log/v2"
+)
+
+// prunedTracer traces a transfer in each block above the pruned height.
+type prunedTracer struct {
+	from, to     common.Address
+	prunedHeight int64
+}
+
+func (pt prunedTracer) TraceBlockFlat(_ context.Context, blockNum rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	if blockNum.Int64() <= pt.prunedHeight {
+		return nil, errors.New("state pruned")
+	}
+	return []*rpctypes.ParityTrace{{
+		Action: rpctypes.ParityTraceAction{From: &pt.from, To: &pt.to},
+		Type:   rpctypes.ParityTraceTypeCall,
+	}}, nil
+}
+
+func TestTraceIndexerBackfill(t *testing.T) {
+	var (
+		alice = common.BytesToAddress([]byte{0x1})
+		bob   = common.BytesToAddress([]byte{0x2})
+	)
+
+	testCases := []struct {
+		name           string
+		backfillHeight int64
+		prunedHeight   int64
+		expFirst       int64
+	}{
+		{"backfill down to the backfill height", 3, 0, 3},
+		{"backfill down to the first block", 1, 0, 1},
+		{"stop at the first pruned block", 1, 2, 3},
+		{"nothing to backfill", 10, 0, 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := indexer.NewTraceIndexer(dbm.NewMemDB(), log.NewNopLogger())
+			require.NoError(t, idxer.IndexBlock(5, nil))
+
+			tracer := prunedTracer{from: alice, to: bob, prunedHeight: tc.prunedHeight}
+			tis := NewEVMTraceIndexerService(idxer, tracer, nil, tc.backfillHeight)
+			tis.backfill(context.Background(), 4)
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.expFirst, first)
+
+			positions, err := idxer.GetByFromAddress(alice, 0, 4)
+			require.NoError(t, err)
+			require.Len(t, positions, int(5-tc.expFirst))
+		})
+	}
+}
+
+func TestTraceAddresses(t *testing.T) {
+	var (
+		alice    = common.BytesToAddress([]byte{0x1})
+		factory  = common.BytesToAddress([]byte{0x2})
+		contract = common.BytesToAddress([]byte{0x3})
+		failed   = common.BytesToAddress([]byte{0x4})
+	)
+	traces := []*rpctypes.ParityTrace{
+		{
+			Action: rpctypes.ParityTraceAction{From: &alice, To: &factory},
+			Type:   rpctypes.ParityTraceTypeCall,
+		},
+		{
+			Action:              rpctypes.ParityTraceAction{From: &factory},
+			Result:              &rpctypes.ParityTraceResult{Address: &contract},
+			Type:                rpctypes.ParityTraceTypeCreate,
+			TraceAddress:        []int{0},
+			TransactionPosition: 0,
+		},
+		{
+			Action:              rpctypes.ParityTraceAction{From: &alice, To: &factory},
+			Type:                rpctypes.ParityTraceTypeCall,
+			TransactionPosition: 1,
+		},
+		{
+			Action:              rpctypes.ParityTraceAction{From: &factory},
+			Result:              &rpctypes.ParityTraceResult{Address: &failed},
+			Error:               "execution reverted",
+			Type:                rpctypes.ParityTraceTypeCreate,
+			TraceAddress:        []int{0},
+			TransactionPosition: 1,
+		},
+	}
+
+	require.Equal(t, []servertypes.TraceTxAddresses{
+		{
+			EthTxIndex: 0,
+			From:       []common.Address{alice, factory},
+			To:         []common.Address{factory, contract},
+			Created:    []servertypes.TraceCreation{{Contract: contract, Creator: factory}},
+		},
+		{
+			EthTxIndex: 1,
+			From:       []common.Address{alice, factory},
+			To:         []common.Address{factory, failed},
+		},
+	}, traceAddresses(traces))
+}
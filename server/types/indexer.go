@@ -1,20 +1,234 @@
 package types
 
 import (
+	"errors"
+	"math/big"
+	"slices"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
+// ErrTxNotFound is returned when an eth tx is not indexed.
+var ErrTxNotFound = errors.New("tx not found")
+
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
 
-	// GetByTxHash returns nil if tx not found.
+	// GetByTxHash returns ErrTxNotFound if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
-	// GetByBlockAndIndex returns nil if tx not found.
+	// GetByBlockAndIndex returns ErrTxNotFound if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// TraceIndexer defines the interface of the on-disk index of the addresses
+// appearing as sender or recipient in the call traces of eth txs, including
+// the contracts created by internal calls.
+type TraceIndexer interface {
+	// LastIndexedBlock returns -1 if indexer db is empty
+	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	IndexBlock(height int64, txs []TraceTxAddresses) error
+
+	// GetByFromAddress returns the eth txs within the inclusive block range
+	// whose traces have the given address as sender.
+	GetByFromAddress(address common.Address, fromBlock, toBlock int64) ([]TracePosition, error)
+	// GetByToAddress returns the eth txs within the inclusive block range
+	// whose traces have the given address as recipient.
+	GetByToAddress(address common.Address, fromBlock, toBlock int64) ([]TracePosition, error)
+	// GetByAddress returns the eth txs within the inclusive block range whose
+	// traces have the given address as sender or recipient, with the same
+	// paging as EVMAddressIndexer.GetByAddress.
+	GetByAddress(address common.Address, fromBlock, toBlock int64, reverse bool, limit int) ([]AddressTx, bool, error)
+	// GetContractCreator returns nil if no indexed trace created the contract.
+	GetContractCreator(address common.Address) (*TraceContractCreator, error)
+}
+
+// TraceTxAddresses are the addresses found in the call traces of an eth tx.
+type TraceTxAddresses struct {
+	EthTxIndex int32
+	From       []common.Address
+	To         []common.Address
+	Created    []TraceCreation
+}
+
+// TraceCreation is a contract created by a call trace and its creator, which
+// is the contract doing the creation for internal calls.
+type TraceCreation struct {
+	Contract common.Address
+	Creator  common.Address
+}
+
+// TraceContractCreator locates the eth tx whose call traces created a
+// contract, with the creator of the contract.
+type TraceContractCreator struct {
+	TracePosition
+	Creator common.Address
+}
+
+// TracePosition locates an eth tx in the chain.
+type TracePosition struct {
+	Height     int64
+	EthTxIndex int32
+}
+
+// Roles of an address in an indexed eth tx.
+const (
+	AddressRoleFrom uint8 = 1 << iota
+	AddressRoleTo
+	AddressRoleCreated
+)
+
+// EVMAddressIndexer defines the interface of the eth tx indexers that also
+// index the txs each address appears in and the creators of the contracts.
+type EVMAddressIndexer interface {
+	// AddressIndexEnabled returns true if the eth txs of newly indexed blocks
+	// are indexed by address and by sender and nonce.
+	AddressIndexEnabled() bool
+	// FirstAddressIndexedBlock returns the first block whose eth txs are
+	// indexed by address and by sender and nonce, returns -1 if no block is.
+	FirstAddressIndexedBlock() (int64, error)
+	// GetByAddress returns the eth txs within the inclusive block range in
+	// which the address appears, in ascending order or descending order if
+	// reverse is set. At least limit txs are returned when available, but a
+	// block is never split across pages. The second return value reports if
+	// there are more txs beyond the returned ones. A zero limit returns all.
+	GetByAddress(address common.Address, fromBlock, toBlock int64, reverse bool, limit int) ([]AddressTx, bool, error)
+	// GetContractCreator returns nil if the contract creation is not indexed.
+	GetContractCreator(address common.Address) (*ContractCreator, error)
+	// GetBySenderAndNonce returns the hash of the eth tx sent by the sender
+	// with the nonce, returns nil if the tx is not indexed.
+	GetBySenderAndNonce(sender common.Address, nonce uint64) (*common.Hash, error)
+}
+
+// AddressTx locates an eth tx an address appears in, with the roles of the
+// address in the tx.
+type AddressTx struct {
+	Height     int64
+	EthTxIndex int32
+	Roles      uint8
+}
+
+// ContractCreator is the eth tx and the sender that created a contract.
+type ContractCreator struct {
+	TxHash  common.Hash
+	Creator common.Address
+}
+
+// EVMLogIndexer defines the interface of the eth tx indexers that also index
+// the logs by emitting address and first topic.
+type EVMLogIndexer interface {
+	// LogIndexEnabled returns true if the logs of newly indexed blocks are
+	// indexed.
+	LogIndexEnabled() bool
+	// LastLogIndexedBlock returns -1 if no block logs are indexed
+	LastLogIndexedBlock() (int64, error)
+	// FirstLogIndexedBlock returns -1 if no block logs are indexed
+	FirstLogIndexedBlock() (int64, error)
+	// UnindexedLogBlocks returns in ascending order the blocks within the
+	// inclusive range whose logs are not indexed, false if there are more
+	// than limit of them.
+	UnindexedLogBlocks(fromBlock, toBlock int64, limit int) ([]int64, bool, error)
+	// GetLogBlocks returns in ascending order the blocks within the inclusive
+	// range that contain a log emitted by one of the addresses with one of the
+	// first topics. An empty list matches any address or topic, but at least
+	// one of them must be given.
+	GetLogBlocks(addresses []common.Address, topics0 []common.Hash, fromBlock, toBlock int64) ([]int64, error)
+}
+
+// Categories of the indexed transfers.
+const (
+	// TransferCategoryExternal is the native coin value of an eth tx.
+	TransferCategoryExternal = "external"
+	// TransferCategoryERC20 is an ERC-20 Transfer log.
+	TransferCategoryERC20 = "erc20"
+	// TransferCategoryERC721 is an ERC-721 Transfer log.
+	TransferCategoryERC721 = "erc721"
+	// TransferCategoryERC1155 is an ERC-1155 TransferSingle or TransferBatch
+	// log.
+	TransferCategoryERC1155 = "erc1155"
+	// TransferCategoryNative is a bank transfer of the Cosmos side of an eth
+	// tx, like the precompile sends and the x/erc20 conversions.
+	TransferCategoryNative = "native"
+)
+
+// EVMTransferIndexer defines the interface of the eth tx indexers that also
+// index the token and native coin transfers by address.
+type EVMTransferIndexer interface {
+	// TransferIndexEnabled returns true if the transfers of newly indexed
+	// blocks are indexed.
+	TransferIndexEnabled() bool
+	// LastTransferIndexedBlock returns -1 if no block transfers are indexed
+	LastTransferIndexedBlock() (int64, error)
+	// FirstTransferIndexedBlock returns -1 if no block transfers are indexed
+	FirstTransferIndexedBlock() (int64, error)
+	// GetTransfers returns at most limit transfers matching the filter, and
+	// the position of the next matching transfer if there is one.
+	GetTransfers(filter TransferFilter, limit int) ([]*Transfer, *TransferPosition, error)
+}
+
+// Transfer is an indexed transfer of tokens or native coins.
+type Transfer struct {
+	TransferPosition
+	TxHash   common.Hash     `json:"txHash"`
+	Category string          `json:"category"`
+	From     common.Address  `json:"from"`
+	To       common.Address  `json:"to"`
+	Contract *common.Address `json:"contract,omitempty"`
+	// Value is the amount of the fungible transfers
+	Value *big.Int `json:"value,omitempty"`
+	// TokenID is the ERC-721 token id
+	TokenID *big.Int `json:"tokenId,omitempty"`
+	// ERC1155 are the ERC-1155 token ids and amounts
+	ERC1155 []ERC1155Transfer `json:"erc1155,omitempty"`
+	// Denom is the denom of the native transfers
+	Denom string `json:"denom,omitempty"`
+}
+
+// ERC1155Transfer is the amount of an ERC-1155 token id transferred.
+type ERC1155Transfer struct {
+	TokenID *big.Int `json:"tokenId"`
+	Value   *big.Int `json:"value"`
+}
+
+// TransferPosition locates a transfer among the transfers of the chain.
+type TransferPosition struct {
+	Height int64  `json:"height"`
+	Index  uint64 `json:"index"`
+}
+
+// TransferFilter are the criteria of the transfers to look up. A nil address
+// matches any address, and an empty list any contract or category.
+type TransferFilter struct {
+	FromAddress *common.Address
+	ToAddress   *common.Address
+	Contracts   []common.Address
+	Categories  []string
+	// FromBlock and ToBlock bound the inclusive block range
+	FromBlock int64
+	ToBlock   int64
+	// Reverse returns the most recent transfers first
+	Reverse bool
+	// Start is the position the lookup starts from, included
+	Start *TransferPosition
+}
+
+// Matches returns true if the transfer satisfies the criteria of the filter.
+func (f *TransferFilter) Matches(t *Transfer) bool {
+	if f.FromAddress != nil && *f.FromAddress != t.From {
+		return false
+	}
+	if f.ToAddress != nil && *f.ToAddress != t.To {
+		return false
+	}
+	if len(f.Contracts) > 0 && (t.Contract == nil || !slices.Contains(f.Contracts, *t.Contract)) {
+		return false
+	}
+	return len(f.Categories) == 0 || slices.Contains(f.Categories, t.Category)
+}
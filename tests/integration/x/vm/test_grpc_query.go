@@ -9,8 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -18,6 +20,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	"github.com/cosmos/evm/testutil/integration/evm/factory"
@@ -33,6 +36,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -321,6 +325,120 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	newIndex := s.Keyring.AddKey()
+	addr := s.Keyring.GetAddr(newIndex)
+
+	ctx := s.Network.GetContext()
+	var expStorage types.Storage
+	for i := byte(1); i <= 3; i++ {
+		key := common.BytesToHash([]byte{i})
+		value := common.BytesToHash([]byte{i, i})
+		s.Network.App.GetEVMKeeper().SetState(ctx, addr, key, value.Bytes())
+		expStorage = append(expStorage, types.NewState(key, value))
+	}
+
+	_, err := s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{Address: invalidAddress})
+	s.Require().Error(err)
+
+	res, err := s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expStorage[:2], res.Storage)
+	s.Require().NotEmpty(res.Pagination.NextKey)
+
+	res, err = s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(expStorage[2:], res.Storage)
+	s.Require().Empty(res.Pagination.NextKey)
+}
+
+func (s *KeeperTestSuite) TestQueryTxStatus() {
+	// the status of the txs is reported by the exclusive app-side mempool
+	options := s.Options
+	s.Options = append(append([]network.ConfigOption{}, options...), network.WithExclusiveMempool())
+	defer func() { s.Options = options }()
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, types.EvmTxArgs{To: &recipient, Amount: big.NewInt(1000)})
+	s.Require().NoError(err)
+	msg, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	s.Require().True(ok)
+	s.Require().NoError(s.Network.App.GetMempool().Insert(s.Network.GetContext(), signedTx))
+
+	testCases := []struct {
+		name      string
+		hash      string
+		expPass   bool
+		expStatus []string
+		expNonce  uint64
+	}{
+		{"invalid hash", "0x1234", false, nil, 0},
+		{"unknown tx", common.HexToHash("0x1234").Hex(), true, []string{"unknown"}, 0},
+		{
+			"tx in the mempool",
+			msg.Hash().Hex(),
+			true,
+			// the tx is queued until the mempool promotes it
+			[]string{"pending", "queued"},
+			msg.AsTransaction().Nonce(),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.Network.GetEvmClient().TxStatus(s.Network.GetContext(), &types.QueryTxStatusRequest{Hash: tc.hash})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Contains(tc.expStatus, res.Status)
+			s.Require().Equal(tc.expNonce, res.Nonce)
+			s.Require().Empty(res.Reason)
+			s.Require().Empty(res.ReplacedBy)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryContracts() {
+	ctx := s.Network.GetContext()
+
+	var expAddrs []string
+	s.Network.App.GetEVMKeeper().IterateContracts(ctx, func(addr common.Address, _ common.Hash) bool {
+		expAddrs = append(expAddrs, addr.Hex())
+		return false
+	})
+	s.Require().NotEmpty(expAddrs)
+
+	var (
+		addrs   []string
+		nextKey []byte
+	)
+	for {
+		res, err := s.Network.GetEvmClient().Contracts(ctx, &types.QueryContractsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Contracts), 1)
+		for _, contract := range res.Contracts {
+			s.Require().NotEqual(common.Hash{}.Hex(), contract.CodeHash)
+			addrs = append(addrs, contract.Address)
+		}
+		nextKey = res.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	s.Require().Equal(expAddrs, addrs)
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -1506,6 +1624,124 @@ func (s *KeeperTestSuite) TestTraceTx() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceTxPrestateDiff() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	var (
+		senderKey    = s.Keyring.GetKey(0)
+		recipient    = common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+		feeCollector = common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+		amount       = big.NewInt(1000)
+	)
+	txArgs := types.EvmTxArgs{To: &recipient, Amount: amount}
+
+	// the traced message is the transfer delivered in the previous block
+	signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, txArgs)
+	s.Require().NoError(err)
+	msgToTrace, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+	s.Require().True(ok)
+	res, err := s.Factory.ExecuteEthTx(senderKey.Priv, txArgs)
+	s.Require().NoError(err)
+	s.Require().True(res.IsOK())
+	s.Require().NoError(s.Network.NextBlock())
+
+	recipientBalance := s.Network.App.GetEVMKeeper().SpendableCoin(s.Network.GetContext(), recipient)
+
+	type prestateDiff struct {
+		Pre  map[common.Address]struct{ Balance *hexutil.Big } `json:"pre"`
+		Post map[common.Address]struct{ Balance *hexutil.Big } `json:"post"`
+	}
+	testCases := []struct {
+		name        string
+		traceConfig *types.TraceConfig
+		getDiff     func(data []byte) prestateDiff
+	}{
+		{
+			name: "prestate tracer",
+			traceConfig: &types.TraceConfig{
+				Tracer:           types.PrestateTracerName,
+				TracerJsonConfig: `{"diffMode":true}`,
+			},
+			getDiff: func(data []byte) prestateDiff {
+				var diff prestateDiff
+				s.Require().NoError(json.Unmarshal(data, &diff))
+				return diff
+			},
+		},
+		{
+			name: "prestate tracer run by the mux tracer",
+			traceConfig: &types.TraceConfig{
+				Tracer:           types.MuxTracerName,
+				TracerJsonConfig: `{"callTracer":{},"prestateTracer":{"diffMode":true}}`,
+			},
+			getDiff: func(data []byte) prestateDiff {
+				var results struct {
+					CallTracer json.RawMessage `json:"callTracer"`
+					Diff       prestateDiff    `json:"prestateTracer"`
+				}
+				s.Require().NoError(json.Unmarshal(data, &results))
+				s.Require().NotEmpty(results.CallTracer)
+				return results.Diff
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			traceReq := getDefaultTraceTxRequest(s.Network)
+			traceReq.Msg = msgToTrace
+			traceReq.TraceConfig = tc.traceConfig
+			traceRes, err := s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), traceReq)
+			s.Require().NoError(err)
+
+			diff := tc.getDiff(traceRes.Data)
+			balanceChange := func(addr common.Address) *big.Int {
+				pre, post := new(big.Int), new(big.Int)
+				if acc, ok := diff.Pre[addr]; ok && acc.Balance != nil {
+					pre = acc.Balance.ToInt()
+				}
+				if acc, ok := diff.Post[addr]; ok && acc.Balance != nil {
+					post = acc.Balance.ToInt()
+				}
+				return new(big.Int).Sub(post, pre)
+			}
+
+			// the diff includes the fees, which aren't deducted by the EVM when tracing
+			s.Require().Equal(amount, balanceChange(recipient))
+			fees := balanceChange(feeCollector)
+			s.Require().Positive(fees.Sign())
+			s.Require().Equal(new(big.Int).Neg(new(big.Int).Add(amount, fees)), balanceChange(senderKey.Addr))
+
+			// the message is executed on a branch of the state, that is discarded
+			s.Require().Equal(recipientBalance, s.Network.App.GetEVMKeeper().SpendableCoin(s.Network.GetContext(), recipient))
+		})
+	}
+
+	s.Run("sender can't pay the fees after the message", func() {
+		// the transfer leaves a single wei to the sender, below the fees
+		balance := s.Network.App.GetEVMKeeper().SpendableCoin(s.Network.GetContext(), senderKey.Addr).ToBig()
+		signedTx, err := s.Factory.GenerateSignedEthTx(senderKey.Priv, types.EvmTxArgs{
+			To:       &recipient,
+			Amount:   new(big.Int).Sub(balance, big.NewInt(1)),
+			GasLimit: ethparams.TxGas,
+			GasPrice: s.Network.App.GetEVMKeeper().GetBaseFee(s.Network.GetContext()),
+		})
+		s.Require().NoError(err)
+		msg, ok := signedTx.GetMsgs()[0].(*types.MsgEthereumTx)
+		s.Require().True(ok)
+
+		traceReq := getDefaultTraceTxRequest(s.Network)
+		traceReq.Msg = msg
+		traceReq.TraceConfig = &types.TraceConfig{
+			Tracer:           types.PrestateTracerName,
+			TracerJsonConfig: `{"diffMode":true}`,
+		}
+		_, err = s.Network.GetEvmClient().TraceTx(s.Network.GetContext(), traceReq)
+		s.Require().ErrorContains(err, "lower than its fees")
+	})
+}
+
 func (s *KeeperTestSuite) TestTraceBlock() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -2213,6 +2449,169 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	recipient := common.HexToAddress("0x963EBDf2e1f8DB8707D05FC75bfeFFBa1B5BaC17")
+	contract := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	height := uint64(s.Network.GetContext().BlockHeight()) //nolint:gosec // G115 // block height is never negative
+
+	// returnWord is the code returning the word pushed by push, as a 32 bytes
+	// big endian value
+	returnWord := func(push ...byte) hexutil.Bytes {
+		return append(push, byte(vm.PUSH1), 0x00, byte(vm.MSTORE), byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00, byte(vm.RETURN))
+	}
+	// the revert data is appended to the code reverting with it
+	stringType, err := abi.NewType("string", "", nil)
+	s.Require().NoError(err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("boom")
+	s.Require().NoError(err)
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+	revertCode := append(hexutil.Bytes{
+		byte(vm.PUSH1), byte(len(revertData)), byte(vm.PUSH1), 0x0c, byte(vm.PUSH1), 0x00, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(revertData)), byte(vm.PUSH1), 0x00, byte(vm.REVERT),
+	}, revertData...)
+
+	var (
+		sloadCode   = returnWord(byte(vm.PUSH1), 0x00, byte(vm.SLOAD))
+		balanceCode = returnWord(append(append([]byte{byte(vm.PUSH20)}, recipient.Bytes()...), byte(vm.BALANCE))...)
+		numberCode  = returnWord(byte(vm.NUMBER))
+		numberAddr  = common.HexToAddress("0x6666666666666666666666666666666666666666")
+		blockTime   = hexutil.Uint64(4_000_000_000)
+	)
+
+	word := func(v int64) hexutil.Bytes { return common.BigToHash(big.NewInt(v)).Bytes() }
+	call := func(to common.Address) types.TransactionArgs {
+		return types.TransactionArgs{From: &sender, To: &to}
+	}
+
+	testCases := []struct {
+		name   string
+		blocks []rpctypes.SimBlock
+		check  func(results []*rpctypes.SimBlockResult)
+	}{
+		{
+			"state overrides",
+			[]rpctypes.SimBlock{{
+				StateOverrides: &rpctypes.StateOverride{
+					contract: rpctypes.OverrideAccount{
+						Code:  &sloadCode,
+						State: &map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))},
+					},
+				},
+				Calls: []types.TransactionArgs{call(contract)},
+			}},
+			func(results []*rpctypes.SimBlockResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0].Calls, 1)
+				res := results[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), res.Status)
+				s.Require().Equal(word(42), res.ReturnValue)
+			},
+		},
+		{
+			"block overrides and multiple blocks",
+			[]rpctypes.SimBlock{
+				{
+					// the value transferred in the first block is observed
+					// in the second one
+					Calls: []types.TransactionArgs{{
+						From:  &sender,
+						To:    &recipient,
+						Value: (*hexutil.Big)(big.NewInt(1000)),
+					}},
+				},
+				{
+					BlockOverrides: &rpctypes.BlockOverrides{
+						Number: (*hexutil.Big)(new(big.Int).SetUint64(height + 10)),
+						Time:   &blockTime,
+					},
+					StateOverrides: &rpctypes.StateOverride{
+						contract:   rpctypes.OverrideAccount{Code: &balanceCode},
+						numberAddr: rpctypes.OverrideAccount{Code: &numberCode},
+					},
+					Calls: []types.TransactionArgs{call(contract), call(numberAddr)},
+				},
+			},
+			func(results []*rpctypes.SimBlockResult) {
+				// the skipped block numbers are filled with empty blocks
+				s.Require().Len(results, 10)
+				for i, res := range results {
+					s.Require().Equal(hexutil.Uint64(height+uint64(i)+1), res.Number) //nolint:gosec // G115 // test block index
+					if i > 0 {
+						s.Require().Equal(results[i-1].Hash, res.ParentHash)
+					}
+					if i > 0 && i < len(results)-1 {
+						s.Require().Empty(res.Calls)
+					}
+				}
+				last := results[len(results)-1]
+				s.Require().Equal(blockTime, last.Timestamp)
+				s.Require().Equal(word(1000), last.Calls[0].ReturnValue)
+				s.Require().Equal(word(int64(height+10)), last.Calls[1].ReturnValue) //nolint:gosec // G115 // test block height
+			},
+		},
+		{
+			"revert",
+			[]rpctypes.SimBlock{{
+				StateOverrides: &rpctypes.StateOverride{
+					contract: rpctypes.OverrideAccount{Code: &revertCode},
+				},
+				Calls: []types.TransactionArgs{call(contract)},
+			}},
+			func(results []*rpctypes.SimBlockResult) {
+				s.Require().Len(results, 1)
+				res := results[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), res.Status)
+				s.Require().NotNil(res.Error)
+				s.Require().Equal(rpctypes.SimErrCodeReverted, res.Error.Code)
+				s.Require().Equal("execution reverted: boom", res.Error.Message)
+				s.Require().Equal(hexutil.Encode(revertData), res.Error.Data)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(&rpctypes.SimOpts{BlockStateCalls: tc.blocks})
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+				Opts:      opts,
+				GasCap:    config.DefaultGasCap,
+				ChainId:   s.Network.GetEIP155ChainID().Int64(),
+				BlockHash: common.Hash{0x1}.Hex(),
+			})
+			s.Require().NoError(err)
+			s.Require().Empty(res.Error)
+
+			var results []*rpctypes.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.check(results)
+		})
+	}
+
+	s.Run("gas cap budget of the request", func() {
+		transfer := types.TransactionArgs{From: &sender, To: &recipient, Value: (*hexutil.Big)(big.NewInt(1))}
+		opts, err := json.Marshal(&rpctypes.SimOpts{BlockStateCalls: []rpctypes.SimBlock{
+			{Calls: []types.TransactionArgs{transfer, transfer}},
+			{Calls: []types.TransactionArgs{transfer}},
+		}})
+		s.Require().NoError(err)
+
+		// the two transfers of the first block use up the whole gas cap
+		res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.SimulateV1Request{
+			Opts:      opts,
+			GasCap:    2 * ethparams.TxGas,
+			ChainId:   s.Network.GetEIP155ChainID().Int64(),
+			BlockHash: common.Hash{0x1}.Hex(),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int64(rpctypes.SimErrCodeClientLimit), res.ErrorCode)
+		s.Require().Contains(res.Error, "gas cap")
+	})
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -31,9 +31,13 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -45,6 +49,12 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// fractionalBalanceChangeEventType and fractionalBalanceChangeAttributeKeyAddress
+	// are the type and address attribute of the event of the precisebank module
+	// emitted on fractional balance changes.
+	fractionalBalanceChangeEventType           = "fractional_balance_change"
+	fractionalBalanceChangeAttributeKeyAddress = "address"
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -237,6 +247,77 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (_ *types.Q
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (_ *types.QueryStorageRangeResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "StorageRange", trace.WithAttributes(
+		attribute.String("address", req.Address),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage types.Storage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Contracts implements the Query/Contracts gRPC method
+func (k Keeper) Contracts(c context.Context, req *types.QueryContractsRequest) (_ *types.QueryContractsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "Contracts")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
+
+	var contracts []types.ContractAccount
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		address := common.BytesToAddress(key)
+		acct := k.GetAccountOrEmpty(ctx, address)
+		contracts = append(contracts, types.ContractAccount{
+			Address:  address.Hex(),
+			Balance:  acct.Balance.String(),
+			Nonce:    acct.Nonce,
+			CodeHash: common.BytesToHash(value).Hex(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (_ *types.QueryParamsResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -899,10 +980,44 @@ func (k *Keeper) traceTxWithMsg(
 		}
 	}()
 
+	// Record the Cosmos side of the precompile calls if asked with the tracer config
+	var (
+		precompileTracerConfig types.PrecompileTracerConfig
+		precompileRecorder     *types.PrecompileRecorder
+	)
+	if traceConfig.Tracer != "" && traceConfig.TracerJsonConfig != "" {
+		// ignore error. the tracer config was already validated by the tracer
+		_ = json.Unmarshal([]byte(traceConfig.TracerJsonConfig), &precompileTracerConfig)
+	}
+	if precompileTracerConfig.WithPrecompiles {
+		precompileRecorder = types.NewPrecompileRecorder()
+		ctx = types.ContextWithPrecompileRecorder(ctx, precompileRecorder)
+	}
+
+	// Report the Cosmos side balance changes in the diff of the prestate tracer
+	var prestateTracerConfig types.PrestateTracerConfig
+	if traceConfig.TracerJsonConfig != "" {
+		// ignore error. the tracer config was already validated by the tracer
+		switch traceConfig.Tracer {
+		case types.PrestateTracerName:
+			_ = json.Unmarshal([]byte(traceConfig.TracerJsonConfig), &prestateTracerConfig)
+		case types.MuxTracerName:
+			_ = json.Unmarshal(types.MuxTracerConfig(traceConfig.TracerJsonConfig, types.PrestateTracerName), &prestateTracerConfig)
+		}
+	}
+
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
-	stateDB := statedb.New(ctx, k, txConfig)
-	_, err = k.ApplyMessageWithConfig(ctx, stateDB, *msg, tracer.Hooks, commitMessage, false, cfg, txConfig, false, nil)
+	execCtx, commit := ctx, commitMessage
+	var writeCache func()
+	if prestateTracerConfig.DiffMode {
+		// the message is committed to a branch of the state, to read the
+		// balances after its execution
+		execCtx, writeCache = ctx.CacheContext()
+		commit = true
+	}
+	stateDB := statedb.New(execCtx, k, txConfig)
+	res, err := k.ApplyMessageWithConfig(execCtx, stateDB, *msg, tracer.Hooks, commit, false, cfg, txConfig, false, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -913,6 +1028,46 @@ func (k *Keeper) traceTxWithMsg(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if precompileRecorder != nil {
+		callTrace, err := rawTraceResult(result)
+		if err != nil {
+			return nil, err
+		}
+		if result, err = precompileRecorder.AddToCallTrace(callTrace); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if prestateTracerConfig.DiffMode {
+		raw, err := rawTraceResult(result)
+		if err != nil {
+			return nil, err
+		}
+		diff := raw
+		if traceConfig.Tracer == types.MuxTracerName {
+			// the diff is the result of the prestate tracer run by the mux tracer
+			diff, _ = types.MuxTracerResult(diff, types.PrestateTracerName)
+		}
+		balances, err := k.traceBalanceChanges(ctx, execCtx, msg, res.GasUsed, types.PrestateDiffAddresses(diff))
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if diff, err = types.SetPrestateDiffBalances(diff, balances); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if traceConfig.Tracer == types.MuxTracerName {
+			result, err = types.SetMuxTracerResult(raw, types.PrestateTracerName, diff)
+		} else {
+			result = diff
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if commitMessage {
+			writeCache()
+		}
+	}
+
 	return &result, nil
 }
 
@@ -953,6 +1108,136 @@ func (k Keeper) Config(ctx context.Context, _ *types.QueryConfigRequest) (*types
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// TxStatus implements the Query/TxStatus gRPC method
+func (k Keeper) TxStatus(c context.Context, req *types.QueryTxStatusRequest) (_ *types.QueryTxStatusResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	_, span := ctx.StartSpan(tracer, "TxStatus", trace.WithAttributes(
+		attribute.String("hash", req.Hash),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	hashBz, err := hexutil.Decode(req.Hash)
+	if err != nil || len(hashBz) != common.HashLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %s", req.Hash)
+	}
+
+	sm, ok := k.evmMempool.(txStatusMempool)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the mempool doesn't report the status of txs")
+	}
+	txStatus := sm.TxStatus(common.BytesToHash(hashBz))
+
+	res := &types.QueryTxStatusResponse{
+		Status:      string(txStatus.State),
+		Nonce:       txStatus.Nonce,
+		Reason:      string(txStatus.Reason),
+		BlockNumber: txStatus.BlockNumber,
+	}
+	if txStatus.ExpectedNonce != nil {
+		res.ExpectedNonce = *txStatus.ExpectedNonce
+	}
+	if txStatus.BaseFee != nil {
+		baseFee := sdkmath.NewIntFromBigInt(txStatus.BaseFee)
+		res.BaseFee = &baseFee
+	}
+	if txStatus.ReplacedBy != (common.Hash{}) {
+		res.ReplacedBy = txStatus.ReplacedBy.Hex()
+	}
+	if !txStatus.RemovedAt.IsZero() {
+		res.RemovedAt = &txStatus.RemovedAt
+	}
+	return res, nil
+}
+
+// traceBalanceChanges returns the balances of the EVM coin before and after
+// the traced message of the accounts touched by it: its sender and recipient,
+// the fee collector, the accounts of the EVM state diff and the ones whose bank
+// or precisebank balance changed. The balances include the fractional
+// precisebank balances and the fees of the message, which aren't deducted when
+// tracing. It fails if the sender can't pay the fees after the message, which
+// would have failed on chain.
+func (k *Keeper) traceBalanceChanges(
+	preCtx, postCtx sdk.Context,
+	msg *core.Message,
+	gasUsed uint64,
+	addrs []common.Address,
+) (map[common.Address]types.BalanceChange, error) {
+	feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	addrs = append(addrs, msg.From, feeCollector)
+	if msg.To != nil {
+		addrs = append(addrs, *msg.To)
+	}
+	for _, event := range postCtx.EventManager().Events() {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		case fractionalBalanceChangeEventType:
+			key = fractionalBalanceChangeAttributeKeyAddress
+		default:
+			continue
+		}
+		attr, ok := event.GetAttribute(key)
+		if !ok {
+			continue
+		}
+		if addr, err := sdk.AccAddressFromBech32(attr.Value); err == nil {
+			addrs = append(addrs, common.BytesToAddress(addr))
+		}
+	}
+
+	// the balances are the spendable ones, as returned by eth_getBalance
+	balanceOf := func(ctx sdk.Context, addr common.Address) *big.Int {
+		if balance := k.SpendableCoin(ctx, addr); balance != nil {
+			return balance.ToBig()
+		}
+		return new(big.Int)
+	}
+	balances := make(map[common.Address]types.BalanceChange, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := balances[addr]; ok {
+			continue
+		}
+		balances[addr] = types.BalanceChange{
+			Pre:  balanceOf(preCtx, addr),
+			Post: balanceOf(postCtx, addr),
+		}
+	}
+
+	// the fees are deducted from the sender and refunded by the fee collector
+	// outside of the message execution
+	if msg.GasPrice == nil {
+		return balances, nil
+	}
+	fees := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), msg.GasPrice)
+	if fees.Sign() <= 0 {
+		return balances, nil
+	}
+	sender := balances[msg.From]
+	if sender.Post.Cmp(fees) < 0 {
+		return nil, fmt.Errorf("balance %s of the sender %s after the message is lower than its fees %s", sender.Post, msg.From, fees)
+	}
+	sender.Post.Sub(sender.Post, fees)
+	collector := balances[feeCollector]
+	collector.Post.Add(collector.Post, fees)
+	return balances, nil
+}
+
+// rawTraceResult returns the JSON encoded result of a tracer.
+func rawTraceResult(result interface{}) (json.RawMessage, error) {
+	raw, ok := result.(json.RawMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "unexpected tracer result type %T", result)
+	}
+	return raw, nil
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
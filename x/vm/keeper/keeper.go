@@ -446,6 +446,12 @@ func (k *Keeper) SetEvmMempool(evmMempool evmmempool.NotifiedMempool) {
 	k.evmMempool = evmMempool
 }
 
+// txStatusMempool is implemented by the mempools that report the stage of
+// their lifecycle the evm txs are in.
+type txStatusMempool interface {
+	TxStatus(hash common.Hash) evmmempool.TxStatus
+}
+
 // SetHeaderHash sets current block hash into EIP-2935 compatible storage contract.
 func (k Keeper) SetHeaderHash(ctx sdk.Context) {
 	ctx, span := ctx.StartSpan(tracer, "SetHeaderHash", trace.WithAttributes(
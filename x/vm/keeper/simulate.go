@@ -0,0 +1,563 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	antetypes "github.com/cosmos/evm/ante/types"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event,
+// used for the synthetic logs of native value transfers.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// SimulateV1 implements the eth_simulateV1 rpc api. It executes a sequence of
+// simulated blocks on top of the requested block, each with its own block and
+// state overrides. Contrary to EthCall, the state changes of every call are
+// committed to a cached context so that subsequent calls observe them.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (_ *types.SimulateV1Response, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "SimulateV1", trace.WithAttributes(attribute.String("proposer", req.ProposerAddress.String())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	var opts rpctypes.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return simulateErrorResponse(err)
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// all the simulated blocks share a single cache context which is discarded
+	// once the query returns.
+	simCtx, _ := k.SetConsensusParamsInCtx(ctx).CacheContext()
+	// the gas cap is the budget of the whole request, not of each call
+	budget := req.GasCap
+	if budget == 0 {
+		budget = math.MaxUint64
+	}
+	sim := &simulator{
+		keeper: &k,
+		opts:   &opts,
+		cfg:    cfg,
+		gasCap: req.GasCap,
+		budget: budget,
+		parent: simParent{
+			number: uint64(ctx.BlockHeight()),      //#nosec G115 -- block height is never negative
+			time:   uint64(ctx.BlockTime().Unix()), //#nosec G115 -- block time is never negative
+			hash:   common.HexToHash(req.BlockHash),
+		},
+	}
+
+	results, err := sim.execute(simCtx)
+	if err != nil {
+		return simulateErrorResponse(err)
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.SimulateV1Response{Data: bz}, nil
+}
+
+// simulateErrorResponse returns the response of a simulation aborted by the
+// given error, keeping the JSON-RPC error code of simulation errors.
+func simulateErrorResponse(err error) (*types.SimulateV1Response, error) {
+	var simErr *rpctypes.SimError
+	if !errors.As(err, &simErr) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.SimulateV1Response{
+		ErrorCode: int64(simErr.Code),
+		Error:     simErr.Message,
+	}, nil
+}
+
+// simParent holds the header fields of the block preceding a simulated block.
+type simParent struct {
+	number uint64
+	time   uint64
+	hash   common.Hash
+}
+
+// simulator executes the blocks of a single eth_simulateV1 request.
+type simulator struct {
+	keeper *Keeper
+	opts   *rpctypes.SimOpts
+	cfg    *statedb.EVMConfig
+	gasCap uint64
+	// budget is the gas left to the calls of the request
+	budget uint64
+	parent simParent
+}
+
+// execute runs all the simulated blocks sequentially on the given context. The
+// block numbers skipped by a number override are filled with empty blocks, as
+// geth does.
+func (s *simulator) execute(ctx sdk.Context) ([]*rpctypes.SimBlockResult, error) {
+	results := make([]*rpctypes.SimBlockResult, 0, len(s.opts.BlockStateCalls))
+	for i := range s.opts.BlockStateCalls {
+		block := &s.opts.BlockStateCalls[i]
+		if overrides := block.BlockOverrides; overrides != nil && overrides.Number != nil {
+			number := overrides.Number.ToInt()
+			for number.IsUint64() && number.Uint64() > s.parent.number+1 {
+				res, err := s.nextBlock(ctx, len(results), &rpctypes.SimBlock{})
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, res)
+			}
+		}
+		res, err := s.nextBlock(ctx, len(results), block)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// nextBlock executes the block on top of the previous simulated block, given
+// the number of blocks already simulated.
+func (s *simulator) nextBlock(ctx sdk.Context, simulated int, block *rpctypes.SimBlock) (*rpctypes.SimBlockResult, error) {
+	if simulated >= rpctypes.MaxSimulateBlocks {
+		return nil, &rpctypes.SimError{Code: rpctypes.SimErrCodeClientLimit, Message: "too many blocks"}
+	}
+	res, err := s.processBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	s.parent = simParent{
+		number: uint64(res.Number),
+		time:   uint64(res.Timestamp),
+		hash:   res.Hash,
+	}
+	return res, nil
+}
+
+// processBlock applies the overrides of a simulated block and executes its calls.
+func (s *simulator) processBlock(ctx sdk.Context, block *rpctypes.SimBlock) (*rpctypes.SimBlockResult, error) {
+	number := s.parent.number + 1
+	blockTime := s.parent.time + rpctypes.SimulateTimestampIncrement
+	cfg := *s.cfg
+	if !s.opts.Validation {
+		// without validation calls are allowed to have a zero gas price
+		cfg.BaseFee = new(big.Int)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gasLimit := antetypes.BlockGasLimit(ctx)
+	if gasLimit == 0 || gasLimit > math.MaxInt64 {
+		gasLimit = math.MaxInt64
+	}
+
+	if overrides := block.BlockOverrides; overrides != nil {
+		if overrides.Number != nil {
+			n := overrides.Number.ToInt()
+			if !n.IsUint64() || n.Uint64() <= s.parent.number {
+				return nil, &rpctypes.SimError{
+					Code:    rpctypes.SimErrCodeBlockNumberOrder,
+					Message: fmt.Sprintf("block numbers must be in order: %s <= %d", n, s.parent.number),
+				}
+			}
+			number = n.Uint64()
+		}
+		if overrides.Time != nil {
+			if uint64(*overrides.Time) <= s.parent.time {
+				return nil, &rpctypes.SimError{
+					Code:    rpctypes.SimErrCodeBlockTimeOrder,
+					Message: fmt.Sprintf("block timestamps must be in order: %d <= %d", *overrides.Time, s.parent.time),
+				}
+			}
+			blockTime = uint64(*overrides.Time)
+		}
+		if overrides.GasLimit != nil {
+			gasLimit = min(uint64(*overrides.GasLimit), math.MaxInt64)
+			cp := ctx.ConsensusParams()
+			if cp.Block != nil {
+				blockParams := *cp.Block
+				blockParams.MaxGas = int64(gasLimit) //#nosec G115 -- capped above
+				cp.Block = &blockParams
+				ctx = ctx.WithConsensusParams(cp)
+			}
+		}
+		if overrides.FeeRecipient != nil {
+			cfg.CoinBase = *overrides.FeeRecipient
+		}
+		if overrides.BaseFeePerGas != nil {
+			cfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+		}
+	}
+
+	ctx = ctx.
+		WithBlockHeight(int64(number)).                     //#nosec G115 -- checked to fit the block height
+		WithBlockTime(time.Unix(int64(blockTime), 0).UTC()) //#nosec G115 -- timestamps fit an int64
+
+	if block.StateOverrides != nil {
+		if err := s.applyStateOverrides(ctx, block.StateOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		gasUsed uint64
+		txs     = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders = make([]common.Address, 0, len(block.Calls))
+		calls   = make([]*rpctypes.SimCallResult, 0, len(block.Calls))
+	)
+	for i := range block.Calls {
+		args := block.Calls[i]
+		tx, res, err := s.processCall(ctx, &cfg, &args, uint(i), gasLimit-gasUsed)
+		if err != nil {
+			return nil, err
+		}
+		gasUsed += uint64(res.GasUsed)
+		txs = append(txs, tx)
+		senders = append(senders, args.GetFrom())
+		calls = append(calls, res)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash:  s.parent.hash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    cfg.CoinBase,
+		Root:        ethtypes.EmptyRootHash,
+		TxHash:      ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil)),
+		ReceiptHash: ethtypes.EmptyReceiptsHash,
+		Difficulty:  new(big.Int),
+		Number:      new(big.Int).SetUint64(number),
+		GasLimit:    gasLimit,
+		GasUsed:     gasUsed,
+		Time:        blockTime,
+		MixDigest:   common.MaxHash,
+		BaseFee:     cfg.BaseFee,
+	}
+	blockHash := header.Hash()
+
+	var logIndex uint
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = blockHash
+			log.Index = logIndex
+			logIndex++
+		}
+	}
+
+	transactions := make([]interface{}, 0, len(txs))
+	for i, tx := range txs {
+		if !s.opts.ReturnFullTransactions {
+			transactions = append(transactions, tx.Hash())
+			continue
+		}
+		rpcTx := rpctypes.NewRPCTransaction(tx, blockHash, number, blockTime, uint64(i), cfg.BaseFee, types.GetEthChainConfig())
+		// simulated transactions are unsigned, so the sender can't be recovered
+		rpcTx.From = senders[i]
+		transactions = append(transactions, rpcTx)
+	}
+
+	return &rpctypes.SimBlockResult{
+		Number:        hexutil.Uint64(number),
+		Hash:          blockHash,
+		ParentHash:    s.parent.hash,
+		Timestamp:     hexutil.Uint64(blockTime),
+		GasLimit:      hexutil.Uint64(gasLimit),
+		GasUsed:       hexutil.Uint64(gasUsed),
+		Miner:         cfg.CoinBase,
+		BaseFeePerGas: (*hexutil.Big)(cfg.BaseFee),
+		MixHash:       common.MaxHash,
+		Transactions:  transactions,
+		Calls:         calls,
+	}, nil
+}
+
+// applyStateOverrides commits the state overrides of a simulated block to the
+// given context. Moving precompiles is not supported, since precompiles are
+// resolved per call.
+func (s *simulator) applyStateOverrides(ctx sdk.Context, overrides *rpctypes.StateOverride) error {
+	for addr, account := range *overrides {
+		if account.MovePrecompileTo != nil {
+			return &rpctypes.SimError{
+				Code:    rpctypes.SimErrCodeInvalidParams,
+				Message: fmt.Sprintf("account %s: moving precompiles is not supported", addr.Hex()),
+			}
+		}
+	}
+
+	stateDB := statedb.New(ctx, s.keeper, statedb.NewEmptyTxConfig())
+	if err := overrides.Apply(stateDB, vm.PrecompiledContracts{}); err != nil {
+		return &rpctypes.SimError{Code: rpctypes.SimErrCodeInvalidParams, Message: err.Error()}
+	}
+	return stateDB.Commit()
+}
+
+// processCall executes a single simulated call and commits its state changes.
+func (s *simulator) processCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args *types.TransactionArgs,
+	txIndex uint,
+	remainingGas uint64,
+) (*ethtypes.Transaction, *rpctypes.SimCallResult, error) {
+	from := args.GetFrom()
+	args.From = &from
+
+	stateNonce := s.keeper.GetNonce(ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&stateNonce)
+	}
+	if s.opts.Validation {
+		if nonce := uint64(*args.Nonce); nonce < stateNonce {
+			return nil, nil, &rpctypes.SimError{
+				Code:    rpctypes.SimErrCodeNonceTooLow,
+				Message: fmt.Sprintf("%s: address %s, tx: %d state: %d", core.ErrNonceTooLow, from.Hex(), nonce, stateNonce),
+			}
+		} else if nonce > stateNonce {
+			return nil, nil, &rpctypes.SimError{
+				Code:    rpctypes.SimErrCodeNonceTooHigh,
+				Message: fmt.Sprintf("%s: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from.Hex(), nonce, stateNonce),
+			}
+		}
+	}
+
+	if args.Gas == nil {
+		args.Gas = (*hexutil.Uint64)(&remainingGas)
+	} else if uint64(*args.Gas) > remainingGas {
+		return nil, nil, &rpctypes.SimError{
+			Code:    rpctypes.SimErrCodeBlockGasLimit,
+			Message: fmt.Sprintf("block gas limit reached: %d > %d", *args.Gas, remainingGas),
+		}
+	}
+
+	if s.budget == 0 {
+		return nil, nil, &rpctypes.SimError{
+			Code:    rpctypes.SimErrCodeClientLimit,
+			Message: fmt.Sprintf("gas cap of %d reached by the simulated calls", s.gasCap),
+		}
+	}
+	chainID := types.GetEthChainConfig().ChainID
+	// the gas of the call is capped to the budget left, so it never exceeds it
+	if err := args.CallDefaults(s.budget, cfg.BaseFee, chainID); err != nil {
+		return nil, nil, &rpctypes.SimError{Code: rpctypes.SimErrCodeInvalidParams, Message: err.Error()}
+	}
+	msg := args.ToMessage(cfg.BaseFee, !s.opts.Validation, !s.opts.Validation)
+	tx := args.ToTransaction(ethtypes.LegacyTxType)
+
+	var fee *uint256.Int
+	if s.opts.Validation {
+		var err error
+		if fee, err = s.validateFees(ctx, cfg, msg); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	txConfig := statedb.NewTxConfig(tx.Hash(), txIndex)
+	callCtx := buildTraceCtx(ctx, msg.GasLimit)
+	stateDB := statedb.New(callCtx, s.keeper, txConfig)
+	if fee != nil {
+		stateDB.SubBalance(from, fee, tracing.BalanceDecreaseGasBuy)
+	}
+	// the ante handler increments the sender sequence of calls before the execution
+	if msg.To != nil {
+		stateDB.SetNonce(from, msg.Nonce+1, tracing.NonceChangeEoACall)
+	}
+
+	var (
+		hooks    *tracing.Hooks
+		transfer *simTransferTracer
+	)
+	if s.opts.TraceTransfers {
+		transfer = newSimTransferTracer(stateDB)
+		hooks = transfer.hooks()
+	}
+
+	res, err := s.keeper.ApplyMessageWithConfig(callCtx, stateDB, *msg, hooks, true, false, cfg, txConfig, false, nil)
+	if err != nil {
+		if errors.Is(err, core.ErrIntrinsicGas) || errors.Is(err, core.ErrFloorDataGas) {
+			return nil, nil, &rpctypes.SimError{Code: rpctypes.SimErrCodeIntrinsicGas, Message: err.Error()}
+		}
+		return nil, nil, err
+	}
+	s.budget -= res.GasUsed
+
+	if fee != nil {
+		// refund the unused gas, as done after the execution of a transaction
+		refund := new(uint256.Int).Mul(
+			uint256.NewInt(msg.GasLimit-res.GasUsed),
+			uint256.MustFromBig(msg.GasPrice),
+		)
+		refundDB := statedb.New(callCtx, s.keeper, txConfig)
+		refundDB.AddBalance(from, refund, tracing.BalanceIncreaseGasReturn)
+		if err := refundDB.Commit(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logs := types.LogsToEthereum(res.Logs)
+	if transfer != nil {
+		logs = transfer.merge(logs)
+	}
+	for _, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight()) //#nosec G115 -- block height is never negative
+		log.TxHash = tx.Hash()
+		log.TxIndex = txIndex
+	}
+
+	result := &rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := types.NewExecErrorWithReason(res.Ret)
+			data, _ := revertErr.ErrorData().(string)
+			result.Error = &rpctypes.SimCallError{
+				Code:    rpctypes.SimErrCodeReverted,
+				Message: revertErr.Error(),
+				Data:    data,
+			}
+		} else {
+			result.Error = &rpctypes.SimCallError{
+				Code:    rpctypes.SimErrCodeVMError,
+				Message: res.VmError,
+			}
+		}
+	}
+	return tx, result, nil
+}
+
+// validateFees performs the fee checks of a transaction when validation is
+// enabled and returns the fee to be charged upfront to the sender.
+func (s *simulator) validateFees(ctx sdk.Context, cfg *statedb.EVMConfig, msg *core.Message) (*uint256.Int, error) {
+	if cfg.BaseFee != nil && msg.GasFeeCap.Cmp(cfg.BaseFee) < 0 {
+		return nil, &rpctypes.SimError{
+			Code: rpctypes.SimErrCodeBaseFeeTooLow,
+			Message: fmt.Sprintf("%s: address %s, maxFeePerGas: %s, baseFee: %s",
+				core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, cfg.BaseFee),
+		}
+	}
+
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+	cost := new(big.Int).Add(fee, msg.Value)
+	balance := s.keeper.GetAccountOrEmpty(ctx, msg.From).Balance
+	if balance.ToBig().Cmp(cost) < 0 {
+		return nil, &rpctypes.SimError{
+			Code: rpctypes.SimErrCodeInsufficientFunds,
+			Message: fmt.Sprintf("%s: address %s have %s want %s",
+				core.ErrInsufficientFunds, msg.From.Hex(), balance, cost),
+		}
+	}
+	return uint256.MustFromBig(fee), nil
+}
+
+// simTransferLog is a synthetic transfer log, along with the amount of logs
+// emitted by the transaction before it.
+type simTransferLog struct {
+	position int
+	log      *ethtypes.Log
+}
+
+// simTransferTracer records the native value transfers of a simulated call as
+// ERC-20 style Transfer logs. Transfers of reverted call frames are dropped.
+type simTransferTracer struct {
+	stateDB *statedb.StateDB
+	logs    []simTransferLog
+	frames  []int
+}
+
+func newSimTransferTracer(stateDB *statedb.StateDB) *simTransferTracer {
+	return &simTransferTracer{stateDB: stateDB}
+}
+
+func (t *simTransferTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: func(*tracing.VMContext, *ethtypes.Transaction, common.Address) {},
+		OnTxEnd:   func(*ethtypes.Receipt, error) {},
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *simTransferTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, len(t.logs))
+	if value == nil || value.Sign() == 0 || vm.OpCode(typ) == vm.DELEGATECALL {
+		return
+	}
+	t.logs = append(t.logs, simTransferLog{
+		position: len(t.stateDB.Logs()),
+		log: &ethtypes.Log{
+			Address: rpctypes.TransferLogAddress,
+			Topics: []common.Hash{
+				transferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *simTransferTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	start := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if reverted {
+		t.logs = t.logs[:start]
+	}
+}
+
+// merge interleaves the synthetic transfer logs with the logs emitted by the call.
+func (t *simTransferTracer) merge(logs []*ethtypes.Log) []*ethtypes.Log {
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.logs))
+	next := 0
+	for i, log := range logs {
+		for ; next < len(t.logs) && t.logs[next].position <= i; next++ {
+			merged = append(merged, t.logs[next].log)
+		}
+		merged = append(merged, log)
+	}
+	for ; next < len(t.logs); next++ {
+		merged = append(merged, t.logs[next].log)
+	}
+	return merged
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrecompileTracerConfig is the tracer configuration option adding the Cosmos
+// side of the precompile calls to the frames of the call tracer.
+type PrecompileTracerConfig struct {
+	WithPrecompiles bool `json:"withPrecompiles"`
+}
+
+// PrecompileCall is the Cosmos side of a precompile call.
+type PrecompileCall struct {
+	Address common.Address `json:"-"`
+	Input   []byte         `json:"-"`
+
+	// Method is the name of the called method of the precompile ABI
+	Method string `json:"method,omitempty"`
+	// Args are the decoded arguments of the method, by name
+	Args map[string]interface{} `json:"args,omitempty"`
+	// Msgs are the Cosmos messages executed by the precompile
+	Msgs []PrecompileMsg `json:"msgs,omitempty"`
+	// Events are the Cosmos events emitted by the precompile
+	Events []PrecompileEvent `json:"events,omitempty"`
+	// BalanceChanges are the bank balance changes of the precompile call
+	BalanceChanges []PrecompileBalanceChange `json:"balanceChanges,omitempty"`
+	// Error is the error of the precompile call, whose Cosmos changes were
+	// reverted
+	Error string `json:"error,omitempty"`
+}
+
+// PrecompileMsg is a Cosmos message executed by a precompile.
+type PrecompileMsg struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PrecompileEvent is a Cosmos event emitted by a precompile.
+type PrecompileEvent struct {
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// PrecompileBalanceChange is the change of the bank balance of a denom of an
+// account during a precompile call.
+type PrecompileBalanceChange struct {
+	Address common.Address `json:"address"`
+	Denom   string         `json:"denom"`
+	Delta   string         `json:"delta"`
+}
+
+type precompileRecorderKey struct{}
+
+// PrecompileRecorder records the precompile calls of a traced transaction, in
+// their execution order.
+type PrecompileRecorder struct {
+	calls []*PrecompileCall
+	stack []*PrecompileCall
+}
+
+// NewPrecompileRecorder creates an empty precompile recorder.
+func NewPrecompileRecorder() *PrecompileRecorder {
+	return &PrecompileRecorder{}
+}
+
+// ContextWithPrecompileRecorder returns a context whose precompile calls are
+// recorded by the recorder.
+func ContextWithPrecompileRecorder(ctx sdk.Context, r *PrecompileRecorder) sdk.Context {
+	return ctx.WithValue(precompileRecorderKey{}, r)
+}
+
+// PrecompileRecorderFromContext returns the precompile recorder of the
+// context, or nil if the precompile calls aren't recorded.
+func PrecompileRecorderFromContext(ctx sdk.Context) *PrecompileRecorder {
+	r, _ := ctx.Value(precompileRecorderKey{}).(*PrecompileRecorder)
+	return r
+}
+
+// Enter records the start of a precompile call.
+func (r *PrecompileRecorder) Enter(call *PrecompileCall) {
+	r.calls = append(r.calls, call)
+	r.stack = append(r.stack, call)
+}
+
+// Exit records the end of the current precompile call.
+func (r *PrecompileRecorder) Exit() {
+	if len(r.stack) > 0 {
+		r.stack = r.stack[:len(r.stack)-1]
+	}
+}
+
+// RecordMsg records a Cosmos message executed by the current precompile call.
+func (r *PrecompileRecorder) RecordMsg(msg sdk.Msg) {
+	if len(r.stack) == 0 {
+		return
+	}
+	value, err := json.Marshal(msg)
+	if err != nil {
+		value = json.RawMessage("null")
+	}
+	call := r.stack[len(r.stack)-1]
+	call.Msgs = append(call.Msgs, PrecompileMsg{Type: sdk.MsgTypeURL(msg), Value: value})
+}
+
+// Calls returns the recorded precompile calls.
+func (r *PrecompileRecorder) Calls() []*PrecompileCall {
+	return r.calls
+}
+
+// AddToCallTrace adds the recorded precompile calls, as a "precompile" field,
+// to the frames of the call tracer result calling the same precompile with the
+// same input, in their execution order.
+func (r *PrecompileRecorder) AddToCallTrace(result json.RawMessage) (json.RawMessage, error) {
+	if len(r.calls) == 0 {
+		return result, nil
+	}
+
+	var frame map[string]interface{}
+	// the results of the other tracers are kept as is
+	if json.Unmarshal(result, &frame) != nil {
+		return result, nil
+	}
+	next := 0
+	r.addToFrame(frame, &next)
+	return json.Marshal(frame)
+}
+
+func (r *PrecompileRecorder) addToFrame(frame map[string]interface{}, next *int) {
+	if *next < len(r.calls) {
+		call := r.calls[*next]
+		to, _ := frame["to"].(string)
+		input, _ := frame["input"].(string)
+		inputBz, err := hexutil.Decode(input)
+		if err != nil {
+			inputBz = nil
+		}
+		if common.IsHexAddress(to) && common.HexToAddress(to) == call.Address && bytes.Equal(inputBz, call.Input) {
+			frame["precompile"] = call
+			*next++
+		}
+	}
+
+	calls, _ := frame["calls"].([]interface{})
+	for _, c := range calls {
+		if child, ok := c.(map[string]interface{}); ok {
+			r.addToFrame(child, next)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestPrecompileRecorderAddToCallTrace(t *testing.T) {
+	staking := common.HexToAddress(types.StakingPrecompileAddress)
+	recorder := types.NewPrecompileRecorder()
+
+	// the messages are recorded in the current call
+	recorder.RecordMsg(&banktypes.MsgSend{FromAddress: "ignored"})
+	recorder.Enter(&types.PrecompileCall{Address: staking, Input: []byte{1, 2}, Method: "delegate"})
+	recorder.RecordMsg(&banktypes.MsgSend{FromAddress: "from", ToAddress: "to", Amount: sdk.NewCoins(sdk.NewInt64Coin("atest", 1))})
+	recorder.Exit()
+	recorder.Enter(&types.PrecompileCall{Address: staking, Input: []byte{3}, Error: "reverted"})
+	recorder.Exit()
+	require.Len(t, recorder.Calls(), 2)
+	require.Len(t, recorder.Calls()[0].Msgs, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", recorder.Calls()[0].Msgs[0].Type)
+
+	trace := `{"type":"CALL","to":"0x1000000000000000000000000000000000000001","input":"0x","calls":[` +
+		`{"type":"CALL","to":"` + staking.Hex() + `","input":"0x09"},` +
+		`{"type":"CALL","to":"` + staking.Hex() + `","input":"0x0102"},` +
+		`{"type":"CALL","to":"0x1000000000000000000000000000000000000002","input":"0x","calls":[` +
+		`{"type":"CALL","to":"` + staking.Hex() + `","input":"0x03"}]}]}`
+	result, err := recorder.AddToCallTrace(json.RawMessage(trace))
+	require.NoError(t, err)
+
+	var frame struct {
+		Precompile *types.PrecompileCall `json:"precompile"`
+		Calls      []struct {
+			Precompile *types.PrecompileCall `json:"precompile"`
+			Calls      []struct {
+				Precompile *types.PrecompileCall `json:"precompile"`
+			} `json:"calls"`
+		} `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(result, &frame))
+	require.Nil(t, frame.Precompile)
+	require.Nil(t, frame.Calls[0].Precompile, "the input doesn't match")
+	require.Equal(t, "delegate", frame.Calls[1].Precompile.Method)
+	require.Len(t, frame.Calls[1].Precompile.Msgs, 1)
+	require.Equal(t, "reverted", frame.Calls[2].Calls[0].Precompile.Error)
+
+	// the results of the other tracers are kept as is
+	result, err = recorder.AddToCallTrace(json.RawMessage(`[1,2]`))
+	require.NoError(t, err)
+	require.JSONEq(t, `[1,2]`, string(result))
+}
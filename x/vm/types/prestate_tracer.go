@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// PrestateTracerName is the name of the native prestate tracer.
+	PrestateTracerName = "prestateTracer"
+	// MuxTracerName is the name of the native tracer running several tracers.
+	MuxTracerName = "muxTracer"
+)
+
+// PrestateTracerConfig is the configuration of the prestate tracer.
+type PrestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"`
+}
+
+// BalanceChange is the balance of an account before and after a transaction,
+// in the 18 decimals representation of the EVM coin.
+type BalanceChange struct {
+	Pre  *big.Int
+	Post *big.Int
+}
+
+// prestateDiff is the result of the prestate tracer in diff mode, whose
+// account fields are kept as is.
+type prestateDiff struct {
+	Pre  map[common.Address]map[string]json.RawMessage `json:"pre"`
+	Post map[common.Address]map[string]json.RawMessage `json:"post"`
+}
+
+// PrestateDiffAddresses returns the addresses of the accounts of the result of
+// the prestate tracer in diff mode.
+func PrestateDiffAddresses(result json.RawMessage) []common.Address {
+	var diff prestateDiff
+	if json.Unmarshal(result, &diff) != nil {
+		return nil
+	}
+	addrs := make([]common.Address, 0, len(diff.Pre)+len(diff.Post))
+	for addr := range diff.Pre {
+		addrs = append(addrs, addr)
+	}
+	for addr := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+// SetPrestateDiffBalances replaces the balances of the result of the prestate
+// tracer in diff mode, which only reflect the EVM state, with the given
+// balances. The accounts whose balance changed are added to the diff, and the
+// balance changes that didn't happen are removed from it.
+func SetPrestateDiffBalances(result json.RawMessage, balances map[common.Address]BalanceChange) (json.RawMessage, error) {
+	var diff prestateDiff
+	// the results of the other tracers are kept as is
+	if json.Unmarshal(result, &diff) != nil {
+		return result, nil
+	}
+	if diff.Pre == nil {
+		diff.Pre = make(map[common.Address]map[string]json.RawMessage)
+	}
+	if diff.Post == nil {
+		diff.Post = make(map[common.Address]map[string]json.RawMessage)
+	}
+
+	for addr, balance := range balances {
+		pre, post := balance.Pre, balance.Post
+		if pre == nil {
+			pre = new(big.Int)
+		}
+		if post == nil {
+			post = new(big.Int)
+		}
+
+		if pre.Cmp(post) == 0 {
+			postAcc, ok := diff.Post[addr]
+			if !ok {
+				continue
+			}
+			delete(postAcc, "balance")
+			// the account is only in the diff because of its balance
+			if len(postAcc) == 0 {
+				delete(diff.Post, addr)
+				delete(diff.Pre, addr)
+			}
+			continue
+		}
+
+		preBz, err := json.Marshal((*hexutil.Big)(pre))
+		if err != nil {
+			return nil, err
+		}
+		postBz, err := json.Marshal((*hexutil.Big)(post))
+		if err != nil {
+			return nil, err
+		}
+		if diff.Pre[addr] == nil {
+			diff.Pre[addr] = make(map[string]json.RawMessage)
+		}
+		if diff.Post[addr] == nil {
+			diff.Post[addr] = make(map[string]json.RawMessage)
+		}
+		diff.Pre[addr]["balance"] = preBz
+		diff.Post[addr]["balance"] = postBz
+	}
+
+	return json.Marshal(diff)
+}
+
+// MuxTracerConfig returns the config of the given tracer within the config of
+// the mux tracer, or nil if the mux tracer doesn't run it.
+func MuxTracerConfig(config string, name string) json.RawMessage {
+	var configs map[string]json.RawMessage
+	if json.Unmarshal([]byte(config), &configs) != nil {
+		return nil
+	}
+	return configs[name]
+}
+
+// MuxTracerResult returns the result of the given tracer within the result of
+// the mux tracer.
+func MuxTracerResult(result json.RawMessage, name string) (json.RawMessage, bool) {
+	var results map[string]json.RawMessage
+	if json.Unmarshal(result, &results) != nil {
+		return nil, false
+	}
+	res, ok := results[name]
+	return res, ok
+}
+
+// SetMuxTracerResult replaces the result of the given tracer within the result
+// of the mux tracer.
+func SetMuxTracerResult(result json.RawMessage, name string, res json.RawMessage) (json.RawMessage, error) {
+	var results map[string]json.RawMessage
+	if err := json.Unmarshal(result, &results); err != nil {
+		return nil, err
+	}
+	results[name] = res
+	return json.Marshal(results)
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestSetPrestateDiffBalances(t *testing.T) {
+	var (
+		sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		contract  = common.HexToAddress("0x1000000000000000000000000000000000000002")
+		validator = common.HexToAddress("0x1000000000000000000000000000000000000003")
+		unchanged = common.HexToAddress("0x1000000000000000000000000000000000000004")
+	)
+	result := `{"pre":{` +
+		`"` + sender.Hex() + `":{"balance":"0x64","nonce":1},` +
+		`"` + contract.Hex() + `":{"balance":"0x0","storage":{"0x01":"0x02"}},` +
+		`"` + unchanged.Hex() + `":{"balance":"0xa"}},` +
+		`"post":{` +
+		`"` + sender.Hex() + `":{"balance":"0x5a","nonce":2},` +
+		`"` + contract.Hex() + `":{"storage":{"0x01":"0x03"}},` +
+		`"` + unchanged.Hex() + `":{"balance":"0x14"}}}`
+	require.ElementsMatch(t, []common.Address{sender, contract, unchanged}, types.PrestateDiffAddresses(json.RawMessage(result)))
+
+	diff, err := types.SetPrestateDiffBalances(json.RawMessage(result), map[common.Address]types.BalanceChange{
+		// fees deducted
+		sender: {Pre: big.NewInt(100), Post: big.NewInt(80)},
+		// the contract balance is unchanged
+		contract: {Pre: big.NewInt(0), Post: big.NewInt(0)},
+		// account only touched by a bank transfer of a precompile
+		validator: {Pre: big.NewInt(5), Post: big.NewInt(15)},
+		// the EVM balance change was reverted on the Cosmos side
+		unchanged: {Pre: big.NewInt(10), Post: big.NewInt(10)},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"pre":{`+
+		`"`+jsonKey(sender)+`":{"balance":"0x64","nonce":1},`+
+		`"`+jsonKey(contract)+`":{"balance":"0x0","storage":{"0x01":"0x02"}},`+
+		`"`+jsonKey(validator)+`":{"balance":"0x5"}},`+
+		`"post":{`+
+		`"`+jsonKey(sender)+`":{"balance":"0x50","nonce":2},`+
+		`"`+jsonKey(contract)+`":{"storage":{"0x01":"0x03"}},`+
+		`"`+jsonKey(validator)+`":{"balance":"0xf"}}}`, string(diff))
+
+	// the results of the other tracers are kept as is
+	diff, err = types.SetPrestateDiffBalances(json.RawMessage(`[1,2]`), nil)
+	require.NoError(t, err)
+	require.JSONEq(t, `[1,2]`, string(diff))
+}
+
+// jsonKey returns the address as encoded in JSON map keys.
+func jsonKey(addr common.Address) string {
+	bz, _ := addr.MarshalText()
+	return string(bz)
+}
+
+func TestMuxTracerResult(t *testing.T) {
+	config := `{"flatCallTracer":{"convertParityErrors":true},"prestateTracer":{"diffMode":true}}`
+	require.JSONEq(t, `{"diffMode":true}`, string(types.MuxTracerConfig(config, types.PrestateTracerName)))
+	require.Nil(t, types.MuxTracerConfig(config, "callTracer"))
+	require.Nil(t, types.MuxTracerConfig(`{"diffMode":true}`, types.PrestateTracerName))
+
+	result := json.RawMessage(`{"flatCallTracer":[{"type":"call"}],"prestateTracer":{"pre":{},"post":{}}}`)
+	diff, ok := types.MuxTracerResult(result, types.PrestateTracerName)
+	require.True(t, ok)
+	require.JSONEq(t, `{"pre":{},"post":{}}`, string(diff))
+	_, ok = types.MuxTracerResult(result, "callTracer")
+	require.False(t, ok)
+
+	// the results of the other tracers are kept as is
+	result, err := types.SetMuxTracerResult(result, types.PrestateTracerName, json.RawMessage(`{"pre":{"0x01":{}},"post":{}}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"flatCallTracer":[{"type":"call"}],"prestateTracer":{"pre":{"0x01":{}},"post":{}}}`, string(result))
+}
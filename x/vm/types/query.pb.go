@@ -692,6 +692,282 @@ func (m *QueryCodeResponse) GetCode() []byte {
 	return nil
 }
 
+// QueryStorageRangeRequest is the request type for the Query/StorageRange RPC
+// method.
+type QueryStorageRangeRequest struct {
+	// address is the ethereum hex address to query the storage state for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request, the key is the
+	// storage slot key to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageRangeRequest) Reset()         { *m = QueryStorageRangeRequest{} }
+func (m *QueryStorageRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeRequest) ProtoMessage()    {}
+func (*QueryStorageRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+}
+func (m *QueryStorageRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeRequest.Merge(m, src)
+}
+func (m *QueryStorageRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRangeRequest proto.InternalMessageInfo
+
+// QueryStorageRangeResponse is the response type for the Query/StorageRange
+// RPC method.
+type QueryStorageRangeResponse struct {
+	// storage defines the storage slots of the contract.
+	Storage Storage `protobuf:"bytes,1,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageRangeResponse) Reset()         { *m = QueryStorageRangeResponse{} }
+func (m *QueryStorageRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRangeResponse) ProtoMessage()    {}
+func (*QueryStorageRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+}
+func (m *QueryStorageRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRangeResponse.Merge(m, src)
+}
+func (m *QueryStorageRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRangeResponse proto.InternalMessageInfo
+
+func (m *QueryStorageRangeResponse) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *QueryStorageRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContractsRequest is the request type for the Query/Contracts RPC
+// method.
+type QueryContractsRequest struct {
+	// pagination defines an optional pagination for the request, the key is the
+	// contract address to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsRequest) Reset()         { *m = QueryContractsRequest{} }
+func (m *QueryContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsRequest) ProtoMessage()    {}
+func (*QueryContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+}
+func (m *QueryContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsRequest.Merge(m, src)
+}
+func (m *QueryContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsRequest proto.InternalMessageInfo
+
+func (m *QueryContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ContractAccount defines the state of an account holding code.
+type ContractAccount struct {
+	// address is the ethereum hex address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the EVM denomination.
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// nonce is the account's sequence number.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// code_hash is the hex hash of the account's code.
+	CodeHash string `protobuf:"bytes,4,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *ContractAccount) Reset()         { *m = ContractAccount{} }
+func (m *ContractAccount) String() string { return proto.CompactTextString(m) }
+func (*ContractAccount) ProtoMessage()    {}
+func (*ContractAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+}
+func (m *ContractAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAccount.Merge(m, src)
+}
+func (m *ContractAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAccount proto.InternalMessageInfo
+
+func (m *ContractAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *ContractAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ContractAccount) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// QueryContractsResponse is the response type for the Query/Contracts RPC
+// method.
+type QueryContractsResponse struct {
+	// contracts defines the accounts holding code.
+	Contracts []ContractAccount `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractsResponse) Reset()         { *m = QueryContractsResponse{} }
+func (m *QueryContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractsResponse) ProtoMessage()    {}
+func (*QueryContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+}
+func (m *QueryContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractsResponse.Merge(m, src)
+}
+func (m *QueryContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractsResponse proto.InternalMessageInfo
+
+func (m *QueryContractsResponse) GetContracts() []ContractAccount {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *QueryContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTxLogsRequest is the request type for the Query/TxLogs RPC method.
 type QueryTxLogsRequest struct {
 	// hash is the ethereum transaction hex hash to query the logs for.
@@ -704,7 +980,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +1021,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +1072,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1111,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1165,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1244,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1018,41 +1294,33 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
-// QueryTraceTxRequest defines TraceTx request
-type QueryTraceTxRequest struct {
-	// msg is the MsgEthereumTx for the requested transaction
-	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
-	// trace_config holds extra parameters to trace functions.
-	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
-	// predecessors is an array of transactions included in the same block
-	// need to be replayed first to get correct context for tracing.
-	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
-	// block_number of requested transaction
-	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
-	// block_hash of requested transaction
-	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	// block_time of requested transaction
-	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
-	// proposer_address is the proposer of the requested block
-	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
-	// chain_id is the eip155 chain id parsed from the requested block header
-	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// block_max_gas of the block of the requested transaction
-	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts uses the same json format as the `eth_simulateV1` rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the default gas cap to be used for each call
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the base block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the base block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_hash (hex) of the base block, used as the parent of the first
+	// simulated block
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
-func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTraceTxRequest) ProtoMessage()    {}
-func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
-func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1062,69 +1330,225 @@ func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
 }
-func (m *QueryTraceTxRequest) XXX_Size() int {
+func (m *SimulateV1Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
 
-func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+func (m *SimulateV1Request) GetOpts() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Opts
 	}
 	return nil
 }
 
-func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+func (m *SimulateV1Request) GetGasCap() uint64 {
 	if m != nil {
-		return m.TraceConfig
+		return m.GasCap
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
 	if m != nil {
-		return m.Predecessors
+		return m.ProposerAddress
 	}
 	return nil
 }
 
-func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+func (m *SimulateV1Request) GetChainId() int64 {
 	if m != nil {
-		return m.BlockNumber
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *QueryTraceTxRequest) GetBlockHash() string {
+func (m *SimulateV1Request) GetBlockHash() string {
 	if m != nil {
 		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *QueryTraceTxRequest) GetBlockTime() time.Time {
-	if m != nil {
-		return m.BlockTime
-	}
-	return time.Time{}
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// data is the json encoded list of simulated blocks
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// error_code is the json-rpc error code of the failure that aborted the
+	// simulation, if any
+	ErrorCode int64 `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// error is the reason the simulation was aborted, if any
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryTraceTxRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
-	if m != nil {
-		return m.ProposerAddress
-	}
-	return nil
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
 }
-
-func (m *QueryTraceTxRequest) GetChainId() int64 {
-	if m != nil {
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SimulateV1Response) GetErrorCode() int64 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *SimulateV1Response) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QueryTraceTxRequest defines TraceTx request
+type QueryTraceTxRequest struct {
+	// msg is the MsgEthereumTx for the requested transaction
+	Msg *MsgEthereumTx `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,3,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// predecessors is an array of transactions included in the same block
+	// need to be replayed first to get correct context for tracing.
+	Predecessors []*MsgEthereumTx `protobuf:"bytes,4,rep,name=predecessors,proto3" json:"predecessors,omitempty"`
+	// block_number of requested transaction
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_hash of requested transaction
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// block_time of requested transaction
+	BlockTime time.Time `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// proposer_address is the proposer of the requested block
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_max_gas of the block of the requested transaction
+	BlockMaxGas int64 `protobuf:"varint,10,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+}
+
+func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
+func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceTxRequest) ProtoMessage()    {}
+func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+}
+func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceTxRequest.Merge(m, src)
+}
+func (m *QueryTraceTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceTxRequest proto.InternalMessageInfo
+
+func (m *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetPredecessors() []*MsgEthereumTx {
+	if m != nil {
+		return m.Predecessors
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTraceTxRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QueryTraceTxRequest) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryTraceTxRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetChainId() int64 {
+	if m != nil {
 		return m.ChainId
 	}
 	return 0
@@ -1147,7 +1571,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1631,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +1726,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1786,7 @@ func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
 func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallRequest) ProtoMessage()    {}
 func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
 }
 func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1881,7 @@ func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{}
 func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallResponse) ProtoMessage()    {}
 func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
 }
 func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1926,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1965,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{34}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2003,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{35}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +2042,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{36}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,6 +2071,157 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 
+// QueryTxStatusRequest is the request type for the Query/TxStatus RPC method.
+type QueryTxStatusRequest struct {
+	// hash is the ethereum hex hash of the transaction.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryTxStatusRequest) Reset()         { *m = QueryTxStatusRequest{} }
+func (m *QueryTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTxStatusRequest) ProtoMessage()    {}
+func (*QueryTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{37}
+}
+func (m *QueryTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxStatusRequest.Merge(m, src)
+}
+func (m *QueryTxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxStatusRequest proto.InternalMessageInfo
+
+func (m *QueryTxStatusRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// QueryTxStatusResponse is the response type for the Query/TxStatus RPC
+// method.
+type QueryTxStatusResponse struct {
+	// status is the stage of its lifecycle the transaction is in: "pending",
+	// "queued", "underpriced", "replaced", "evicted", "included" or "unknown".
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// nonce is the nonce of the transaction, if it's in the mempool.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// expected_nonce is the nonce of the next transaction of the sender the
+	// mempool expects, if the transaction is queued.
+	ExpectedNonce uint64 `protobuf:"varint,3,opt,name=expected_nonce,json=expectedNonce,proto3" json:"expected_nonce,omitempty"`
+	// base_fee is the current base fee, if the transaction is underpriced.
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+	// replaced_by is the hash of the transaction that replaced it.
+	ReplacedBy string `protobuf:"bytes,5,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	// reason is why the transaction was evicted.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block_number is the number of the block the transaction was included in.
+	BlockNumber uint64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// removed_at is when the transaction exited the mempool.
+	RemovedAt *time.Time `protobuf:"bytes,8,opt,name=removed_at,json=removedAt,proto3,stdtime" json:"removed_at,omitempty"`
+}
+
+func (m *QueryTxStatusResponse) Reset()         { *m = QueryTxStatusResponse{} }
+func (m *QueryTxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTxStatusResponse) ProtoMessage()    {}
+func (*QueryTxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{38}
+}
+func (m *QueryTxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTxStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTxStatusResponse.Merge(m, src)
+}
+func (m *QueryTxStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTxStatusResponse proto.InternalMessageInfo
+
+func (m *QueryTxStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryTxStatusResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *QueryTxStatusResponse) GetExpectedNonce() uint64 {
+	if m != nil {
+		return m.ExpectedNonce
+	}
+	return 0
+}
+
+func (m *QueryTxStatusResponse) GetReplacedBy() string {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return ""
+}
+
+func (m *QueryTxStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QueryTxStatusResponse) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QueryTxStatusResponse) GetRemovedAt() *time.Time {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
@@ -1662,12 +2237,19 @@ func init() {
 	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryStorageRangeRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRangeRequest")
+	proto.RegisterType((*QueryStorageRangeResponse)(nil), "cosmos.evm.vm.v1.QueryStorageRangeResponse")
+	proto.RegisterType((*QueryContractsRequest)(nil), "cosmos.evm.vm.v1.QueryContractsRequest")
+	proto.RegisterType((*ContractAccount)(nil), "cosmos.evm.vm.v1.ContractAccount")
+	proto.RegisterType((*QueryContractsResponse)(nil), "cosmos.evm.vm.v1.QueryContractsResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "cosmos.evm.vm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "cosmos.evm.vm.v1.SimulateV1Response")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
@@ -1678,120 +2260,147 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+	proto.RegisterType((*QueryTxStatusRequest)(nil), "cosmos.evm.vm.v1.QueryTxStatusRequest")
+	proto.RegisterType((*QueryTxStatusResponse)(nil), "cosmos.evm.vm.v1.QueryTxStatusResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0x76, 0xa2, 0x4c, 0xec, 0x8d, 0xcc, 0xb5, 0x25, 0x87, 0xf1,
-	0x77, 0x1c, 0x32, 0xf6, 0x66, 0x17, 0xd8, 0xec, 0x61, 0xd7, 0x36, 0x1c, 0x27, 0x9b, 0x64, 0x91,
-	0x55, 0x8d, 0x1e, 0x0a, 0x14, 0xc2, 0x88, 0x9a, 0x50, 0x84, 0x45, 0x52, 0xe1, 0x50, 0xaa, 0x9c,
-	0x8f, 0x1e, 0x8a, 0x36, 0x4d, 0x90, 0x4b, 0x8a, 0xde, 0xdb, 0x1c, 0x7b, 0x6b, 0x6f, 0xfd, 0x17,
-	0x72, 0x0c, 0x50, 0x14, 0x28, 0x7a, 0x48, 0x8b, 0xa4, 0x40, 0xfb, 0x37, 0xf4, 0x54, 0xcc, 0x07,
-	0x25, 0x52, 0x14, 0x25, 0xa7, 0x48, 0xd1, 0x1e, 0x0a, 0x18, 0x09, 0x67, 0xe6, 0xcd, 0x7b, 0xbf,
-	0x79, 0xdf, 0x4f, 0x60, 0x56, 0x77, 0x88, 0xe5, 0x10, 0x0d, 0xb7, 0x2c, 0x8d, 0xfe, 0x6d, 0x68,
-	0xb7, 0x9a, 0xd8, 0x3d, 0x54, 0x1b, 0xae, 0xe3, 0x39, 0x30, 0xc7, 0x4f, 0x55, 0xdc, 0xb2, 0x54,
-	0xfa, 0xb7, 0x21, 0x9f, 0x40, 0x96, 0x69, 0x3b, 0x1a, 0xfb, 0x97, 0x13, 0xc9, 0x6b, 0x82, 0x45,
-	0x05, 0x11, 0xcc, 0x6f, 0x6b, 0xad, 0x8d, 0x0a, 0xf6, 0xd0, 0x86, 0xd6, 0x40, 0x86, 0x69, 0x23,
-	0xcf, 0x74, 0x6c, 0x41, 0x2b, 0x47, 0xc4, 0x51, 0xd6, 0xfc, 0x6c, 0x26, 0x72, 0xe6, 0xb5, 0xc5,
-	0xd1, 0x94, 0xe1, 0x18, 0x0e, 0xfb, 0xd4, 0xe8, 0x97, 0xd8, 0x9d, 0x35, 0x1c, 0xc7, 0xa8, 0x63,
-	0x0d, 0x35, 0x4c, 0x0d, 0xd9, 0xb6, 0xe3, 0x31, 0x49, 0x44, 0x9c, 0x16, 0xc5, 0x29, 0x5b, 0x55,
-	0x9a, 0x37, 0x35, 0xcf, 0xb4, 0x30, 0xf1, 0x90, 0xd5, 0xe0, 0x04, 0xca, 0x14, 0x80, 0xff, 0xa7,
-	0x68, 0x77, 0x1c, 0xfb, 0xa6, 0x69, 0x94, 0xf0, 0xad, 0x26, 0x26, 0x9e, 0x72, 0x0d, 0x9c, 0x0c,
-	0xed, 0x92, 0x86, 0x63, 0x13, 0x0c, 0xff, 0x0e, 0xc6, 0x75, 0xb6, 0x93, 0x97, 0xe6, 0xa5, 0x95,
-	0xec, 0xe6, 0x9c, 0xda, 0xab, 0x1a, 0x75, 0xa7, 0x86, 0x4c, 0x5b, 0x5c, 0x13, 0xc4, 0xca, 0x3f,
-	0x05, 0xb7, 0x2d, 0x5d, 0x77, 0x9a, 0xb6, 0x27, 0x84, 0xc0, 0x3c, 0x48, 0xa1, 0x6a, 0xd5, 0xc5,
-	0x84, 0x30, 0x76, 0x99, 0x92, 0xbf, 0xbc, 0x98, 0x7e, 0xf0, 0xa4, 0x38, 0xf2, 0xd3, 0x93, 0xe2,
-	0x88, 0xa2, 0x83, 0xa9, 0xf0, 0x55, 0x81, 0x24, 0x0f, 0x52, 0x15, 0x54, 0x47, 0xb6, 0x8e, 0xfd,
-	0xbb, 0x62, 0x09, 0xff, 0x0a, 0x32, 0xba, 0x53, 0xc5, 0xe5, 0x1a, 0x22, 0xb5, 0xfc, 0x28, 0x3b,
-	0x4b, 0xd3, 0x8d, 0xcb, 0x88, 0xd4, 0xe0, 0x14, 0x18, 0xb3, 0x1d, 0x7a, 0x29, 0x31, 0x2f, 0xad,
-	0x24, 0x4b, 0x7c, 0xa1, 0xfc, 0x1b, 0xcc, 0x88, 0xd7, 0xd2, 0xc7, 0xfc, 0x0a, 0x94, 0xf7, 0x25,
-	0x20, 0xf7, 0xe3, 0x20, 0xc0, 0x2e, 0x82, 0x63, 0x5c, 0x4f, 0xe5, 0x30, 0xa7, 0x49, 0xbe, 0xbb,
-	0xc5, 0x37, 0xa1, 0x0c, 0xd2, 0x84, 0x0a, 0xa5, 0xf8, 0x46, 0x19, 0xbe, 0xce, 0x9a, 0xb2, 0x40,
-	0x9c, 0x6b, 0xd9, 0x6e, 0x5a, 0x15, 0xec, 0x8a, 0x17, 0x4c, 0x8a, 0xdd, 0xff, 0xb1, 0x4d, 0xe5,
-	0x2a, 0x98, 0x65, 0x38, 0xde, 0x44, 0x75, 0xb3, 0x8a, 0x3c, 0xc7, 0xed, 0x79, 0xcc, 0x69, 0x30,
-	0xa1, 0x3b, 0x76, 0x2f, 0x8e, 0x2c, 0xdd, 0xdb, 0x8a, 0xbc, 0xea, 0x91, 0x04, 0xe6, 0x62, 0xb8,
-	0x89, 0x87, 0x2d, 0x83, 0xe3, 0x3e, 0xaa, 0x30, 0x47, 0x1f, 0xec, 0x6b, 0x7c, 0x9a, 0xef, 0x44,
-	0xdb, 0xdc, 0xce, 0xaf, 0x62, 0x9e, 0xf3, 0xc2, 0x89, 0x3a, 0x57, 0x87, 0x39, 0x91, 0x72, 0x55,
-	0x08, 0x7b, 0xc3, 0x73, 0x5c, 0x64, 0x0c, 0x17, 0x06, 0x73, 0x20, 0x71, 0x80, 0x0f, 0x85, 0xbf,
-	0xd1, 0xcf, 0x80, 0xf8, 0x75, 0x21, 0xbe, 0xc3, 0x4c, 0x88, 0x9f, 0x02, 0x63, 0x2d, 0x54, 0x6f,
-	0xfa, 0xc2, 0xf9, 0x42, 0xf9, 0x07, 0xc8, 0x09, 0x57, 0xaa, 0xbe, 0xd2, 0x23, 0x97, 0xc1, 0x89,
-	0xc0, 0x3d, 0x21, 0x02, 0x82, 0x24, 0xf5, 0x7d, 0x76, 0x6b, 0xa2, 0xc4, 0xbe, 0x95, 0xdb, 0x22,
-	0xe2, 0xf7, 0xdb, 0xd7, 0x1c, 0x83, 0xf8, 0x22, 0x20, 0x48, 0xb2, 0x88, 0xe1, 0xfc, 0xd9, 0x37,
-	0xbc, 0x04, 0x40, 0x37, 0x77, 0xb1, 0xb7, 0x65, 0x37, 0x97, 0xfc, 0x90, 0xa7, 0x89, 0x4e, 0xe5,
-	0x69, 0x52, 0x24, 0x3a, 0xf5, 0x46, 0x57, 0x55, 0xa5, 0xc0, 0xcd, 0x00, 0xc8, 0x87, 0x92, 0x50,
-	0xac, 0x2f, 0x5c, 0xe0, 0x5c, 0x05, 0xc9, 0xba, 0x63, 0xd0, 0xd7, 0x25, 0x56, 0xb2, 0x9b, 0xd3,
-	0xd1, 0xb4, 0x72, 0xcd, 0x31, 0x4a, 0x8c, 0x04, 0xee, 0xf5, 0x01, 0xb5, 0x3c, 0x14, 0x14, 0x97,
-	0x13, 0x44, 0xd5, 0xc9, 0x7c, 0x37, 0x90, 0x8b, 0x2c, 0x5f, 0x0f, 0x4a, 0x49, 0x00, 0xf4, 0x77,
-	0x05, 0xc0, 0x7f, 0x81, 0xf1, 0x06, 0xdb, 0x11, 0x99, 0x2f, 0x1f, 0x85, 0xc8, 0x6f, 0x6c, 0x67,
-	0x9e, 0x3e, 0x2f, 0x8e, 0x7c, 0xf6, 0xe3, 0x17, 0x6b, 0x52, 0x49, 0x5c, 0x51, 0xbe, 0x96, 0xc0,
-	0xb1, 0x5d, 0xaf, 0xb6, 0x83, 0xea, 0xf5, 0x80, 0xba, 0x91, 0x6b, 0x10, 0xdf, 0x30, 0xf4, 0x1b,
-	0x9e, 0x02, 0x29, 0x03, 0x91, 0xb2, 0x8e, 0x1a, 0x22, 0x46, 0xc6, 0x0d, 0x44, 0x76, 0x50, 0x03,
-	0xbe, 0x0d, 0x72, 0x0d, 0xd7, 0x69, 0x38, 0x04, 0xbb, 0x9d, 0x38, 0xa3, 0x31, 0x32, 0xb1, 0xbd,
-	0xf9, 0xf3, 0xf3, 0xa2, 0x6a, 0x98, 0x5e, 0xad, 0x59, 0x51, 0x75, 0xc7, 0xd2, 0x44, 0xf1, 0xe0,
-	0xff, 0x9d, 0x23, 0xd5, 0x03, 0xcd, 0x3b, 0x6c, 0x60, 0xa2, 0xee, 0x74, 0x03, 0xbc, 0x74, 0xdc,
-	0xe7, 0xe5, 0x07, 0xe7, 0x0c, 0x48, 0xeb, 0x34, 0x6b, 0x97, 0xcd, 0x6a, 0x3e, 0x39, 0x2f, 0xad,
-	0x24, 0x4a, 0x29, 0xb6, 0xbe, 0x52, 0x85, 0xb3, 0x20, 0xe3, 0xb4, 0xb0, 0xeb, 0x9a, 0x55, 0x4c,
-	0xf2, 0x63, 0x0c, 0x6b, 0x77, 0x43, 0xd9, 0x07, 0x27, 0x77, 0x89, 0x67, 0x5a, 0xc8, 0xc3, 0x7b,
-	0xa8, 0xab, 0xab, 0x1c, 0x48, 0x18, 0x88, 0x3f, 0x2d, 0x59, 0xa2, 0x9f, 0x74, 0xc7, 0xc5, 0x1e,
-	0x7b, 0xd5, 0x44, 0x89, 0x7e, 0x52, 0x99, 0x2d, 0xab, 0x8c, 0x5d, 0xd7, 0xe1, 0xe1, 0x9e, 0x29,
-	0xa5, 0x5a, 0xd6, 0x2e, 0x5d, 0x2a, 0x0f, 0x93, 0xbe, 0x8f, 0xb8, 0x48, 0xc7, 0xfb, 0x6d, 0x5f,
-	0x65, 0x1b, 0x20, 0x61, 0x11, 0xbf, 0xf2, 0x14, 0xa3, 0xfa, 0xbf, 0x4e, 0x8c, 0x5d, 0xaf, 0x86,
-	0x5d, 0xdc, 0xb4, 0xf6, 0xdb, 0x25, 0x4a, 0x0b, 0xff, 0x03, 0x26, 0x3c, 0xca, 0xa4, 0x2c, 0xaa,
-	0x56, 0x22, 0xae, 0x6a, 0x31, 0x51, 0xa2, 0x6a, 0x65, 0xbd, 0xee, 0x02, 0xee, 0x80, 0x89, 0x86,
-	0x8b, 0xab, 0x58, 0xc7, 0x84, 0x38, 0x2e, 0xc9, 0x27, 0x99, 0x83, 0x0e, 0x95, 0x1e, 0xba, 0x44,
-	0xb3, 0x6e, 0xa5, 0xee, 0xe8, 0x07, 0x7e, 0x7e, 0x1b, 0x63, 0x4a, 0xce, 0xb2, 0x3d, 0x9e, 0xdd,
-	0xe0, 0x1c, 0x00, 0x9c, 0x84, 0x05, 0xe1, 0x38, 0xd3, 0x48, 0x86, 0xed, 0xb0, 0xba, 0x75, 0xd9,
-	0x3f, 0xa6, 0xe5, 0x3b, 0x9f, 0x62, 0xcf, 0x90, 0x55, 0x5e, 0xdb, 0x55, 0xbf, 0xb6, 0xab, 0xfb,
-	0x7e, 0x6d, 0xdf, 0x9e, 0xa4, 0x4e, 0xf8, 0xf8, 0xbb, 0xa2, 0xc4, 0x1d, 0x91, 0x73, 0xa2, 0xc7,
-	0x7d, 0x7d, 0x29, 0xfd, 0xdb, 0xf8, 0x52, 0x26, 0xec, 0x4b, 0x0a, 0x98, 0xe4, 0x6f, 0xb0, 0x50,
-	0xbb, 0x4c, 0x1d, 0x04, 0x04, 0xd4, 0x70, 0x1d, 0xb5, 0xf7, 0x10, 0xf9, 0x6f, 0x32, 0x3d, 0x9a,
-	0x4b, 0x94, 0xd2, 0x5e, 0xbb, 0x6c, 0xda, 0x55, 0xdc, 0x56, 0xd6, 0x44, 0xea, 0xec, 0xb8, 0x42,
-	0x37, 0xaf, 0x55, 0x91, 0x87, 0xfc, 0xf0, 0xa1, 0xdf, 0xca, 0x97, 0x09, 0xf0, 0x97, 0x2e, 0xf1,
-	0x36, 0xe5, 0x1a, 0x70, 0x1d, 0xaf, 0xed, 0x67, 0x97, 0xe1, 0xae, 0xe3, 0xb5, 0xc9, 0x6b, 0x70,
-	0x9d, 0x3f, 0xad, 0x7e, 0x44, 0xab, 0x2b, 0xe7, 0xc0, 0xa9, 0x88, 0xe1, 0x06, 0x18, 0xfa, 0xc3,
-	0x04, 0x98, 0xee, 0xd2, 0xff, 0x51, 0xb3, 0x6a, 0xaf, 0x03, 0x25, 0x7f, 0x07, 0x07, 0xda, 0x79,
-	0x45, 0x07, 0x4a, 0xfb, 0x0e, 0x14, 0xf4, 0x9d, 0xa0, 0x71, 0xd3, 0x21, 0xe3, 0x2a, 0xeb, 0xc1,
-	0x88, 0xe3, 0x86, 0x18, 0x60, 0xb7, 0xe9, 0x4e, 0x07, 0x47, 0xf0, 0x25, 0x8c, 0xbb, 0xb3, 0xc6,
-	0x54, 0x78, 0x5b, 0xb0, 0xb8, 0x00, 0xd2, 0xb4, 0x9c, 0x97, 0x6f, 0x62, 0xd1, 0x21, 0x6d, 0xcf,
-	0x7c, 0xfb, 0xbc, 0x38, 0xcd, 0xf5, 0x47, 0xaa, 0x07, 0xaa, 0xe9, 0x68, 0x16, 0xf2, 0x6a, 0xea,
-	0x15, 0xdb, 0xa3, 0x9d, 0x1b, 0xbb, 0xad, 0x14, 0x45, 0xcf, 0xba, 0x57, 0x77, 0x2a, 0xa8, 0x7e,
-	0xdd, 0xb4, 0xf7, 0x10, 0xb9, 0xe1, 0x9a, 0x9d, 0x86, 0x51, 0xd1, 0x41, 0x21, 0x8e, 0x40, 0x08,
-	0xde, 0x02, 0x93, 0x96, 0x69, 0x53, 0x67, 0x2d, 0x37, 0xe8, 0x81, 0x90, 0x3e, 0x47, 0x95, 0x13,
-	0x8f, 0x20, 0x6b, 0x75, 0x59, 0x6d, 0x7e, 0x94, 0x03, 0x63, 0x4c, 0x0a, 0xfc, 0x40, 0x02, 0x29,
-	0xd1, 0x36, 0xc3, 0xc5, 0xa8, 0xf1, 0xfb, 0xcc, 0x45, 0xf2, 0xd2, 0x30, 0x32, 0x8e, 0x53, 0x39,
-	0xfb, 0xde, 0x57, 0x3f, 0x7c, 0x3c, 0xba, 0x08, 0xcf, 0x68, 0x91, 0x99, 0x51, 0xb4, 0xce, 0xda,
-	0x1d, 0xe1, 0xd8, 0xf7, 0xe0, 0x27, 0x12, 0x98, 0x0c, 0x4d, 0x27, 0xf0, 0x6c, 0x8c, 0x98, 0x7e,
-	0x53, 0x90, 0xbc, 0x7e, 0x34, 0x62, 0x81, 0x6c, 0x93, 0x21, 0x5b, 0x87, 0x6b, 0x51, 0x64, 0xfe,
-	0x20, 0x14, 0x01, 0xf8, 0xb9, 0x04, 0x72, 0xbd, 0x83, 0x06, 0x54, 0x63, 0xc4, 0xc6, 0xcc, 0x37,
-	0xb2, 0x76, 0x64, 0x7a, 0x81, 0xf4, 0x22, 0x43, 0x7a, 0x01, 0x6e, 0x46, 0x91, 0xb6, 0xfc, 0x3b,
-	0x5d, 0xb0, 0xc1, 0xd9, 0xe9, 0x1e, 0xbc, 0x2f, 0x81, 0x94, 0x18, 0x29, 0x62, 0x4d, 0x1b, 0x9e,
-	0x56, 0x62, 0x4d, 0xdb, 0x33, 0x99, 0x28, 0xeb, 0x0c, 0xd6, 0x12, 0x5c, 0x88, 0xc2, 0x12, 0x23,
-	0x0a, 0x09, 0xa8, 0xee, 0x91, 0x04, 0x52, 0x62, 0xb8, 0x88, 0x05, 0x12, 0x9e, 0x64, 0x62, 0x81,
-	0xf4, 0xcc, 0x28, 0xca, 0x06, 0x03, 0x72, 0x16, 0xae, 0x46, 0x81, 0x10, 0x4e, 0xda, 0xc5, 0xa1,
-	0xdd, 0x39, 0xc0, 0x87, 0xf7, 0xe0, 0x6d, 0x90, 0xa4, 0x33, 0x08, 0x54, 0x62, 0x5d, 0xa6, 0x33,
-	0xd8, 0xc8, 0x67, 0x06, 0xd2, 0x08, 0x0c, 0xab, 0x0c, 0xc3, 0x19, 0x78, 0xba, 0x9f, 0x37, 0x55,
-	0x43, 0x9a, 0x78, 0x07, 0x8c, 0xf3, 0x36, 0x1c, 0x2e, 0xc4, 0x70, 0x0e, 0x75, 0xfb, 0xf2, 0xe2,
-	0x10, 0x2a, 0x81, 0x60, 0x9e, 0x21, 0x90, 0x61, 0x3e, 0x8a, 0x80, 0xb7, 0xf8, 0xb0, 0x0d, 0x52,
-	0xa2, 0xc3, 0x87, 0xf3, 0x51, 0x9e, 0xe1, 0xe6, 0x5f, 0x5e, 0x1e, 0xd6, 0x81, 0xf8, 0x72, 0x15,
-	0x26, 0x77, 0x16, 0xca, 0x51, 0xb9, 0xd8, 0xab, 0x95, 0x75, 0x2a, 0xee, 0x5d, 0x90, 0x0d, 0x34,
-	0xe1, 0x47, 0x90, 0xde, 0xe7, 0xcd, 0x7d, 0xba, 0x78, 0x65, 0x89, 0xc9, 0x9e, 0x87, 0x85, 0x3e,
-	0xb2, 0x05, 0x39, 0x4d, 0x91, 0xf0, 0x2e, 0x48, 0x89, 0xee, 0x2c, 0xd6, 0xf7, 0xc2, 0x8d, 0x7c,
-	0xac, 0xef, 0xf5, 0x34, 0x79, 0x83, 0x5e, 0xcf, 0x2b, 0xab, 0xd7, 0x86, 0x0f, 0x24, 0x00, 0xba,
-	0x6d, 0x03, 0x5c, 0x19, 0xc4, 0x3a, 0xd8, 0x12, 0xca, 0xab, 0x47, 0xa0, 0x14, 0x38, 0x16, 0x19,
-	0x8e, 0x22, 0x9c, 0x8b, 0xc3, 0xc1, 0x6a, 0x25, 0x7c, 0x5f, 0x02, 0x99, 0x4e, 0x21, 0x84, 0xcb,
-	0x83, 0xf8, 0x07, 0xcd, 0xb1, 0x32, 0x9c, 0x50, 0xe0, 0x58, 0x60, 0x38, 0x0a, 0x70, 0x36, 0x0e,
-	0x07, 0xf3, 0x87, 0xbb, 0x34, 0x29, 0xb1, 0x5a, 0x38, 0x20, 0x29, 0x05, 0x0b, 0xf0, 0x80, 0xa4,
-	0x14, 0x2a, 0xc8, 0x83, 0xec, 0xe1, 0x17, 0x6a, 0x1a, 0x80, 0xa2, 0x7b, 0x59, 0x88, 0x0d, 0xed,
-	0xc0, 0x0f, 0x8d, 0xb1, 0x01, 0x18, 0xfe, 0xe1, 0x71, 0x50, 0x00, 0xf2, 0xf6, 0x0a, 0x7e, 0x2a,
-	0x81, 0x13, 0x91, 0x92, 0x0e, 0xe3, 0xea, 0x41, 0x5c, 0x77, 0x20, 0x9f, 0x3f, 0xfa, 0x05, 0x01,
-	0x6d, 0x99, 0x41, 0x3b, 0x0d, 0x8b, 0x51, 0x68, 0xa1, 0x2e, 0x62, 0xfb, 0xe2, 0xd3, 0x17, 0x05,
-	0xe9, 0xd9, 0x8b, 0x82, 0xf4, 0xfd, 0x8b, 0x82, 0xf4, 0xf8, 0x65, 0x61, 0xe4, 0xd9, 0xcb, 0xc2,
-	0xc8, 0x37, 0x2f, 0x0b, 0x23, 0x6f, 0xcd, 0x47, 0x7b, 0x4d, 0xca, 0xa4, 0x4d, 0xd9, 0xb0, 0x4e,
-	0xb3, 0x32, 0xce, 0x9a, 0xb5, 0xbf, 0xfd, 0x12, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xe7, 0xce, 0x3f,
-	0xa9, 0x16, 0x00, 0x00,
+	// 2122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x48, 0x3e, 0x49, 0xb1, 0x3c, 0xa6, 0x6c, 0x6a, 0x23, 0x89, 0xf2, 0x5a,
+	0x5f, 0x96, 0x15, 0x32, 0x52, 0xd3, 0x02, 0x75, 0x0f, 0xa9, 0x24, 0x38, 0xca, 0x87, 0x1d, 0xb8,
+	0xb4, 0x90, 0x43, 0x81, 0x60, 0x31, 0x5c, 0x8e, 0x29, 0x42, 0xdc, 0x5d, 0x66, 0x77, 0xc9, 0x52,
+	0x71, 0x9c, 0x43, 0x3f, 0xf2, 0x81, 0x5c, 0x02, 0xf4, 0xd6, 0x43, 0x9b, 0x63, 0x51, 0xa0, 0x68,
+	0x6f, 0xbd, 0xb5, 0xd7, 0x1c, 0x03, 0x14, 0x05, 0xda, 0x1e, 0x9c, 0xc2, 0x2e, 0xd0, 0xfe, 0x0d,
+	0x3d, 0x15, 0x33, 0xf3, 0x96, 0xbb, 0xcb, 0xe5, 0x6a, 0xe9, 0xc0, 0x41, 0x73, 0x28, 0x40, 0xd8,
+	0x3b, 0x6f, 0xdf, 0xbc, 0xf7, 0x7b, 0x1f, 0xfb, 0xe6, 0xbd, 0x11, 0x2c, 0x19, 0xb6, 0x6b, 0xda,
+	0x6e, 0x95, 0xf5, 0xcc, 0x2a, 0xff, 0xed, 0x56, 0xdf, 0xe9, 0x32, 0xe7, 0xac, 0xd2, 0x71, 0x6c,
+	0xcf, 0x26, 0xf3, 0xf2, 0x6d, 0x85, 0xf5, 0xcc, 0x0a, 0xff, 0xed, 0xaa, 0x17, 0xa9, 0xd9, 0xb2,
+	0xec, 0xaa, 0xf8, 0x57, 0x32, 0xa9, 0xdb, 0x28, 0xa2, 0x4e, 0x5d, 0x26, 0x77, 0x57, 0x7b, 0xbb,
+	0x75, 0xe6, 0xd1, 0xdd, 0x6a, 0x87, 0x36, 0x5b, 0x16, 0xf5, 0x5a, 0xb6, 0x85, 0xbc, 0x6a, 0x4c,
+	0x1d, 0x17, 0x2d, 0xdf, 0x2d, 0xc6, 0xde, 0x79, 0x7d, 0x7c, 0x55, 0x6c, 0xda, 0x4d, 0x5b, 0x3c,
+	0x56, 0xf9, 0x13, 0x52, 0x97, 0x9a, 0xb6, 0xdd, 0x6c, 0xb3, 0x2a, 0xed, 0xb4, 0xaa, 0xd4, 0xb2,
+	0x6c, 0x4f, 0x68, 0x72, 0xf1, 0x6d, 0x19, 0xdf, 0x8a, 0x55, 0xbd, 0x7b, 0xbf, 0xea, 0xb5, 0x4c,
+	0xe6, 0x7a, 0xd4, 0xec, 0x48, 0x06, 0xad, 0x08, 0xe4, 0x07, 0x1c, 0xed, 0xa1, 0x6d, 0xdd, 0x6f,
+	0x35, 0x6b, 0xec, 0x9d, 0x2e, 0x73, 0x3d, 0xed, 0x36, 0x5c, 0x8a, 0x50, 0xdd, 0x8e, 0x6d, 0xb9,
+	0x8c, 0x7c, 0x1b, 0xa6, 0x0d, 0x41, 0x29, 0x29, 0xab, 0xca, 0xd6, 0xcc, 0xde, 0x72, 0x65, 0xd8,
+	0x35, 0x95, 0xc3, 0x13, 0xda, 0xb2, 0x70, 0x1b, 0x32, 0x6b, 0xdf, 0x45, 0x69, 0xfb, 0x86, 0x61,
+	0x77, 0x2d, 0x0f, 0x95, 0x90, 0x12, 0xe4, 0x68, 0xa3, 0xe1, 0x30, 0xd7, 0x15, 0xe2, 0x0a, 0x35,
+	0x7f, 0x79, 0x33, 0xff, 0xd1, 0x67, 0xe5, 0x89, 0x7f, 0x7f, 0x56, 0x9e, 0xd0, 0x0c, 0x28, 0x46,
+	0xb7, 0x22, 0x92, 0x12, 0xe4, 0xea, 0xb4, 0x4d, 0x2d, 0x83, 0xf9, 0x7b, 0x71, 0x49, 0x9e, 0x87,
+	0x82, 0x61, 0x37, 0x98, 0x7e, 0x42, 0xdd, 0x93, 0xd2, 0xa4, 0x78, 0x97, 0xe7, 0x84, 0x57, 0xa9,
+	0x7b, 0x42, 0x8a, 0x30, 0x65, 0xd9, 0x7c, 0x53, 0x66, 0x55, 0xd9, 0xca, 0xd6, 0xe4, 0x42, 0x7b,
+	0x19, 0x16, 0xd1, 0x5a, 0x6e, 0xcc, 0x57, 0x40, 0xf9, 0x81, 0x02, 0xea, 0x28, 0x09, 0x08, 0x76,
+	0x1d, 0x9e, 0x93, 0x7e, 0xd2, 0xa3, 0x92, 0xe6, 0x24, 0x75, 0x5f, 0x12, 0x89, 0x0a, 0x79, 0x97,
+	0x2b, 0xe5, 0xf8, 0x26, 0x05, 0xbe, 0xc1, 0x9a, 0x8b, 0xa0, 0x52, 0xaa, 0x6e, 0x75, 0xcd, 0x3a,
+	0x73, 0xd0, 0x82, 0x39, 0xa4, 0xbe, 0x29, 0x88, 0xda, 0x1b, 0xb0, 0x24, 0x70, 0xbc, 0x45, 0xdb,
+	0xad, 0x06, 0xf5, 0x6c, 0x67, 0xc8, 0x98, 0xab, 0x30, 0x6b, 0xd8, 0xd6, 0x30, 0x8e, 0x19, 0x4e,
+	0xdb, 0x8f, 0x59, 0xf5, 0x89, 0x02, 0xcb, 0x09, 0xd2, 0xd0, 0xb0, 0x4d, 0xb8, 0xe0, 0xa3, 0x8a,
+	0x4a, 0xf4, 0xc1, 0x3e, 0x43, 0xd3, 0xfc, 0x24, 0x3a, 0x90, 0x71, 0x7e, 0x9a, 0xf0, 0xbc, 0x08,
+	0xc5, 0xe8, 0xd6, 0xb4, 0x24, 0xd2, 0xde, 0x40, 0x65, 0xf7, 0x3c, 0xdb, 0xa1, 0xcd, 0x74, 0x65,
+	0x64, 0x1e, 0x32, 0xa7, 0xec, 0x0c, 0xf3, 0x8d, 0x3f, 0x86, 0xd4, 0xef, 0x40, 0x31, 0x2a, 0x0c,
+	0xd5, 0x17, 0x61, 0xaa, 0x47, 0xdb, 0x5d, 0x5f, 0xb9, 0x5c, 0x68, 0xdf, 0x81, 0x79, 0x4c, 0xa5,
+	0xc6, 0x53, 0x19, 0xb9, 0x09, 0x17, 0x43, 0xfb, 0x50, 0x05, 0x81, 0x2c, 0xcf, 0x7d, 0xb1, 0x6b,
+	0xb6, 0x26, 0x9e, 0x79, 0xb2, 0x96, 0x22, 0x78, 0xa8, 0x35, 0x8e, 0x85, 0xaf, 0x00, 0x04, 0x85,
+	0x4c, 0x18, 0x3a, 0xb3, 0xb7, 0xe1, 0x7f, 0xff, 0xbc, 0xea, 0x55, 0x64, 0xcd, 0xc4, 0xaa, 0x57,
+	0xb9, 0x1b, 0xf8, 0xad, 0x16, 0xda, 0x19, 0x42, 0xfc, 0x5b, 0x05, 0x16, 0x47, 0x00, 0x41, 0xe8,
+	0x47, 0x90, 0x73, 0x25, 0xbd, 0xa4, 0xac, 0x66, 0xb6, 0x66, 0xf6, 0xae, 0xc4, 0x8b, 0xcd, 0x3d,
+	0x8f, 0x7a, 0xec, 0xa0, 0xf8, 0xf9, 0xa3, 0xf2, 0xc4, 0x6f, 0xbe, 0x2c, 0xe7, 0x50, 0xce, 0xaf,
+	0xff, 0xf5, 0xfb, 0x6d, 0xa5, 0xe6, 0xef, 0x26, 0x47, 0x23, 0x80, 0x6f, 0xa6, 0x02, 0x97, 0x28,
+	0xc2, 0xc8, 0x35, 0x1d, 0x16, 0xfc, 0xa2, 0xe8, 0x39, 0xd4, 0xf0, 0x5c, 0xdf, 0x69, 0x51, 0xd7,
+	0x28, 0x5f, 0xd5, 0x35, 0x5a, 0x1f, 0x2e, 0xf8, 0xb2, 0xf1, 0x4b, 0x3b, 0x27, 0x1e, 0xa1, 0xe4,
+	0x9d, 0x8c, 0x56, 0xc0, 0x91, 0x45, 0x2e, 0x5a, 0x17, 0xb3, 0xd1, 0xba, 0xc8, 0x43, 0x71, 0x79,
+	0xd8, 0x36, 0x8c, 0xc3, 0xeb, 0x7c, 0x1f, 0x12, 0x31, 0x12, 0x57, 0x47, 0x94, 0xfd, 0x28, 0xee,
+	0x83, 0x02, 0x8f, 0x89, 0x0c, 0x44, 0xb0, 0xfd, 0xd9, 0x85, 0xe2, 0x5d, 0x3c, 0xb5, 0x8e, 0xfb,
+	0xb7, 0xed, 0xe6, 0x20, 0x0e, 0x04, 0xb2, 0xc2, 0x3a, 0xe9, 0x29, 0xf1, 0xfc, 0x35, 0xa4, 0xed,
+	0xc7, 0x0a, 0x5c, 0x8a, 0x28, 0x47, 0x47, 0x5d, 0x87, 0x6c, 0xdb, 0x6e, 0xfa, 0x3e, 0x5a, 0x88,
+	0xfb, 0xe8, 0xb6, 0xdd, 0xac, 0x09, 0x96, 0x67, 0xe7, 0x07, 0xff, 0xf4, 0xbe, 0x4b, 0x1d, 0x6a,
+	0xfa, 0x7e, 0xd0, 0x6a, 0x70, 0x29, 0x42, 0x45, 0x80, 0xdf, 0x83, 0xe9, 0x8e, 0xa0, 0x60, 0x8a,
+	0x96, 0xe2, 0x10, 0xe5, 0x8e, 0x70, 0xf4, 0x70, 0x8b, 0xf6, 0x17, 0x05, 0x9e, 0xbb, 0xe5, 0x9d,
+	0x1c, 0xd2, 0x76, 0x3b, 0xe4, 0x6e, 0xea, 0x34, 0x5d, 0xbf, 0xb8, 0xf0, 0x67, 0x72, 0x05, 0x72,
+	0x4d, 0xea, 0xea, 0x06, 0xed, 0x60, 0x9d, 0x9f, 0x6e, 0x52, 0xf7, 0x90, 0x76, 0xc8, 0xdb, 0x30,
+	0xdf, 0x71, 0xec, 0x8e, 0xed, 0x32, 0x67, 0x70, 0x56, 0xf0, 0xfc, 0x9c, 0x3d, 0xd8, 0xfb, 0xcf,
+	0xa3, 0x72, 0xa5, 0xd9, 0xf2, 0x4e, 0xba, 0xf5, 0x8a, 0x61, 0x9b, 0x55, 0x6c, 0x80, 0xe4, 0x7f,
+	0x2f, 0xb8, 0x8d, 0xd3, 0xaa, 0x77, 0xd6, 0x61, 0x6e, 0xe5, 0x30, 0x38, 0xa4, 0x6a, 0x17, 0x7c,
+	0x59, 0x48, 0x20, 0x8b, 0x90, 0x37, 0x78, 0xe7, 0xa1, 0xb7, 0x1a, 0x22, 0xb9, 0x33, 0xb5, 0x9c,
+	0x58, 0xbf, 0xd6, 0x20, 0x4b, 0x50, 0xb0, 0x7b, 0xcc, 0x71, 0x5a, 0x0d, 0xe6, 0x96, 0xa6, 0x04,
+	0xd6, 0x80, 0xa0, 0x1d, 0xc3, 0xa5, 0x5b, 0xae, 0xd7, 0x32, 0xa9, 0xc7, 0x8e, 0x68, 0xe0, 0xab,
+	0x79, 0xc8, 0x34, 0xa9, 0x34, 0x2d, 0x5b, 0xe3, 0x8f, 0x9c, 0xe2, 0x30, 0x4f, 0x58, 0x35, 0x5b,
+	0xe3, 0x8f, 0x5c, 0x67, 0xcf, 0xd4, 0x99, 0xe3, 0xd8, 0xf2, 0xc8, 0x2a, 0xd4, 0x72, 0x3d, 0xf3,
+	0x16, 0x5f, 0x6a, 0x7f, 0x53, 0xe0, 0xe2, 0xbd, 0x96, 0xd9, 0x6d, 0x53, 0x8f, 0xbd, 0xb5, 0x1b,
+	0x72, 0x98, 0xdd, 0xf1, 0x06, 0x0e, 0xe3, 0xcf, 0xdf, 0x44, 0x87, 0x2d, 0x03, 0xd4, 0xdb, 0xb6,
+	0x71, 0x2a, 0x4b, 0xc5, 0x94, 0xb0, 0xac, 0x20, 0x28, 0xa2, 0x56, 0xbc, 0x0d, 0x24, 0x6c, 0x5a,
+	0x70, 0xd2, 0x34, 0xa8, 0x47, 0x7d, 0xdb, 0xf8, 0x33, 0x17, 0x24, 0xbc, 0xa3, 0x8b, 0x33, 0x68,
+	0x52, 0x68, 0x29, 0x08, 0x0a, 0x3f, 0xa4, 0x78, 0x9d, 0x0a, 0x3b, 0x4f, 0x2e, 0xb4, 0x8f, 0xb3,
+	0xfe, 0xe7, 0xe5, 0x50, 0x83, 0x1d, 0xf7, 0x7d, 0xe7, 0xed, 0x42, 0xc6, 0x74, 0xfd, 0xc6, 0xb3,
+	0x1c, 0x4f, 0xdd, 0x3b, 0x6e, 0xf3, 0x96, 0x77, 0xc2, 0x1c, 0xd6, 0x35, 0x8f, 0xfb, 0x35, 0xce,
+	0x4b, 0xbe, 0x0f, 0xb3, 0xbc, 0xf0, 0x30, 0x1d, 0x9b, 0xd6, 0x4c, 0x52, 0xd3, 0x2a, 0x54, 0x61,
+	0xd3, 0x3a, 0xe3, 0x05, 0x0b, 0x72, 0x08, 0xb3, 0x1d, 0x87, 0x35, 0x98, 0xc1, 0x5c, 0xd7, 0x76,
+	0xdc, 0x52, 0x76, 0x35, 0x33, 0x8e, 0xf6, 0xc8, 0x26, 0xde, 0x74, 0x49, 0x7f, 0x62, 0x7b, 0x33,
+	0x25, 0x1c, 0x31, 0x23, 0x68, 0xb2, 0xb9, 0x19, 0x72, 0xf9, 0xf4, 0x90, 0xcb, 0xc9, 0xab, 0xfe,
+	0x6b, 0xde, 0xbd, 0x97, 0x72, 0xc2, 0x0c, 0xb5, 0x22, 0x5b, 0xfb, 0x8a, 0xdf, 0xda, 0x57, 0x8e,
+	0xfd, 0xd6, 0xfe, 0x60, 0x8e, 0x7f, 0xbf, 0x9f, 0x7e, 0x59, 0x56, 0xb0, 0x02, 0x8b, 0xcd, 0xfc,
+	0xf5, 0xc8, 0xac, 0xca, 0x7f, 0x3d, 0x59, 0x55, 0x88, 0x66, 0x95, 0x06, 0x73, 0xd2, 0x06, 0x93,
+	0xf6, 0x75, 0xfe, 0x6d, 0x41, 0xc8, 0x0d, 0x77, 0x68, 0xff, 0x88, 0xba, 0xaf, 0x67, 0xf3, 0x93,
+	0xf3, 0x99, 0x5a, 0xde, 0xeb, 0xeb, 0x2d, 0xab, 0xc1, 0xfa, 0xda, 0x36, 0x76, 0x4e, 0x83, 0x54,
+	0x48, 0x4e, 0x36, 0xed, 0x0f, 0x19, 0xb8, 0x1c, 0x30, 0x1f, 0x70, 0xa9, 0xa1, 0xd4, 0xf1, 0xfa,
+	0x7e, 0x61, 0x4e, 0x4f, 0x1d, 0xaf, 0xef, 0x3e, 0x83, 0xd4, 0xf9, 0x7f, 0xd4, 0xc7, 0x8c, 0xba,
+	0xf6, 0x02, 0x5c, 0x89, 0x05, 0xee, 0x9c, 0x40, 0x7f, 0x98, 0x81, 0x85, 0x80, 0xff, 0x9b, 0x7a,
+	0x20, 0x0d, 0x27, 0x50, 0xf6, 0x7f, 0x90, 0x40, 0x87, 0x4f, 0x99, 0x40, 0x79, 0x3f, 0x81, 0xc2,
+	0xb9, 0x13, 0x0e, 0x6e, 0x3e, 0x12, 0x5c, 0x6d, 0x07, 0x2e, 0x0f, 0x07, 0xe2, 0x9c, 0xb8, 0x2d,
+	0x0c, 0x06, 0x38, 0x97, 0xbd, 0xc2, 0x58, 0x70, 0xd5, 0x50, 0x8c, 0x92, 0x51, 0xc4, 0x4b, 0x90,
+	0xe7, 0x9d, 0x90, 0x7e, 0x9f, 0xe1, 0x80, 0x74, 0xb0, 0xf8, 0xf7, 0x47, 0xe5, 0x05, 0xe9, 0x3f,
+	0xb7, 0x71, 0x5a, 0x69, 0xd9, 0x55, 0x93, 0x7a, 0x27, 0x95, 0xd7, 0x2c, 0x8f, 0xf7, 0xbe, 0x62,
+	0xb7, 0x56, 0xc6, 0x91, 0xf5, 0xa8, 0x6d, 0xd7, 0x69, 0xfb, 0x4e, 0xcb, 0x3a, 0xa2, 0xee, 0x5d,
+	0xa7, 0x35, 0x98, 0x17, 0x35, 0x03, 0x56, 0x92, 0x18, 0x50, 0xf1, 0x3e, 0xcc, 0x99, 0x2d, 0x8b,
+	0x27, 0xab, 0xde, 0xe1, 0x2f, 0x50, 0xfb, 0x32, 0x77, 0x4e, 0x32, 0x82, 0x19, 0x33, 0x10, 0x15,
+	0xd4, 0xad, 0x3e, 0x9f, 0x50, 0xba, 0xe7, 0x35, 0xa8, 0xda, 0x9f, 0x26, 0x61, 0x61, 0x88, 0x19,
+	0x81, 0x5c, 0x86, 0x69, 0x57, 0x50, 0x90, 0x1f, 0x57, 0x41, 0x7f, 0x3f, 0x19, 0xee, 0xef, 0xd7,
+	0xe1, 0x39, 0xd6, 0xef, 0x30, 0xc3, 0x63, 0x0d, 0x3d, 0xdc, 0xfe, 0xcf, 0xf9, 0xd4, 0x37, 0x05,
+	0x5b, 0xd8, 0xad, 0xd9, 0x71, 0xdd, 0x4a, 0xca, 0x30, 0xe3, 0xb0, 0x4e, 0x9b, 0x1a, 0xac, 0xa1,
+	0xd7, 0xcf, 0xb0, 0x27, 0x00, 0x9f, 0x74, 0x70, 0xc6, 0xb1, 0x3a, 0x8c, 0xba, 0xb6, 0x85, 0x59,
+	0x88, 0xab, 0x58, 0x12, 0xe7, 0x04, 0xa6, 0x48, 0x12, 0xbf, 0x0c, 0xe0, 0x30, 0xd3, 0xee, 0xb1,
+	0x86, 0x4e, 0xbd, 0x52, 0x3e, 0x35, 0x4b, 0xb3, 0x32, 0x43, 0x71, 0xcf, 0xbe, 0xb7, 0xf7, 0xc7,
+	0x22, 0x4c, 0x09, 0x0f, 0x92, 0x9f, 0x29, 0x90, 0xf3, 0x27, 0xa7, 0xf5, 0xf8, 0xa7, 0x36, 0xe2,
+	0x12, 0x4a, 0xdd, 0x48, 0x63, 0x93, 0xc1, 0xd0, 0x6e, 0xfc, 0xf8, 0xcf, 0xff, 0xfc, 0xf9, 0xe4,
+	0x3a, 0xb9, 0x56, 0x8d, 0x5d, 0xd0, 0xe1, 0x3d, 0x45, 0xf5, 0x01, 0x96, 0x91, 0x87, 0xe4, 0x97,
+	0x0a, 0xcc, 0x45, 0xae, 0x82, 0xc8, 0x8d, 0x04, 0x35, 0xa3, 0xae, 0x9c, 0xd4, 0x9d, 0xf1, 0x98,
+	0x11, 0xd9, 0x9e, 0x40, 0xb6, 0x43, 0xb6, 0xe3, 0xc8, 0xfc, 0x5b, 0xa7, 0x18, 0xc0, 0xdf, 0x29,
+	0x30, 0x3f, 0x7c, 0xab, 0x43, 0x2a, 0x09, 0x6a, 0x13, 0x2e, 0x93, 0xd4, 0xea, 0xd8, 0xfc, 0x88,
+	0xf4, 0xa6, 0x40, 0xfa, 0x12, 0xd9, 0x8b, 0x23, 0xed, 0xf9, 0x7b, 0x02, 0xb0, 0xe1, 0x8b, 0xaa,
+	0x87, 0xe4, 0x03, 0x05, 0x72, 0x78, 0x7f, 0x93, 0x18, 0xda, 0xe8, 0xd5, 0x90, 0xba, 0x91, 0xc6,
+	0x86, 0xb0, 0x76, 0x04, 0xac, 0x0d, 0xb2, 0x16, 0x87, 0x85, 0x23, 0xb5, 0x1b, 0x72, 0xdd, 0x27,
+	0x0a, 0xf8, 0x17, 0x0d, 0x89, 0x40, 0xa2, 0xd7, 0x46, 0xea, 0x46, 0x1a, 0x1b, 0x02, 0xd9, 0x15,
+	0x40, 0x6e, 0x90, 0xeb, 0x71, 0x20, 0x78, 0x99, 0x11, 0xe0, 0xa8, 0x3e, 0x38, 0x65, 0x67, 0x0f,
+	0xc9, 0xbb, 0x90, 0x15, 0xbd, 0xb4, 0x96, 0x98, 0x32, 0x83, 0x5b, 0x24, 0xf5, 0xda, 0xb9, 0x3c,
+	0x88, 0xe1, 0xba, 0xc0, 0x70, 0x8d, 0x5c, 0x1d, 0x95, 0x4d, 0x8d, 0x88, 0x27, 0x7e, 0xa1, 0xc0,
+	0x6c, 0xf8, 0xea, 0x86, 0x6c, 0xa7, 0xd8, 0x19, 0xba, 0x68, 0x52, 0x6f, 0x8c, 0xc5, 0x3b, 0xb6,
+	0x63, 0x74, 0x87, 0x6f, 0x08, 0x81, 0xfb, 0x89, 0x02, 0x85, 0xc1, 0x65, 0x06, 0xd9, 0x4c, 0x34,
+	0x3d, 0x7a, 0x95, 0xa3, 0x6e, 0xa5, 0x33, 0x22, 0xa6, 0x6b, 0x02, 0xd3, 0x32, 0x79, 0x7e, 0x94,
+	0xa3, 0x7c, 0xbd, 0x3f, 0x82, 0x69, 0x39, 0x52, 0x93, 0xb5, 0x04, 0xc1, 0x91, 0xc9, 0x5d, 0x5d,
+	0x4f, 0xe1, 0x42, 0xdd, 0xab, 0x42, 0xb7, 0x4a, 0x4a, 0x71, 0xdd, 0x72, 0x5c, 0x27, 0x7d, 0xc8,
+	0xe1, 0xb4, 0x4e, 0x56, 0xe3, 0x32, 0xa3, 0x83, 0xbc, 0xba, 0x99, 0xd6, 0x12, 0xfb, 0x7a, 0x35,
+	0xa1, 0x77, 0x89, 0xa8, 0x71, 0xbd, 0xcc, 0x3b, 0xd1, 0x0d, 0xae, 0xee, 0x7d, 0x98, 0x09, 0x0d,
+	0xd4, 0x63, 0x68, 0x1f, 0x61, 0xf3, 0x88, 0x89, 0x5c, 0xdb, 0x10, 0xba, 0x57, 0xc9, 0xca, 0x08,
+	0xdd, 0xc8, 0xce, 0xcf, 0x6c, 0xf2, 0x3e, 0x40, 0x30, 0x9e, 0x92, 0x11, 0x39, 0x1f, 0x9b, 0xcb,
+	0xd5, 0xb5, 0xf3, 0x99, 0x10, 0xc0, 0xba, 0x00, 0x50, 0x26, 0xcb, 0x23, 0x92, 0x10, 0xb9, 0xf5,
+	0xde, 0x2e, 0x79, 0x0f, 0x72, 0x38, 0xae, 0x24, 0x96, 0x87, 0xe8, 0x64, 0xab, 0x6e, 0xa4, 0xb1,
+	0xa5, 0x7b, 0x5f, 0xb6, 0x9a, 0x5e, 0x9f, 0x7c, 0xa4, 0x00, 0x04, 0x7d, 0x34, 0xd9, 0x3a, 0x4f,
+	0x74, 0x78, 0x46, 0x52, 0xaf, 0x8f, 0xc1, 0x99, 0xee, 0x08, 0x89, 0x43, 0x9c, 0xee, 0xe4, 0xa7,
+	0x0a, 0x14, 0x06, 0x9d, 0x61, 0xe2, 0x17, 0x38, 0xdc, 0xc4, 0xab, 0x5b, 0xe9, 0x8c, 0x88, 0x63,
+	0x4d, 0xe0, 0x58, 0x21, 0x4b, 0x49, 0x38, 0x44, 0x3e, 0xbe, 0xc7, 0xcf, 0x0d, 0xd9, 0xc5, 0x24,
+	0x9f, 0x1b, 0xe1, 0x8e, 0x54, 0xdd, 0x48, 0x63, 0x4b, 0x8f, 0x87, 0xdf, 0x62, 0xf1, 0x02, 0x80,
+	0xed, 0xfc, 0x5a, 0x72, 0x65, 0x09, 0xfe, 0xf0, 0xa6, 0xae, 0xa7, 0x70, 0xa5, 0x17, 0x00, 0x39,
+	0x6f, 0x90, 0x5f, 0x29, 0x70, 0x31, 0xd6, 0xe3, 0x92, 0xa4, 0x23, 0x3b, 0xa9, 0x5d, 0x56, 0x5f,
+	0x1c, 0x7f, 0x03, 0x42, 0xdb, 0x14, 0xd0, 0xae, 0x92, 0x72, 0x1c, 0x5a, 0xa4, 0xad, 0x26, 0x1f,
+	0x2a, 0x90, 0xf7, 0x7b, 0x5e, 0x92, 0xf8, 0x0d, 0x44, 0x3b, 0x68, 0x75, 0x33, 0x95, 0x0f, 0x61,
+	0x6c, 0x0b, 0x18, 0x6b, 0x44, 0x1b, 0x91, 0x1c, 0x7d, 0x5d, 0x76, 0xd2, 0xd5, 0x07, 0xbc, 0x03,
+	0x7f, 0x78, 0x70, 0xf3, 0xf3, 0xc7, 0x2b, 0xca, 0x17, 0x8f, 0x57, 0x94, 0x7f, 0x3c, 0x5e, 0x51,
+	0x3e, 0x7d, 0xb2, 0x32, 0xf1, 0xc5, 0x93, 0x95, 0x89, 0xbf, 0x3e, 0x59, 0x99, 0xf8, 0xe1, 0x6a,
+	0x7c, 0x0c, 0xe4, 0x72, 0xfa, 0x5c, 0x92, 0x18, 0x02, 0xeb, 0xd3, 0xa2, 0x43, 0xfd, 0xd6, 0x7f,
+	0x07, 0x00, 0xae, 0x24, 0xac, 0x26, 0x43, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1820,12 +2429,18 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// StorageRange queries the storage slots of a contract, ordered by slot key.
+	StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error)
+	// Contracts queries the accounts holding code, ordered by address.
+	Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -1844,6 +2459,9 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// TxStatus queries the stage of its lifecycle an ethereum transaction is in,
+	// as seen by the app-side mempool of the node.
+	TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error)
 }
 
 type queryClient struct {
@@ -1908,6 +2526,24 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) StorageRange(ctx context.Context, in *QueryStorageRangeRequest, opts ...grpc.CallOption) (*QueryStorageRangeResponse, error) {
+	out := new(QueryStorageRangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/StorageRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Contracts(ctx context.Context, in *QueryContractsRequest, opts ...grpc.CallOption) (*QueryContractsResponse, error) {
+	out := new(QueryContractsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Contracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Params", in, out, opts...)
@@ -1935,6 +2571,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TraceTx", in, out, opts...)
@@ -1989,9 +2634,18 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Account queries an Ethereum account.
+func (c *queryClient) TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error) {
+	out := new(QueryTxStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/TxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Account queries an Ethereum account.
 	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 	// CosmosAccount queries an Ethereum account's Cosmos Address.
 	CosmosAccount(context.Context, *QueryCosmosAccountRequest) (*QueryCosmosAccountResponse, error)
@@ -2005,12 +2659,18 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// StorageRange queries the storage slots of a contract, ordered by slot key.
+	StorageRange(context.Context, *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error)
+	// Contracts queries the accounts holding code, ordered by address.
+	Contracts(context.Context, *QueryContractsRequest) (*QueryContractsResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -2029,6 +2689,9 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// TxStatus queries the stage of its lifecycle an ethereum transaction is in,
+	// as seen by the app-side mempool of the node.
+	TxStatus(context.Context, *QueryTxStatusRequest) (*QueryTxStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2053,6 +2716,12 @@ func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageR
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) StorageRange(ctx context.Context, req *QueryStorageRangeRequest) (*QueryStorageRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRange not implemented")
+}
+func (*UnimplementedQueryServer) Contracts(ctx context.Context, req *QueryContractsRequest) (*QueryContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contracts not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2062,6 +2731,9 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -2080,6 +2752,9 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) TxStatus(ctx context.Context, req *QueryTxStatusRequest) (*QueryTxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2193,6 +2868,42 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/StorageRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRange(ctx, req.(*QueryStorageRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Contracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Contracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/Contracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contracts(ctx, req.(*QueryContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2247,6 +2958,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2355,6 +3084,24 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/TxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxStatus(ctx, req.(*QueryTxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
@@ -2384,6 +3131,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "StorageRange",
+			Handler:    _Query_StorageRange_Handler,
+		},
+		{
+			MethodName: "Contracts",
+			Handler:    _Query_Contracts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2396,6 +3151,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2420,6 +3179,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Query_TxStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -2882,7 +3645,7 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRangeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2892,12 +3655,12 @@ func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2914,17 +3677,17 @@ func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryStorageRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2934,12 +3697,12 @@ func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryStorageRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2956,10 +3719,10 @@ func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2973,7 +3736,7 @@ func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2983,20 +3746,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ContractAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3006,30 +3781,46 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryContractsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3039,51 +3830,46 @@ func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryContractsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Overrides) > 0 {
-		i -= len(m.Overrides)
-		copy(dAtA[i:], m.Overrides)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GasCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Args) > 0 {
-		i -= len(m.Args)
-		copy(dAtA[i:], m.Args)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3093,39 +3879,39 @@ func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxLogsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3135,57 +3921,32 @@ func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxLogsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x12
 	}
-	if len(m.ProposerAddress) > 0 {
-		i -= len(m.ProposerAddress)
-		copy(dAtA[i:], m.ProposerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Predecessors) > 0 {
-		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3193,37 +3954,36 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3233,27 +3993,30 @@ func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
+func (m *EthCallRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3263,83 +4026,51 @@ func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthCallRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockMaxGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x2a
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x20
 	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
 		copy(dAtA[i:], m.ProposerAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
 		i--
-		dAtA[i] = 0x42
-	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x10
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Txs) > 0 {
-		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3349,27 +4080,39 @@ func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3379,52 +4122,27 @@ func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x40
-	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintQuery(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
 		i -= len(m.BlockHash)
 		copy(dAtA[i:], m.BlockHash)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.TraceConfig != nil {
-		{
-			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
 	if len(m.ProposerAddress) > 0 {
 		i -= len(m.ProposerAddress)
@@ -3438,17 +4156,17 @@ func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Args) > 0 {
-		i -= len(m.Args)
-		copy(dAtA[i:], m.Args)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3458,16 +4176,28 @@ func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -3478,7 +4208,7 @@ func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3488,46 +4218,86 @@ func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseFee != nil {
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Predecessors) > 0 {
+		for iNdEx := len(m.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predecessors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TraceConfig != nil {
 		{
-			size := m.BaseFee.Size()
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
 			i -= size
-			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
@@ -3536,7 +4306,7 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3546,20 +4316,27 @@ func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceTxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTraceBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3569,548 +4346,1790 @@ func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MinGasPrice.Size()
-		i -= size
-		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.BlockMaxGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockMaxGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x48
 	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTraceBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTraceBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
-	}
-	return n
-}
-
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTxLogsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGlobalMinGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGlobalMinGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EthCallRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGlobalMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Args)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
-	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	l = len(m.Overrides)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
-	}
-	l = len(m.Ret)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTraceTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RemovedAt != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RemovedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovedAt):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Predecessors) > 0 {
-		for _, e := range m.Predecessors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReplacedBy) > 0 {
+		i -= len(m.ReplacedBy)
+		copy(dAtA[i:], m.ReplacedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReplacedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
+	if m.ExpectedNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpectedNonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryTraceTxResponse) Size() (n int) {
+func (m *QueryConfigResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTraceBlockRequest) Size() (n int) {
+func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.BlockHash)
+	var l int
+	_ = l
+	l = len(m.Balance)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProposerAddress)
+	l = len(m.CodeHash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.BlockMaxGas != 0 {
-		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *QueryTraceBlockResponse) Size() (n int) {
+func (m *QueryCosmosAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTraceCallRequest) Size() (n int) {
+func (m *QueryCosmosAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Args)
+	l = len(m.CosmosAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.GasCap != 0 {
-		n += 1 + sovQuery(uint64(m.GasCap))
-	}
-	l = len(m.ProposerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
 	}
-	if m.TraceConfig != nil {
-		l = m.TraceConfig.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
 	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
+	return n
+}
+
+func (m *QueryValidatorAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.BlockHash)
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
 	return n
 }
 
-func (m *QueryTraceCallResponse) Size() (n int) {
+func (m *QueryValidatorAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Data)
+	l = len(m.AccountAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
 	return n
 }
 
-func (m *QueryBaseFeeRequest) Size() (n int) {
+func (m *QueryBalanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EthCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovQuery(uint64(m.ErrorCode))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Predecessors) > 0 {
+		for _, e := range m.Predecessors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockMaxGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlockMaxGas))
+	}
+	return n
+}
+
+func (m *QueryTraceBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.ExpectedNonce != 0 {
+		n += 1 + sovQuery(uint64(m.ExpectedNonce))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReplacedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	if m.RemovedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovedAt)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ChainConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,12 +6152,82 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4160,7 +6249,7 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4183,17 +6272,17 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4203,27 +6292,23 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ChainConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4246,7 +6331,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,15 +6354,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4305,7 +6390,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4328,7 +6413,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,15 +6436,15 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4387,11 +6472,11 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4419,27 +6504,8 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4461,7 +6527,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4484,15 +6550,15 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4520,7 +6586,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4543,7 +6609,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4566,15 +6632,15 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4602,32 +6668,63 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.AccountNumber = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4637,11 +6734,26 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4663,7 +6775,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4686,15 +6798,15 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4722,7 +6834,43 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4745,7 +6893,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4768,17 +6916,17 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4788,29 +6936,31 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4820,30 +6970,28 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AccountNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4865,7 +7013,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4888,17 +7036,17 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4908,23 +7056,27 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4947,7 +7099,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4970,13 +7122,45 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
@@ -5008,6 +7192,57 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5029,7 +7264,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5052,17 +7287,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5072,29 +7307,31 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contracts = append(m.Contracts, ContractAccount{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5104,23 +7341,27 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5143,7 +7384,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5166,15 +7407,15 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5202,7 +7443,43 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5225,7 +7502,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5248,17 +7525,17 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5268,23 +7545,61 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5307,7 +7622,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5330,46 +7645,12 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5391,7 +7672,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5414,47 +7695,15 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5481,10 +7730,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5509,7 +7755,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5532,17 +7778,17 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5552,31 +7798,50 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5586,26 +7851,77 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5629,7 +7945,7 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5652,67 +7968,70 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5722,24 +8041,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5762,7 +8080,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5785,15 +8103,15 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5820,9 +8138,9 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
@@ -5899,9 +8217,9 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5911,25 +8229,23 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
-			if m.Overrides == nil {
-				m.Overrides = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5952,7 +8268,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5975,34 +8291,15 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6029,14 +8326,33 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6064,7 +8380,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7150,9 +9466,143 @@ func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7162,24 +9612,26 @@ func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7203,7 +9655,7 @@ func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7226,10 +9678,10 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7253,7 +9705,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7276,15 +9728,15 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7312,9 +9764,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7339,7 +9789,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7362,12 +9812,44 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7389,7 +9871,7 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7412,15 +9894,15 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7448,7 +9930,198 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNonce", wireType)
+			}
+			m.ExpectedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RemovedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex